@@ -31,6 +31,7 @@ import (
 
 	common "github.com/IBM/cloud-go-sdk/common"
 	"github.com/IBM/go-sdk-core/v5/core"
+	scccommon "github.com/IBM/scc-go-sdk/v4/common"
 )
 
 // AdminServiceApiV1 : This is an API for the Admin Service
@@ -347,6 +348,76 @@ type EventNotifications struct {
 
 	// Source.
 	SourceID *string `json:"source_id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EventNotifications
+func (o *EventNotifications) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EventNotifications
+func (o *EventNotifications) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EventNotifications
+func (o *EventNotifications) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EventNotifications
+func (o *EventNotifications) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EventNotifications
+func (o *EventNotifications) MarshalJSON() (buffer []byte, err error) {
+	type model EventNotifications
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = scccommon.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetInstanceCrn returns the value of InstanceCrn, or the zero value when InstanceCrn or the EventNotifications is nil
+func (o *EventNotifications) GetInstanceCrn() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.InstanceCrn)
+}
+
+// GetModified returns the value of Modified, or the zero value when Modified or the EventNotifications is nil
+func (o *EventNotifications) GetModified() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.Modified)
+}
+
+// GetSourceID returns the value of SourceID, or the zero value when SourceID or the EventNotifications is nil
+func (o *EventNotifications) GetSourceID() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.SourceID)
 }
 
 // UnmarshalEventNotifications unmarshals an instance of EventNotifications from the specified map of raw messages.
@@ -364,6 +435,10 @@ func UnmarshalEventNotifications(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = scccommon.UnmarshalAdditionalProperties(m, "instance_crn", "modified", "source_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -422,6 +497,38 @@ func (*AdminServiceApiV1) NewJSONPatchOperation(op string, path string) (_model
 	return
 }
 
+// GetOp returns the value of Op, or the zero value when Op or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetOp() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.Op)
+}
+
+// GetPath returns the value of Path, or the zero value when Path or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetPath() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.Path)
+}
+
+// GetFrom returns the value of From, or the zero value when From or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetFrom() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.From)
+}
+
+// GetValue returns Value, or nil when the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetValue() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.Value
+}
+
 // UnmarshalJSONPatchOperation unmarshals an instance of JSONPatchOperation from the specified map of raw messages.
 func UnmarshalJSONPatchOperation(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(JSONPatchOperation)
@@ -461,6 +568,92 @@ type ObjectStorage struct {
 
 	// Modified date in ISO8601 format.
 	Modified *string `json:"modified,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ObjectStorage
+func (o *ObjectStorage) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ObjectStorage
+func (o *ObjectStorage) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ObjectStorage
+func (o *ObjectStorage) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ObjectStorage
+func (o *ObjectStorage) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ObjectStorage
+func (o *ObjectStorage) MarshalJSON() (buffer []byte, err error) {
+	type model ObjectStorage
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = scccommon.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetInstanceCrn returns the value of InstanceCrn, or the zero value when InstanceCrn or the ObjectStorage is nil
+func (o *ObjectStorage) GetInstanceCrn() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.InstanceCrn)
+}
+
+// GetBucket returns the value of Bucket, or the zero value when Bucket or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucket() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.Bucket)
+}
+
+// GetBucketLocation returns the value of BucketLocation, or the zero value when BucketLocation or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucketLocation() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.BucketLocation)
+}
+
+// GetBucketEndpoint returns the value of BucketEndpoint, or the zero value when BucketEndpoint or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucketEndpoint() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.BucketEndpoint)
+}
+
+// GetModified returns the value of Modified, or the zero value when Modified or the ObjectStorage is nil
+func (o *ObjectStorage) GetModified() string {
+	if o == nil {
+		return ""
+	}
+	return scccommon.Deref(o.Modified)
 }
 
 // UnmarshalObjectStorage unmarshals an instance of ObjectStorage from the specified map of raw messages.
@@ -486,6 +679,10 @@ func UnmarshalObjectStorage(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = scccommon.UnmarshalAdditionalProperties(m, "instance_crn", "bucket", "bucket_location", "bucket_endpoint", "modified")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -515,6 +712,68 @@ type Settings struct {
 
 	// Object Storage settings.
 	ObjectStorage *ObjectStorage `json:"object_storage,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Settings
+func (o *Settings) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Settings
+func (o *Settings) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Settings
+func (o *Settings) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Settings
+func (o *Settings) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Settings
+func (o *Settings) MarshalJSON() (buffer []byte, err error) {
+	type model Settings
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = scccommon.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEventNotifications returns EventNotifications, or nil when the Settings is nil
+func (o *Settings) GetEventNotifications() *EventNotifications {
+	if o == nil {
+		return nil
+	}
+	return o.EventNotifications
+}
+
+// GetObjectStorage returns ObjectStorage, or nil when the Settings is nil
+func (o *Settings) GetObjectStorage() *ObjectStorage {
+	if o == nil {
+		return nil
+	}
+	return o.ObjectStorage
 }
 
 // UnmarshalSettings unmarshals an instance of Settings from the specified map of raw messages.
@@ -528,6 +787,10 @@ func UnmarshalSettings(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = scccommon.UnmarshalAdditionalProperties(m, "event_notifications", "object_storage")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -554,6 +817,60 @@ func (*AdminServiceApiV1) NewSettingsPatch(settings *Settings) (_patch []JSONPat
 type TestEvent struct {
 	// Indicates whether the event was received by Event Notifications.
 	Success *bool `json:"success" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TestEvent
+func (o *TestEvent) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TestEvent
+func (o *TestEvent) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TestEvent
+func (o *TestEvent) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TestEvent
+func (o *TestEvent) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TestEvent
+func (o *TestEvent) MarshalJSON() (buffer []byte, err error) {
+	type model TestEvent
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = scccommon.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetSuccess returns the value of Success, or the zero value when Success or the TestEvent is nil
+func (o *TestEvent) GetSuccess() bool {
+	if o == nil {
+		return false
+	}
+	return scccommon.Deref(o.Success)
 }
 
 // UnmarshalTestEvent unmarshals an instance of TestEvent from the specified map of raw messages.
@@ -563,6 +880,10 @@ func UnmarshalTestEvent(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = scccommon.UnmarshalAdditionalProperties(m, "success")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -365,6 +365,76 @@ type EventNotifications struct {
 
 	// Source.
 	SourceID *string `json:"source_id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EventNotifications
+func (o *EventNotifications) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EventNotifications
+func (o *EventNotifications) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EventNotifications
+func (o *EventNotifications) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EventNotifications
+func (o *EventNotifications) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EventNotifications
+func (o *EventNotifications) MarshalJSON() (buffer []byte, err error) {
+	type model EventNotifications
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetInstanceCrn returns the value of InstanceCrn, or the zero value when InstanceCrn or the EventNotifications is nil
+func (o *EventNotifications) GetInstanceCrn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.InstanceCrn)
+}
+
+// GetUpdatedOn returns the value of UpdatedOn, or the zero value when UpdatedOn or the EventNotifications is nil
+func (o *EventNotifications) GetUpdatedOn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedOn)
+}
+
+// GetSourceID returns the value of SourceID, or the zero value when SourceID or the EventNotifications is nil
+func (o *EventNotifications) GetSourceID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.SourceID)
 }
 
 // UnmarshalEventNotifications unmarshals an instance of EventNotifications from the specified map of raw messages.
@@ -382,6 +452,10 @@ func UnmarshalEventNotifications(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "instance_crn", "updated_on", "source_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -461,6 +535,38 @@ func (*AdminServiceV3) NewJSONPatchOperation(op string, path string) (_model *JS
 	return
 }
 
+// GetOp returns the value of Op, or the zero value when Op or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetOp() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Op)
+}
+
+// GetPath returns the value of Path, or the zero value when Path or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetPath() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Path)
+}
+
+// GetFrom returns the value of From, or the zero value when From or the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetFrom() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.From)
+}
+
+// GetValue returns Value, or nil when the JSONPatchOperation is nil
+func (o *JSONPatchOperation) GetValue() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.Value
+}
+
 // UnmarshalJSONPatchOperation unmarshals an instance of JSONPatchOperation from the specified map of raw messages.
 func UnmarshalJSONPatchOperation(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(JSONPatchOperation)
@@ -500,6 +606,92 @@ type ObjectStorage struct {
 
 	// The updated_on date in ISO8601 format.
 	UpdatedOn *string `json:"updated_on,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ObjectStorage
+func (o *ObjectStorage) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ObjectStorage
+func (o *ObjectStorage) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ObjectStorage
+func (o *ObjectStorage) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ObjectStorage
+func (o *ObjectStorage) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ObjectStorage
+func (o *ObjectStorage) MarshalJSON() (buffer []byte, err error) {
+	type model ObjectStorage
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetInstanceCrn returns the value of InstanceCrn, or the zero value when InstanceCrn or the ObjectStorage is nil
+func (o *ObjectStorage) GetInstanceCrn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.InstanceCrn)
+}
+
+// GetBucket returns the value of Bucket, or the zero value when Bucket or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucket() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Bucket)
+}
+
+// GetBucketLocation returns the value of BucketLocation, or the zero value when BucketLocation or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucketLocation() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.BucketLocation)
+}
+
+// GetBucketEndpoint returns the value of BucketEndpoint, or the zero value when BucketEndpoint or the ObjectStorage is nil
+func (o *ObjectStorage) GetBucketEndpoint() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.BucketEndpoint)
+}
+
+// GetUpdatedOn returns the value of UpdatedOn, or the zero value when UpdatedOn or the ObjectStorage is nil
+func (o *ObjectStorage) GetUpdatedOn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedOn)
 }
 
 // UnmarshalObjectStorage unmarshals an instance of ObjectStorage from the specified map of raw messages.
@@ -525,6 +717,10 @@ func UnmarshalObjectStorage(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "instance_crn", "bucket", "bucket_location", "bucket_endpoint", "updated_on")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -575,6 +771,68 @@ type Settings struct {
 
 	// The Cloud Object Storage settings.
 	ObjectStorage *ObjectStorage `json:"object_storage,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Settings
+func (o *Settings) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Settings
+func (o *Settings) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Settings
+func (o *Settings) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Settings
+func (o *Settings) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Settings
+func (o *Settings) MarshalJSON() (buffer []byte, err error) {
+	type model Settings
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEventNotifications returns EventNotifications, or nil when the Settings is nil
+func (o *Settings) GetEventNotifications() *EventNotifications {
+	if o == nil {
+		return nil
+	}
+	return o.EventNotifications
+}
+
+// GetObjectStorage returns ObjectStorage, or nil when the Settings is nil
+func (o *Settings) GetObjectStorage() *ObjectStorage {
+	if o == nil {
+		return nil
+	}
+	return o.ObjectStorage
 }
 
 // UnmarshalSettings unmarshals an instance of Settings from the specified map of raw messages.
@@ -588,6 +846,10 @@ func UnmarshalSettings(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "event_notifications", "object_storage")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -614,6 +876,60 @@ func (*AdminServiceV3) NewSettingsPatch(settings *Settings) (_patch []JSONPatchO
 type TestEvent struct {
 	// The indication of whether the event was received by Event Notifications.
 	Success *bool `json:"success" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TestEvent
+func (o *TestEvent) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TestEvent
+func (o *TestEvent) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TestEvent
+func (o *TestEvent) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TestEvent
+func (o *TestEvent) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TestEvent
+func (o *TestEvent) MarshalJSON() (buffer []byte, err error) {
+	type model TestEvent
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetSuccess returns the value of Success, or the zero value when Success or the TestEvent is nil
+func (o *TestEvent) GetSuccess() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Success)
 }
 
 // UnmarshalTestEvent unmarshals an instance of TestEvent from the specified map of raw messages.
@@ -623,6 +939,10 @@ func UnmarshalTestEvent(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "success")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+//
+// UnmarshalAdditionalProperties - returns the properties of m that are not among knownProperties.
+//
+// This function is invoked by the generated model unmarshal functions so that properties which the service returns
+// but the model does not declare are kept instead of being dropped. The values are kept as json.RawMessage, exactly
+// as the service returned them, so that they are re-emitted unchanged; decode them with json.Unmarshal to inspect
+// them. A nil map is returned when there are no such properties.
+//
+func UnmarshalAdditionalProperties(m map[string]json.RawMessage, knownProperties ...string) (properties map[string]interface{}, err error) {
+	known := make(map[string]bool, len(knownProperties))
+	for _, name := range knownProperties {
+		known[name] = true
+	}
+	for name, raw := range m {
+		if known[name] {
+			continue
+		}
+		if properties == nil {
+			properties = make(map[string]interface{})
+		}
+		properties[name] = append(json.RawMessage(nil), raw...)
+	}
+	return
+}
+
+//
+// MarshalAdditionalProperties - adds properties to the JSON object in buffer.
+//
+// This function is invoked by the generated model MarshalJSON methods. Properties that the model declares take
+// precedence over additional properties with the same name. The declared properties in buffer are left as they are
+// and the additional properties are appended after them, sorted by name.
+//
+// The generated MarshalJSON methods have pointer receivers, so the additional properties of a model are only
+// written when a pointer to the model is marshalled: json.Marshal(&model), not json.Marshal(model).
+//
+func MarshalAdditionalProperties(buffer []byte, properties map[string]interface{}) ([]byte, error) {
+	if len(properties) == 0 {
+		return buffer, nil
+	}
+	declared := make(map[string]json.RawMessage)
+	err := json.Unmarshal(buffer, &declared)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		if _, ok := declared[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return buffer, nil
+	}
+	sort.Strings(names)
+
+	end := bytes.LastIndexByte(buffer, '}')
+	if end < 0 {
+		return buffer, nil
+	}
+	result := append([]byte(nil), buffer[:end]...)
+	separator := len(declared) > 0
+	for _, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(properties[name])
+		if err != nil {
+			return nil, err
+		}
+		if separator {
+			result = append(result, ',')
+		}
+		separator = true
+		result = append(result, key...)
+		result = append(result, ':')
+		result = append(result, value...)
+	}
+	return append(result, buffer[end:]...), nil
+}
+
+//
+// AddAdditionalProperties - adds properties to the request body of an operation.
+//
+// This function is invoked by the generated Update and Replace operations so that the additional properties of a
+// fetched model can be sent back with an update. Properties that the operation sets in body take precedence over
+// additional properties with the same name.
+//
+func AddAdditionalProperties(body map[string]interface{}, properties map[string]interface{}) {
+	for name, value := range properties {
+		if _, ok := body[name]; !ok {
+			body[name] = value
+		}
+	}
+}
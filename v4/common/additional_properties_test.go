@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalAdditionalProperties(t *testing.T) {
+	var m map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal([]byte(`{"name": "n", "new_field": {"a": 1}, "flag": true}`), &m))
+
+	properties, err := UnmarshalAdditionalProperties(m, "name")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"new_field": json.RawMessage(`{"a": 1}`), "flag": json.RawMessage(`true`)}, properties)
+
+	properties, err = UnmarshalAdditionalProperties(m, "name", "new_field", "flag")
+	assert.Nil(t, err)
+	assert.Nil(t, properties)
+}
+
+func TestMarshalAdditionalProperties(t *testing.T) {
+	buffer, err := MarshalAdditionalProperties([]byte(`{"name":"n"}`), map[string]interface{}{"name": "ignored", "flag": true})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name": "n", "flag": true}`, string(buffer))
+
+	buffer, err = MarshalAdditionalProperties([]byte(`{"name":"n"}`), map[string]interface{}{"b": 2, "a": json.RawMessage(`{"z": 1, "y": 2}`)})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"n","a":{"z":1,"y":2},"b":2}`, string(buffer))
+
+	buffer, err = MarshalAdditionalProperties([]byte(`{}`), map[string]interface{}{"flag": true})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"flag":true}`, string(buffer))
+
+	buffer, err = MarshalAdditionalProperties([]byte(`{"name":"n"}`), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"n"}`, string(buffer))
+}
+
+func TestAdditionalPropertiesRoundTrip(t *testing.T) {
+	var m map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal([]byte(`{"name": "n", "big": 9007199254740993, "decimal": 1.10}`), &m))
+
+	properties, err := UnmarshalAdditionalProperties(m, "name")
+	assert.Nil(t, err)
+	buffer, err := MarshalAdditionalProperties([]byte(`{"name":"n"}`), properties)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"n","big":9007199254740993,"decimal":1.10}`, string(buffer))
+}
+
+func TestAddAdditionalProperties(t *testing.T) {
+	body := map[string]interface{}{"name": "n"}
+	AddAdditionalProperties(body, map[string]interface{}{"name": "ignored", "new_field": json.RawMessage(`{"a": 1}`)})
+	assert.Equal(t, map[string]interface{}{"name": "n", "new_field": json.RawMessage(`{"a": 1}`)}, body)
+
+	AddAdditionalProperties(body, nil)
+	assert.Len(t, body, 2)
+}
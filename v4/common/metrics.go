@@ -0,0 +1,59 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// metricLabelEscaper escapes label values as the Prometheus text format requires.
+var metricLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+//
+// FormatMetricLabels - returns name and value pairs formatted as Prometheus labels, without the enclosing braces.
+//
+// The values are escaped as the Prometheus text exposition format requires. This function is used by the metrics
+// writers of the service packages so that they format and escape labels the same way.
+//
+func FormatMetricLabels(namesAndValues ...string) string {
+	pairs := make([]string, 0, len(namesAndValues)/2)
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, namesAndValues[i], metricLabelEscaper.Replace(namesAndValues[i+1])))
+	}
+	return strings.Join(pairs, ",")
+}
+
+//
+// WriteMetricFamily - writes the HELP and TYPE lines of a metric in the Prometheus text exposition format.
+//
+func WriteMetricFamily(w io.Writer, name string, metricType string, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+//
+// WriteMetricSample - writes one sample of a metric in the Prometheus text exposition format. The labels are formatted
+// by FormatMetricLabels and may be empty.
+//
+func WriteMetricSample(w io.Writer, name string, labels string, value interface{}) {
+	if labels == "" {
+		fmt.Fprintf(w, "%s %v\n", name, value)
+		return
+	}
+	fmt.Fprintf(w, "%s{%s} %v\n", name, labels, value)
+}
@@ -0,0 +1,38 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMetricLabels(t *testing.T) {
+	assert.Equal(t, `id="c1",name="east \"edge\" C:\\collector\n"`, FormatMetricLabels("id", "c1", "name", "east \"edge\" C:\\collector\n"))
+	assert.Equal(t, `id="c1"`, FormatMetricLabels("id", "c1", "name"))
+	assert.Equal(t, "", FormatMetricLabels())
+}
+
+func TestWriteMetric(t *testing.T) {
+	var buf bytes.Buffer
+	WriteMetricFamily(&buf, "scc_up", "gauge", "Whether the exporter is up.")
+	WriteMetricSample(&buf, "scc_up", "", 1)
+	WriteMetricSample(&buf, "scc_age_seconds", FormatMetricLabels("id", "c1"), 1.5)
+	assert.Equal(t, "# HELP scc_up Whether the exporter is up.\n# TYPE scc_up gauge\nscc_up 1\nscc_age_seconds{id=\"c1\"} 1.5\n", buf.String())
+}
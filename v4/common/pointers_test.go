@@ -0,0 +1,33 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPtrAndDeref(t *testing.T) {
+	s := Ptr("value")
+	assert.Equal(t, "value", *s)
+	assert.Equal(t, "value", Deref(s))
+	assert.Equal(t, "", Deref((*string)(nil)))
+	assert.Equal(t, int64(0), Deref((*int64)(nil)))
+	assert.Equal(t, int64(7), DerefOr(nil, int64(7)))
+	assert.Equal(t, true, DerefOr(Ptr(true), false))
+}
@@ -449,6 +449,7 @@ func (configManager *ConfigManagerV3) ReplaceRuleWithContext(ctx context.Context
 	if replaceRuleOptions.Import != nil {
 		body["import"] = replaceRuleOptions.Import
 	}
+	common.AddAdditionalProperties(body, replaceRuleOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -533,6 +534,9 @@ type AdditionalTargetAttribute struct {
 
 	// The value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the AdditionalTargetAttribute.Operator property.
@@ -563,6 +567,73 @@ const (
 	AdditionalTargetAttribute_Operator_StringsRequired      = "strings_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of AdditionalTargetAttribute
+func (o *AdditionalTargetAttribute) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of AdditionalTargetAttribute
+func (o *AdditionalTargetAttribute) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of AdditionalTargetAttribute
+func (o *AdditionalTargetAttribute) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of AdditionalTargetAttribute
+func (o *AdditionalTargetAttribute) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of AdditionalTargetAttribute
+func (o *AdditionalTargetAttribute) MarshalJSON() (buffer []byte, err error) {
+	type model AdditionalTargetAttribute
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the AdditionalTargetAttribute is nil
+func (o *AdditionalTargetAttribute) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the AdditionalTargetAttribute is nil
+func (o *AdditionalTargetAttribute) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the AdditionalTargetAttribute is nil
+func (o *AdditionalTargetAttribute) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalAdditionalTargetAttribute unmarshals an instance of AdditionalTargetAttribute from the specified map of raw messages.
 func UnmarshalAdditionalTargetAttribute(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(AdditionalTargetAttribute)
@@ -578,6 +649,10 @@ func UnmarshalAdditionalTargetAttribute(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -592,6 +667,9 @@ type And struct {
 
 	// The value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the And.Operator property.
@@ -622,6 +700,73 @@ const (
 	And_Operator_StringsRequired      = "strings_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of And
+func (o *And) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of And
+func (o *And) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of And
+func (o *And) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of And
+func (o *And) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of And
+func (o *And) MarshalJSON() (buffer []byte, err error) {
+	type model And
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the And is nil
+func (o *And) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the And is nil
+func (o *And) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the And is nil
+func (o *And) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalAnd unmarshals an instance of And from the specified map of raw messages.
 func UnmarshalAnd(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(And)
@@ -637,6 +782,10 @@ func UnmarshalAnd(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "property", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -812,6 +961,60 @@ func (options *GetRuleOptions) SetHeaders(param map[string]string) *GetRuleOptio
 type Import struct {
 	// The list of import parameters.
 	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Import
+func (o *Import) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Import
+func (o *Import) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Import
+func (o *Import) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Import
+func (o *Import) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Import
+func (o *Import) MarshalJSON() (buffer []byte, err error) {
+	type model Import
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetParameters returns Parameters, or nil when the Import is nil
+func (o *Import) GetParameters() []Parameter {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
 }
 
 // UnmarshalImport unmarshals an instance of Import from the specified map of raw messages.
@@ -821,6 +1024,10 @@ func UnmarshalImport(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "parameters")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -861,6 +1068,9 @@ type Or struct {
 
 	// The value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Or.Operator property.
@@ -891,6 +1101,73 @@ const (
 	Or_Operator_StringsRequired      = "strings_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Or
+func (o *Or) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Or
+func (o *Or) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Or
+func (o *Or) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Or
+func (o *Or) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Or
+func (o *Or) MarshalJSON() (buffer []byte, err error) {
+	type model Or
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the Or is nil
+func (o *Or) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the Or is nil
+func (o *Or) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the Or is nil
+func (o *Or) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalOr unmarshals an instance of Or from the specified map of raw messages.
 func UnmarshalOr(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Or)
@@ -906,6 +1183,10 @@ func UnmarshalOr(m map[string]json.RawMessage, result interface{}) (err error) {
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "property", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -923,6 +1204,9 @@ type Parameter struct {
 
 	// The property type.
 	Type *string `json:"type,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Parameter.Type property.
@@ -937,6 +1221,81 @@ const (
 	Parameter_Type_Timestamp  = "timestamp"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Parameter
+func (o *Parameter) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Parameter
+func (o *Parameter) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Parameter
+func (o *Parameter) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Parameter
+func (o *Parameter) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Parameter
+func (o *Parameter) MarshalJSON() (buffer []byte, err error) {
+	type model Parameter
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Parameter is nil
+func (o *Parameter) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value when DisplayName or the Parameter is nil
+func (o *Parameter) GetDisplayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DisplayName)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Parameter is nil
+func (o *Parameter) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Parameter is nil
+func (o *Parameter) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
 // UnmarshalParameter unmarshals an instance of Parameter from the specified map of raw messages.
 func UnmarshalParameter(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Parameter)
@@ -956,6 +1315,10 @@ func UnmarshalParameter(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "display_name", "description", "type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -995,6 +1358,10 @@ type ReplaceRuleOptions struct {
 	// The collection of import parameters.
 	Import *Import `json:"import,omitempty"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -1085,6 +1452,24 @@ func (options *ReplaceRuleOptions) SetHeaders(param map[string]string) *ReplaceR
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *ReplaceRuleOptions) SetAdditionalProperty(key string, value interface{}) *ReplaceRuleOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *ReplaceRuleOptions) SetAdditionalProperties(param map[string]interface{}) *ReplaceRuleOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // RequiredConfig : The required configurations.
 type RequiredConfig struct {
 	// The required config description.
@@ -1095,6 +1480,76 @@ type RequiredConfig struct {
 
 	// The `OR` required configurations.
 	Or []Or `json:"or,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RequiredConfig
+func (o *RequiredConfig) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RequiredConfig
+func (o *RequiredConfig) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RequiredConfig
+func (o *RequiredConfig) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RequiredConfig
+func (o *RequiredConfig) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RequiredConfig
+func (o *RequiredConfig) MarshalJSON() (buffer []byte, err error) {
+	type model RequiredConfig
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RequiredConfig is nil
+func (o *RequiredConfig) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetAnd returns And, or nil when the RequiredConfig is nil
+func (o *RequiredConfig) GetAnd() []And {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
+// GetOr returns Or, or nil when the RequiredConfig is nil
+func (o *RequiredConfig) GetOr() []Or {
+	if o == nil {
+		return nil
+	}
+	return o.Or
 }
 
 // UnmarshalRequiredConfig unmarshals an instance of RequiredConfig from the specified map of raw messages.
@@ -1112,6 +1567,10 @@ func UnmarshalRequiredConfig(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "and", "or")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1156,6 +1615,9 @@ type Rule struct {
 
 	// The list of labels.
 	Labels []string `json:"labels" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Rule.Type property.
@@ -1165,6 +1627,153 @@ const (
 	Rule_Type_UserDefined   = "user_defined"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Rule
+func (o *Rule) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Rule
+func (o *Rule) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Rule
+func (o *Rule) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Rule
+func (o *Rule) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Rule
+func (o *Rule) MarshalJSON() (buffer []byte, err error) {
+	type model Rule
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Rule is nil
+func (o *Rule) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Rule is nil
+func (o *Rule) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetUpdatedOn returns the value of UpdatedOn, or the zero value when UpdatedOn or the Rule is nil
+func (o *Rule) GetUpdatedOn() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedOn)
+}
+
+// GetUpdatedBy returns the value of UpdatedBy, or the zero value when UpdatedBy or the Rule is nil
+func (o *Rule) GetUpdatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedBy)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Rule is nil
+func (o *Rule) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the Rule is nil
+func (o *Rule) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Rule is nil
+func (o *Rule) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Rule is nil
+func (o *Rule) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetVersion returns the value of Version, or the zero value when Version or the Rule is nil
+func (o *Rule) GetVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Version)
+}
+
+// GetImport returns Import, or nil when the Rule is nil
+func (o *Rule) GetImport() *Import {
+	if o == nil {
+		return nil
+	}
+	return o.Import
+}
+
+// GetTarget returns Target, or nil when the Rule is nil
+func (o *Rule) GetTarget() *Target {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetRequiredConfig returns RequiredConfig, or nil when the Rule is nil
+func (o *Rule) GetRequiredConfig() *RequiredConfig {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredConfig
+}
+
+// GetLabels returns Labels, or nil when the Rule is nil
+func (o *Rule) GetLabels() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
 // UnmarshalRule unmarshals an instance of Rule from the specified map of raw messages.
 func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Rule)
@@ -1220,6 +1829,10 @@ func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "created_at", "created_by", "updated_on", "updated_by", "id", "account_id", "description", "type", "version", "import", "target", "required_config", "labels")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1228,6 +1841,60 @@ func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error)
 type Rules struct {
 	// The list of rules.
 	Rules []Rule `json:"rules,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Rules
+func (o *Rules) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Rules
+func (o *Rules) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Rules
+func (o *Rules) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Rules
+func (o *Rules) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Rules
+func (o *Rules) MarshalJSON() (buffer []byte, err error) {
+	type model Rules
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRules returns Rules, or nil when the Rules is nil
+func (o *Rules) GetRules() []Rule {
+	if o == nil {
+		return nil
+	}
+	return o.Rules
 }
 
 // UnmarshalRules unmarshals an instance of Rules from the specified map of raw messages.
@@ -1237,6 +1904,10 @@ func UnmarshalRules(m map[string]json.RawMessage, result interface{}) (err error
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "rules")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1254,6 +1925,9 @@ type Target struct {
 
 	// The list of targets supported properties.
 	AdditionalTargetAttributes []AdditionalTargetAttribute `json:"additional_target_attributes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewTarget : Instantiate Target (Generic Model Constructor)
@@ -1266,6 +1940,81 @@ func (*ConfigManagerV3) NewTarget(serviceName string, resourceKind string) (_mod
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Target
+func (o *Target) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Target
+func (o *Target) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Target
+func (o *Target) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Target
+func (o *Target) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Target
+func (o *Target) MarshalJSON() (buffer []byte, err error) {
+	type model Target
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetServiceName returns the value of ServiceName, or the zero value when ServiceName or the Target is nil
+func (o *Target) GetServiceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ServiceName)
+}
+
+// GetServiceDisplayName returns the value of ServiceDisplayName, or the zero value when ServiceDisplayName or the Target is nil
+func (o *Target) GetServiceDisplayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ServiceDisplayName)
+}
+
+// GetResourceKind returns the value of ResourceKind, or the zero value when ResourceKind or the Target is nil
+func (o *Target) GetResourceKind() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceKind)
+}
+
+// GetAdditionalTargetAttributes returns AdditionalTargetAttributes, or nil when the Target is nil
+func (o *Target) GetAdditionalTargetAttributes() []AdditionalTargetAttribute {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalTargetAttributes
+}
+
 // UnmarshalTarget unmarshals an instance of Target from the specified map of raw messages.
 func UnmarshalTarget(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Target)
@@ -1285,6 +2034,10 @@ func UnmarshalTarget(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "service_name", "service_display_name", "resource_kind", "additional_target_attributes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
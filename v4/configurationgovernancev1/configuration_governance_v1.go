@@ -454,6 +454,7 @@ func (configurationGovernance *ConfigurationGovernanceV1) UpdateRuleWithContext(
 	if updateRuleOptions.Labels != nil {
 		body["labels"] = updateRuleOptions.Labels
 	}
+	common.AddAdditionalProperties(body, updateRuleOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -799,6 +800,7 @@ func (configurationGovernance *ConfigurationGovernanceV1) UpdateRuleAttachmentWi
 	if updateRuleAttachmentOptions.ExcludedScopes != nil {
 		body["excluded_scopes"] = updateRuleAttachmentOptions.ExcludedScopes
 	}
+	common.AddAdditionalProperties(body, updateRuleAttachmentOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1150,6 +1152,7 @@ func (configurationGovernance *ConfigurationGovernanceV1) UpdateTemplateWithCont
 	if updateTemplateOptions.CustomizedDefaults != nil {
 		body["customized_defaults"] = updateTemplateOptions.CustomizedDefaults
 	}
+	common.AddAdditionalProperties(body, updateTemplateOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1496,6 +1499,7 @@ func (configurationGovernance *ConfigurationGovernanceV1) UpdateTemplateAttachme
 	if updateTemplateAttachmentOptions.ExcludedScopes != nil {
 		body["excluded_scopes"] = updateTemplateAttachmentOptions.ExcludedScopes
 	}
+	common.AddAdditionalProperties(body, updateTemplateAttachmentOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1587,6 +1591,9 @@ type BaseTargetAttribute struct {
 	// Options differ depending on the rule or template that you configure. For more information, refer to the service
 	// documentation.
 	Value *string `json:"value" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewBaseTargetAttribute : Instantiate BaseTargetAttribute (Generic Model Constructor)
@@ -1599,6 +1606,65 @@ func (*ConfigurationGovernanceV1) NewBaseTargetAttribute(name string, value stri
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of BaseTargetAttribute
+func (o *BaseTargetAttribute) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of BaseTargetAttribute
+func (o *BaseTargetAttribute) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of BaseTargetAttribute
+func (o *BaseTargetAttribute) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of BaseTargetAttribute
+func (o *BaseTargetAttribute) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of BaseTargetAttribute
+func (o *BaseTargetAttribute) MarshalJSON() (buffer []byte, err error) {
+	type model BaseTargetAttribute
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the BaseTargetAttribute is nil
+func (o *BaseTargetAttribute) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the BaseTargetAttribute is nil
+func (o *BaseTargetAttribute) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalBaseTargetAttribute unmarshals an instance of BaseTargetAttribute from the specified map of raw messages.
 func UnmarshalBaseTargetAttribute(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(BaseTargetAttribute)
@@ -1610,6 +1676,10 @@ func UnmarshalBaseTargetAttribute(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1668,6 +1738,60 @@ func (options *CreateRuleAttachmentsOptions) SetHeaders(param map[string]string)
 // CreateRuleAttachmentsResponse : CreateRuleAttachmentsResponse struct
 type CreateRuleAttachmentsResponse struct {
 	Attachments []RuleAttachment `json:"attachments" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateRuleAttachmentsResponse
+func (o *CreateRuleAttachmentsResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateRuleAttachmentsResponse
+func (o *CreateRuleAttachmentsResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateRuleAttachmentsResponse
+func (o *CreateRuleAttachmentsResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateRuleAttachmentsResponse
+func (o *CreateRuleAttachmentsResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateRuleAttachmentsResponse
+func (o *CreateRuleAttachmentsResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateRuleAttachmentsResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAttachments returns Attachments, or nil when the CreateRuleAttachmentsResponse is nil
+func (o *CreateRuleAttachmentsResponse) GetAttachments() []RuleAttachment {
+	if o == nil {
+		return nil
+	}
+	return o.Attachments
 }
 
 // UnmarshalCreateRuleAttachmentsResponse unmarshals an instance of CreateRuleAttachmentsResponse from the specified map of raw messages.
@@ -1677,6 +1801,10 @@ func UnmarshalCreateRuleAttachmentsResponse(m map[string]json.RawMessage, result
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "attachments")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1693,6 +1821,9 @@ type CreateRuleRequest struct {
 
 	// Properties that you can associate with a rule.
 	Rule *RuleRequest `json:"rule" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewCreateRuleRequest : Instantiate CreateRuleRequest (Generic Model Constructor)
@@ -1704,6 +1835,65 @@ func (*ConfigurationGovernanceV1) NewCreateRuleRequest(rule *RuleRequest) (_mode
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateRuleRequest
+func (o *CreateRuleRequest) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateRuleRequest
+func (o *CreateRuleRequest) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateRuleRequest
+func (o *CreateRuleRequest) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateRuleRequest
+func (o *CreateRuleRequest) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateRuleRequest
+func (o *CreateRuleRequest) MarshalJSON() (buffer []byte, err error) {
+	type model CreateRuleRequest
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRequestID returns the value of RequestID, or the zero value when RequestID or the CreateRuleRequest is nil
+func (o *CreateRuleRequest) GetRequestID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RequestID)
+}
+
+// GetRule returns Rule, or nil when the CreateRuleRequest is nil
+func (o *CreateRuleRequest) GetRule() *RuleRequest {
+	if o == nil {
+		return nil
+	}
+	return o.Rule
+}
+
 // UnmarshalCreateRuleRequest unmarshals an instance of CreateRuleRequest from the specified map of raw messages.
 func UnmarshalCreateRuleRequest(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(CreateRuleRequest)
@@ -1715,6 +1905,10 @@ func UnmarshalCreateRuleRequest(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "request_id", "rule")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1747,6 +1941,92 @@ type CreateRuleResponse struct {
 	//
 	// This field is present for unsuccessful requests.
 	Trace *string `json:"trace,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateRuleResponse
+func (o *CreateRuleResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateRuleResponse
+func (o *CreateRuleResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateRuleResponse
+func (o *CreateRuleResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateRuleResponse
+func (o *CreateRuleResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateRuleResponse
+func (o *CreateRuleResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateRuleResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRequestID returns the value of RequestID, or the zero value when RequestID or the CreateRuleResponse is nil
+func (o *CreateRuleResponse) GetRequestID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RequestID)
+}
+
+// GetStatusCode returns the value of StatusCode, or the zero value when StatusCode or the CreateRuleResponse is nil
+func (o *CreateRuleResponse) GetStatusCode() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.StatusCode)
+}
+
+// GetRule returns Rule, or nil when the CreateRuleResponse is nil
+func (o *CreateRuleResponse) GetRule() *Rule {
+	if o == nil {
+		return nil
+	}
+	return o.Rule
+}
+
+// GetErrors returns Errors, or nil when the CreateRuleResponse is nil
+func (o *CreateRuleResponse) GetErrors() []RuleResponseError {
+	if o == nil {
+		return nil
+	}
+	return o.Errors
+}
+
+// GetTrace returns the value of Trace, or the zero value when Trace or the CreateRuleResponse is nil
+func (o *CreateRuleResponse) GetTrace() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Trace)
 }
 
 // UnmarshalCreateRuleResponse unmarshals an instance of CreateRuleResponse from the specified map of raw messages.
@@ -1772,6 +2052,10 @@ func UnmarshalCreateRuleResponse(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "request_id", "status_code", "rule", "errors", "trace")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1822,6 +2106,60 @@ func (options *CreateRulesOptions) SetHeaders(param map[string]string) *CreateRu
 type CreateRulesResponse struct {
 	// An array of rule responses.
 	Rules []CreateRuleResponse `json:"rules" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateRulesResponse
+func (o *CreateRulesResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateRulesResponse
+func (o *CreateRulesResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateRulesResponse
+func (o *CreateRulesResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateRulesResponse
+func (o *CreateRulesResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateRulesResponse
+func (o *CreateRulesResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateRulesResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRules returns Rules, or nil when the CreateRulesResponse is nil
+func (o *CreateRulesResponse) GetRules() []CreateRuleResponse {
+	if o == nil {
+		return nil
+	}
+	return o.Rules
 }
 
 // UnmarshalCreateRulesResponse unmarshals an instance of CreateRulesResponse from the specified map of raw messages.
@@ -1831,6 +2169,10 @@ func UnmarshalCreateRulesResponse(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "rules")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1889,6 +2231,60 @@ func (options *CreateTemplateAttachmentsOptions) SetHeaders(param map[string]str
 // CreateTemplateAttachmentsResponse : CreateTemplateAttachmentsResponse struct
 type CreateTemplateAttachmentsResponse struct {
 	Attachments []TemplateAttachment `json:"attachments" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateTemplateAttachmentsResponse
+func (o *CreateTemplateAttachmentsResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateTemplateAttachmentsResponse
+func (o *CreateTemplateAttachmentsResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateTemplateAttachmentsResponse
+func (o *CreateTemplateAttachmentsResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateTemplateAttachmentsResponse
+func (o *CreateTemplateAttachmentsResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateTemplateAttachmentsResponse
+func (o *CreateTemplateAttachmentsResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateTemplateAttachmentsResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAttachments returns Attachments, or nil when the CreateTemplateAttachmentsResponse is nil
+func (o *CreateTemplateAttachmentsResponse) GetAttachments() []TemplateAttachment {
+	if o == nil {
+		return nil
+	}
+	return o.Attachments
 }
 
 // UnmarshalCreateTemplateAttachmentsResponse unmarshals an instance of CreateTemplateAttachmentsResponse from the specified map of raw messages.
@@ -1898,6 +2294,10 @@ func UnmarshalCreateTemplateAttachmentsResponse(m map[string]json.RawMessage, re
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "attachments")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1915,6 +2315,9 @@ type CreateTemplateRequest struct {
 
 	// Properties that you can associate with a template.
 	Template *Template `json:"template" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewCreateTemplateRequest : Instantiate CreateTemplateRequest (Generic Model Constructor)
@@ -1926,6 +2329,65 @@ func (*ConfigurationGovernanceV1) NewCreateTemplateRequest(template *Template) (
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateTemplateRequest
+func (o *CreateTemplateRequest) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateTemplateRequest
+func (o *CreateTemplateRequest) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateTemplateRequest
+func (o *CreateTemplateRequest) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateTemplateRequest
+func (o *CreateTemplateRequest) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateTemplateRequest
+func (o *CreateTemplateRequest) MarshalJSON() (buffer []byte, err error) {
+	type model CreateTemplateRequest
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRequestID returns the value of RequestID, or the zero value when RequestID or the CreateTemplateRequest is nil
+func (o *CreateTemplateRequest) GetRequestID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RequestID)
+}
+
+// GetTemplate returns Template, or nil when the CreateTemplateRequest is nil
+func (o *CreateTemplateRequest) GetTemplate() *Template {
+	if o == nil {
+		return nil
+	}
+	return o.Template
+}
+
 // UnmarshalCreateTemplateRequest unmarshals an instance of CreateTemplateRequest from the specified map of raw messages.
 func UnmarshalCreateTemplateRequest(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(CreateTemplateRequest)
@@ -1937,6 +2399,10 @@ func UnmarshalCreateTemplateRequest(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "request_id", "template")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1970,6 +2436,92 @@ type CreateTemplateResponse struct {
 	//
 	// This field is present for unsuccessful requests.
 	Trace *string `json:"trace,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateTemplateResponse
+func (o *CreateTemplateResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateTemplateResponse
+func (o *CreateTemplateResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateTemplateResponse
+func (o *CreateTemplateResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateTemplateResponse
+func (o *CreateTemplateResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateTemplateResponse
+func (o *CreateTemplateResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateTemplateResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetRequestID returns the value of RequestID, or the zero value when RequestID or the CreateTemplateResponse is nil
+func (o *CreateTemplateResponse) GetRequestID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RequestID)
+}
+
+// GetStatusCode returns the value of StatusCode, or the zero value when StatusCode or the CreateTemplateResponse is nil
+func (o *CreateTemplateResponse) GetStatusCode() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.StatusCode)
+}
+
+// GetTemplate returns Template, or nil when the CreateTemplateResponse is nil
+func (o *CreateTemplateResponse) GetTemplate() *Template {
+	if o == nil {
+		return nil
+	}
+	return o.Template
+}
+
+// GetErrors returns Errors, or nil when the CreateTemplateResponse is nil
+func (o *CreateTemplateResponse) GetErrors() []TemplateResponseError {
+	if o == nil {
+		return nil
+	}
+	return o.Errors
+}
+
+// GetTrace returns the value of Trace, or the zero value when Trace or the CreateTemplateResponse is nil
+func (o *CreateTemplateResponse) GetTrace() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Trace)
 }
 
 // UnmarshalCreateTemplateResponse unmarshals an instance of CreateTemplateResponse from the specified map of raw messages.
@@ -1995,7 +2547,11 @@ func UnmarshalCreateTemplateResponse(m map[string]json.RawMessage, result interf
 	if err != nil {
 		return
 	}
-	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "request_id", "status_code", "template", "errors", "trace")
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
 
@@ -2045,6 +2601,60 @@ func (options *CreateTemplatesOptions) SetHeaders(param map[string]string) *Crea
 type CreateTemplatesResponse struct {
 	// An array of template responses.
 	Templates []CreateTemplateResponse `json:"templates" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CreateTemplatesResponse
+func (o *CreateTemplatesResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CreateTemplatesResponse
+func (o *CreateTemplatesResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CreateTemplatesResponse
+func (o *CreateTemplatesResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CreateTemplatesResponse
+func (o *CreateTemplatesResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CreateTemplatesResponse
+func (o *CreateTemplatesResponse) MarshalJSON() (buffer []byte, err error) {
+	type model CreateTemplatesResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetTemplates returns Templates, or nil when the CreateTemplatesResponse is nil
+func (o *CreateTemplatesResponse) GetTemplates() []CreateTemplateResponse {
+	if o == nil {
+		return nil
+	}
+	return o.Templates
 }
 
 // UnmarshalCreateTemplatesResponse unmarshals an instance of CreateTemplatesResponse from the specified map of raw messages.
@@ -2054,6 +2664,10 @@ func UnmarshalCreateTemplatesResponse(m map[string]json.RawMessage, result inter
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "templates")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2251,6 +2865,9 @@ type EnforcementAction struct {
 	// To block a request from completing, use `disallow`. To log the request to Activity Tracker with LogDNA, use
 	// `audit_log`.
 	Action *string `json:"action" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the EnforcementAction.Action property.
@@ -2270,6 +2887,57 @@ func (*ConfigurationGovernanceV1) NewEnforcementAction(action string) (_model *E
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EnforcementAction
+func (o *EnforcementAction) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EnforcementAction
+func (o *EnforcementAction) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EnforcementAction
+func (o *EnforcementAction) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EnforcementAction
+func (o *EnforcementAction) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EnforcementAction
+func (o *EnforcementAction) MarshalJSON() (buffer []byte, err error) {
+	type model EnforcementAction
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAction returns the value of Action, or the zero value when Action or the EnforcementAction is nil
+func (o *EnforcementAction) GetAction() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Action)
+}
+
 // UnmarshalEnforcementAction unmarshals an instance of EnforcementAction from the specified map of raw messages.
 func UnmarshalEnforcementAction(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(EnforcementAction)
@@ -2277,6 +2945,10 @@ func UnmarshalEnforcementAction(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "action")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2473,6 +3145,60 @@ func (options *GetTemplateOptions) SetHeaders(param map[string]string) *GetTempl
 type Link struct {
 	// The URL for the first, previous, next, or last page of resources.
 	Href *string `json:"href" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Link
+func (o *Link) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Link
+func (o *Link) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Link
+func (o *Link) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Link
+func (o *Link) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Link
+func (o *Link) MarshalJSON() (buffer []byte, err error) {
+	type model Link
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the Link is nil
+func (o *Link) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalLink unmarshals an instance of Link from the specified map of raw messages.
@@ -2482,6 +3208,10 @@ func UnmarshalLink(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2848,6 +3578,9 @@ type Rule struct {
 
 	// The number of scope attachments that are associated with the rule.
 	NumberOfAttachments *int64 `json:"number_of_attachments,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Rule.RuleType property.
@@ -2856,6 +3589,161 @@ const (
 	RuleRuleTypeUserDefinedConst = "user_defined"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Rule
+func (o *Rule) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Rule
+func (o *Rule) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Rule
+func (o *Rule) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Rule
+func (o *Rule) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Rule
+func (o *Rule) MarshalJSON() (buffer []byte, err error) {
+	type model Rule
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the Rule is nil
+func (o *Rule) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Rule is nil
+func (o *Rule) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Rule is nil
+func (o *Rule) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetRuleType returns the value of RuleType, or the zero value when RuleType or the Rule is nil
+func (o *Rule) GetRuleType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RuleType)
+}
+
+// GetTarget returns Target, or nil when the Rule is nil
+func (o *Rule) GetTarget() *TargetResource {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetRequiredConfig returns RequiredConfig, or nil when the Rule is nil
+func (o *Rule) GetRequiredConfig() RuleRequiredConfigIntf {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredConfig
+}
+
+// GetEnforcementActions returns EnforcementActions, or nil when the Rule is nil
+func (o *Rule) GetEnforcementActions() []EnforcementAction {
+	if o == nil {
+		return nil
+	}
+	return o.EnforcementActions
+}
+
+// GetLabels returns Labels, or nil when the Rule is nil
+func (o *Rule) GetLabels() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
+// GetRuleID returns the value of RuleID, or the zero value when RuleID or the Rule is nil
+func (o *Rule) GetRuleID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RuleID)
+}
+
+// GetCreationDate returns the value of CreationDate, or the zero value when CreationDate or the Rule is nil
+func (o *Rule) GetCreationDate() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreationDate)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Rule is nil
+func (o *Rule) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModificationDate returns the value of ModificationDate, or the zero value when ModificationDate or the Rule is nil
+func (o *Rule) GetModificationDate() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ModificationDate)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the Rule is nil
+func (o *Rule) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetNumberOfAttachments returns the value of NumberOfAttachments, or the zero value when NumberOfAttachments or the Rule is nil
+func (o *Rule) GetNumberOfAttachments() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NumberOfAttachments)
+}
+
 // UnmarshalRule unmarshals an instance of Rule from the specified map of raw messages.
 func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Rule)
@@ -2915,6 +3803,10 @@ func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "name", "description", "rule_type", "target", "required_config", "enforcement_actions", "labels", "rule_id", "creation_date", "created_by", "modification_date", "modified_by", "number_of_attachments")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2935,6 +3827,92 @@ type RuleAttachment struct {
 
 	// The extent at which the rule can be excluded from the included scope.
 	ExcludedScopes []RuleScope `json:"excluded_scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleAttachment
+func (o *RuleAttachment) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleAttachment
+func (o *RuleAttachment) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleAttachment
+func (o *RuleAttachment) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleAttachment
+func (o *RuleAttachment) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleAttachment
+func (o *RuleAttachment) MarshalJSON() (buffer []byte, err error) {
+	type model RuleAttachment
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAttachmentID returns the value of AttachmentID, or the zero value when AttachmentID or the RuleAttachment is nil
+func (o *RuleAttachment) GetAttachmentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AttachmentID)
+}
+
+// GetRuleID returns the value of RuleID, or the zero value when RuleID or the RuleAttachment is nil
+func (o *RuleAttachment) GetRuleID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RuleID)
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the RuleAttachment is nil
+func (o *RuleAttachment) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetIncludedScope returns IncludedScope, or nil when the RuleAttachment is nil
+func (o *RuleAttachment) GetIncludedScope() *RuleScope {
+	if o == nil {
+		return nil
+	}
+	return o.IncludedScope
+}
+
+// GetExcludedScopes returns ExcludedScopes, or nil when the RuleAttachment is nil
+func (o *RuleAttachment) GetExcludedScopes() []RuleScope {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedScopes
 }
 
 // UnmarshalRuleAttachment unmarshals an instance of RuleAttachment from the specified map of raw messages.
@@ -2960,6 +3938,10 @@ func UnmarshalRuleAttachment(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "attachment_id", "rule_id", "account_id", "included_scope", "excluded_scopes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2982,6 +3964,100 @@ type RuleAttachmentList struct {
 	Last *Link `json:"last" validate:"required"`
 
 	Attachments []RuleAttachment `json:"attachments" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleAttachmentList
+func (o *RuleAttachmentList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleAttachmentList
+func (o *RuleAttachmentList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleAttachmentList
+func (o *RuleAttachmentList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleAttachmentList
+func (o *RuleAttachmentList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleAttachmentList
+func (o *RuleAttachmentList) MarshalJSON() (buffer []byte, err error) {
+	type model RuleAttachmentList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetFirst() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetLast() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetAttachments returns Attachments, or nil when the RuleAttachmentList is nil
+func (o *RuleAttachmentList) GetAttachments() []RuleAttachment {
+	if o == nil {
+		return nil
+	}
+	return o.Attachments
 }
 
 // UnmarshalRuleAttachmentList unmarshals an instance of RuleAttachmentList from the specified map of raw messages.
@@ -3011,6 +4087,10 @@ func UnmarshalRuleAttachmentList(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "attachments")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3025,6 +4105,9 @@ type RuleAttachmentRequest struct {
 
 	// The extent at which the rule can be excluded from the included scope.
 	ExcludedScopes []RuleScope `json:"excluded_scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewRuleAttachmentRequest : Instantiate RuleAttachmentRequest (Generic Model Constructor)
@@ -3037,19 +4120,90 @@ func (*ConfigurationGovernanceV1) NewRuleAttachmentRequest(accountID string, inc
 	return
 }
 
-// UnmarshalRuleAttachmentRequest unmarshals an instance of RuleAttachmentRequest from the specified map of raw messages.
-func UnmarshalRuleAttachmentRequest(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(RuleAttachmentRequest)
-	err = core.UnmarshalPrimitive(m, "account_id", &obj.AccountID)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleAttachmentRequest
+func (o *RuleAttachmentRequest) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalModel(m, "included_scope", &obj.IncludedScope, UnmarshalRuleScope)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleAttachmentRequest
+func (o *RuleAttachmentRequest) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalModel(m, "excluded_scopes", &obj.ExcludedScopes, UnmarshalRuleScope)
-	if err != nil {
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleAttachmentRequest
+func (o *RuleAttachmentRequest) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleAttachmentRequest
+func (o *RuleAttachmentRequest) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleAttachmentRequest
+func (o *RuleAttachmentRequest) MarshalJSON() (buffer []byte, err error) {
+	type model RuleAttachmentRequest
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the RuleAttachmentRequest is nil
+func (o *RuleAttachmentRequest) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetIncludedScope returns IncludedScope, or nil when the RuleAttachmentRequest is nil
+func (o *RuleAttachmentRequest) GetIncludedScope() *RuleScope {
+	if o == nil {
+		return nil
+	}
+	return o.IncludedScope
+}
+
+// GetExcludedScopes returns ExcludedScopes, or nil when the RuleAttachmentRequest is nil
+func (o *RuleAttachmentRequest) GetExcludedScopes() []RuleScope {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedScopes
+}
+
+// UnmarshalRuleAttachmentRequest unmarshals an instance of RuleAttachmentRequest from the specified map of raw messages.
+func UnmarshalRuleAttachmentRequest(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(RuleAttachmentRequest)
+	err = core.UnmarshalPrimitive(m, "account_id", &obj.AccountID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "included_scope", &obj.IncludedScope, UnmarshalRuleScope)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "excluded_scopes", &obj.ExcludedScopes, UnmarshalRuleScope)
+	if err != nil {
+		return
+	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "included_scope", "excluded_scopes")
+	if err != nil {
 		return
 	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
@@ -3083,6 +4237,9 @@ type RuleCondition struct {
 	Or []RuleSingleProperty `json:"or,omitempty"`
 
 	And []RuleSingleProperty `json:"and,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleCondition.Operator property.
@@ -3116,6 +4273,97 @@ type RuleConditionIntf interface {
 	isaRuleCondition() bool
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleCondition
+func (o *RuleCondition) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleCondition
+func (o *RuleCondition) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleCondition
+func (o *RuleCondition) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleCondition
+func (o *RuleCondition) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleCondition
+func (o *RuleCondition) MarshalJSON() (buffer []byte, err error) {
+	type model RuleCondition
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleCondition is nil
+func (o *RuleCondition) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the RuleCondition is nil
+func (o *RuleCondition) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the RuleCondition is nil
+func (o *RuleCondition) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the RuleCondition is nil
+func (o *RuleCondition) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
+// GetOr returns Or, or nil when the RuleCondition is nil
+func (o *RuleCondition) GetOr() []RuleSingleProperty {
+	if o == nil {
+		return nil
+	}
+	return o.Or
+}
+
+// GetAnd returns And, or nil when the RuleCondition is nil
+func (o *RuleCondition) GetAnd() []RuleSingleProperty {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
 // UnmarshalRuleCondition unmarshals an instance of RuleCondition from the specified map of raw messages.
 func UnmarshalRuleCondition(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleCondition)
@@ -3143,6 +4391,10 @@ func UnmarshalRuleCondition(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "property", "operator", "value", "or", "and")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3166,6 +4418,100 @@ type RuleList struct {
 
 	// An array of rules.
 	Rules []Rule `json:"rules" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleList
+func (o *RuleList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleList
+func (o *RuleList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleList
+func (o *RuleList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleList
+func (o *RuleList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleList
+func (o *RuleList) MarshalJSON() (buffer []byte, err error) {
+	type model RuleList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the RuleList is nil
+func (o *RuleList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the RuleList is nil
+func (o *RuleList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the RuleList is nil
+func (o *RuleList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the RuleList is nil
+func (o *RuleList) GetFirst() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the RuleList is nil
+func (o *RuleList) GetLast() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetRules returns Rules, or nil when the RuleList is nil
+func (o *RuleList) GetRules() []Rule {
+	if o == nil {
+		return nil
+	}
+	return o.Rules
 }
 
 // UnmarshalRuleList unmarshals an instance of RuleList from the specified map of raw messages.
@@ -3195,6 +4541,10 @@ func UnmarshalRuleList(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "rules")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3226,6 +4576,9 @@ type RuleRequest struct {
 	// Labels that you can use to group and search for similar rules, such as those that help you to meet a specific
 	// organization guideline.
 	Labels []string `json:"labels,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleRequest.RuleType property.
@@ -3247,6 +4600,113 @@ func (*ConfigurationGovernanceV1) NewRuleRequest(name string, description string
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequest
+func (o *RuleRequest) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequest
+func (o *RuleRequest) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequest
+func (o *RuleRequest) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequest
+func (o *RuleRequest) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequest
+func (o *RuleRequest) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequest
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the RuleRequest is nil
+func (o *RuleRequest) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the RuleRequest is nil
+func (o *RuleRequest) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequest is nil
+func (o *RuleRequest) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetRuleType returns the value of RuleType, or the zero value when RuleType or the RuleRequest is nil
+func (o *RuleRequest) GetRuleType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RuleType)
+}
+
+// GetTarget returns Target, or nil when the RuleRequest is nil
+func (o *RuleRequest) GetTarget() *TargetResource {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetRequiredConfig returns RequiredConfig, or nil when the RuleRequest is nil
+func (o *RuleRequest) GetRequiredConfig() RuleRequiredConfigIntf {
+	if o == nil {
+		return nil
+	}
+	return o.RequiredConfig
+}
+
+// GetEnforcementActions returns EnforcementActions, or nil when the RuleRequest is nil
+func (o *RuleRequest) GetEnforcementActions() []EnforcementAction {
+	if o == nil {
+		return nil
+	}
+	return o.EnforcementActions
+}
+
+// GetLabels returns Labels, or nil when the RuleRequest is nil
+func (o *RuleRequest) GetLabels() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
+}
+
 // UnmarshalRuleRequest unmarshals an instance of RuleRequest from the specified map of raw messages.
 func UnmarshalRuleRequest(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequest)
@@ -3282,6 +4742,10 @@ func UnmarshalRuleRequest(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "name", "description", "rule_type", "target", "required_config", "enforcement_actions", "labels")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3312,6 +4776,9 @@ type RuleRequiredConfig struct {
 	Or []RuleConditionIntf `json:"or,omitempty"`
 
 	And []RuleConditionIntf `json:"and,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleRequiredConfig.Operator property.
@@ -3345,6 +4812,97 @@ type RuleRequiredConfigIntf interface {
 	isaRuleRequiredConfig() bool
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequiredConfig
+func (o *RuleRequiredConfig) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequiredConfig
+func (o *RuleRequiredConfig) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequiredConfig
+func (o *RuleRequiredConfig) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequiredConfig
+func (o *RuleRequiredConfig) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequiredConfig
+func (o *RuleRequiredConfig) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequiredConfig
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
+// GetOr returns Or, or nil when the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetOr() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.Or
+}
+
+// GetAnd returns And, or nil when the RuleRequiredConfig is nil
+func (o *RuleRequiredConfig) GetAnd() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
 // UnmarshalRuleRequiredConfig unmarshals an instance of RuleRequiredConfig from the specified map of raw messages.
 func UnmarshalRuleRequiredConfig(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequiredConfig)
@@ -3372,6 +4930,10 @@ func UnmarshalRuleRequiredConfig(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "property", "operator", "value", "or", "and")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3383,6 +4945,68 @@ type RuleResponseError struct {
 
 	// Describes the problem.
 	Message *string `json:"message" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleResponseError
+func (o *RuleResponseError) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleResponseError
+func (o *RuleResponseError) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleResponseError
+func (o *RuleResponseError) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleResponseError
+func (o *RuleResponseError) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleResponseError
+func (o *RuleResponseError) MarshalJSON() (buffer []byte, err error) {
+	type model RuleResponseError
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCode returns the value of Code, or the zero value when Code or the RuleResponseError is nil
+func (o *RuleResponseError) GetCode() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Code)
+}
+
+// GetMessage returns the value of Message, or the zero value when Message or the RuleResponseError is nil
+func (o *RuleResponseError) GetMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Message)
 }
 
 // UnmarshalRuleResponseError unmarshals an instance of RuleResponseError from the specified map of raw messages.
@@ -3396,7 +5020,11 @@ func UnmarshalRuleResponseError(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
-	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "code", "message")
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
 
@@ -3410,6 +5038,9 @@ type RuleScope struct {
 
 	// The type of scope that you want to evaluate.
 	ScopeType *string `json:"scope_type" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleScope.ScopeType property.
@@ -3432,6 +5063,73 @@ func (*ConfigurationGovernanceV1) NewRuleScope(scopeID string, scopeType string)
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleScope
+func (o *RuleScope) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleScope
+func (o *RuleScope) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleScope
+func (o *RuleScope) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleScope
+func (o *RuleScope) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleScope
+func (o *RuleScope) MarshalJSON() (buffer []byte, err error) {
+	type model RuleScope
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetNote returns the value of Note, or the zero value when Note or the RuleScope is nil
+func (o *RuleScope) GetNote() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Note)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the RuleScope is nil
+func (o *RuleScope) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeType returns the value of ScopeType, or the zero value when ScopeType or the RuleScope is nil
+func (o *RuleScope) GetScopeType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeType)
+}
+
 // UnmarshalRuleScope unmarshals an instance of RuleScope from the specified map of raw messages.
 func UnmarshalRuleScope(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleScope)
@@ -3447,6 +5145,10 @@ func UnmarshalRuleScope(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "note", "scope_id", "scope_type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3473,6 +5175,9 @@ type RuleSingleProperty struct {
 	// Value options differ depending on the rule that you configure. If you use a boolean operator, you do not need to
 	// input a value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleSingleProperty.Operator property.
@@ -3508,6 +5213,81 @@ func (*ConfigurationGovernanceV1) NewRuleSingleProperty(property string, operato
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleSingleProperty
+func (o *RuleSingleProperty) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleSingleProperty
+func (o *RuleSingleProperty) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleSingleProperty
+func (o *RuleSingleProperty) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleSingleProperty
+func (o *RuleSingleProperty) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleSingleProperty
+func (o *RuleSingleProperty) MarshalJSON() (buffer []byte, err error) {
+	type model RuleSingleProperty
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleSingleProperty is nil
+func (o *RuleSingleProperty) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the RuleSingleProperty is nil
+func (o *RuleSingleProperty) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the RuleSingleProperty is nil
+func (o *RuleSingleProperty) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the RuleSingleProperty is nil
+func (o *RuleSingleProperty) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalRuleSingleProperty unmarshals an instance of RuleSingleProperty from the specified map of raw messages.
 func UnmarshalRuleSingleProperty(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleSingleProperty)
@@ -3527,6 +5307,10 @@ func UnmarshalRuleSingleProperty(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "property", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3542,6 +5326,9 @@ type SimpleTargetResource struct {
 	// An extra qualifier for the resource kind. When you include additional attributes, only the resources that match the
 	// definition are included in the rule or template.
 	AdditionalTargetAttributes []BaseTargetAttribute `json:"additional_target_attributes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSimpleTargetResource : Instantiate SimpleTargetResource (Generic Model Constructor)
@@ -3554,6 +5341,73 @@ func (*ConfigurationGovernanceV1) NewSimpleTargetResource(serviceName string, re
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SimpleTargetResource
+func (o *SimpleTargetResource) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SimpleTargetResource
+func (o *SimpleTargetResource) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SimpleTargetResource
+func (o *SimpleTargetResource) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SimpleTargetResource
+func (o *SimpleTargetResource) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SimpleTargetResource
+func (o *SimpleTargetResource) MarshalJSON() (buffer []byte, err error) {
+	type model SimpleTargetResource
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetServiceName returns the value of ServiceName, or the zero value when ServiceName or the SimpleTargetResource is nil
+func (o *SimpleTargetResource) GetServiceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ServiceName)
+}
+
+// GetResourceKind returns the value of ResourceKind, or the zero value when ResourceKind or the SimpleTargetResource is nil
+func (o *SimpleTargetResource) GetResourceKind() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceKind)
+}
+
+// GetAdditionalTargetAttributes returns AdditionalTargetAttributes, or nil when the SimpleTargetResource is nil
+func (o *SimpleTargetResource) GetAdditionalTargetAttributes() []BaseTargetAttribute {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalTargetAttributes
+}
+
 // UnmarshalSimpleTargetResource unmarshals an instance of SimpleTargetResource from the specified map of raw messages.
 func UnmarshalSimpleTargetResource(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SimpleTargetResource)
@@ -3569,6 +5423,10 @@ func UnmarshalSimpleTargetResource(m map[string]json.RawMessage, result interfac
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "service_name", "resource_kind", "additional_target_attributes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3584,6 +5442,9 @@ type TargetResource struct {
 	// An extra qualifier for the resource kind. When you include additional attributes, only the resources that match the
 	// definition are included in the rule or template.
 	AdditionalTargetAttributes []TargetResourceAdditionalTargetAttributesItem `json:"additional_target_attributes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewTargetResource : Instantiate TargetResource (Generic Model Constructor)
@@ -3596,6 +5457,73 @@ func (*ConfigurationGovernanceV1) NewTargetResource(serviceName string, resource
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TargetResource
+func (o *TargetResource) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TargetResource
+func (o *TargetResource) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TargetResource
+func (o *TargetResource) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TargetResource
+func (o *TargetResource) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TargetResource
+func (o *TargetResource) MarshalJSON() (buffer []byte, err error) {
+	type model TargetResource
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetServiceName returns the value of ServiceName, or the zero value when ServiceName or the TargetResource is nil
+func (o *TargetResource) GetServiceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ServiceName)
+}
+
+// GetResourceKind returns the value of ResourceKind, or the zero value when ResourceKind or the TargetResource is nil
+func (o *TargetResource) GetResourceKind() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceKind)
+}
+
+// GetAdditionalTargetAttributes returns AdditionalTargetAttributes, or nil when the TargetResource is nil
+func (o *TargetResource) GetAdditionalTargetAttributes() []TargetResourceAdditionalTargetAttributesItem {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalTargetAttributes
+}
+
 // UnmarshalTargetResource unmarshals an instance of TargetResource from the specified map of raw messages.
 func UnmarshalTargetResource(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TargetResource)
@@ -3611,6 +5539,10 @@ func UnmarshalTargetResource(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "service_name", "resource_kind", "additional_target_attributes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3633,6 +5565,9 @@ type TargetResourceAdditionalTargetAttributesItem struct {
 	//
 	// There are three types of operators: string, numeric, and boolean.
 	Operator *string `json:"operator" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the TargetResourceAdditionalTargetAttributesItem.Operator property.
@@ -3669,6 +5604,73 @@ func (*ConfigurationGovernanceV1) NewTargetResourceAdditionalTargetAttributesIte
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TargetResourceAdditionalTargetAttributesItem
+func (o *TargetResourceAdditionalTargetAttributesItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TargetResourceAdditionalTargetAttributesItem
+func (o *TargetResourceAdditionalTargetAttributesItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TargetResourceAdditionalTargetAttributesItem
+func (o *TargetResourceAdditionalTargetAttributesItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TargetResourceAdditionalTargetAttributesItem
+func (o *TargetResourceAdditionalTargetAttributesItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TargetResourceAdditionalTargetAttributesItem
+func (o *TargetResourceAdditionalTargetAttributesItem) MarshalJSON() (buffer []byte, err error) {
+	type model TargetResourceAdditionalTargetAttributesItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the TargetResourceAdditionalTargetAttributesItem is nil
+func (o *TargetResourceAdditionalTargetAttributesItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the TargetResourceAdditionalTargetAttributesItem is nil
+func (o *TargetResourceAdditionalTargetAttributesItem) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the TargetResourceAdditionalTargetAttributesItem is nil
+func (o *TargetResourceAdditionalTargetAttributesItem) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
 // UnmarshalTargetResourceAdditionalTargetAttributesItem unmarshals an instance of TargetResourceAdditionalTargetAttributesItem from the specified map of raw messages.
 func UnmarshalTargetResourceAdditionalTargetAttributesItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TargetResourceAdditionalTargetAttributesItem)
@@ -3684,6 +5686,10 @@ func UnmarshalTargetResourceAdditionalTargetAttributesItem(m map[string]json.Raw
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "value", "operator")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3708,6 +5714,9 @@ type Template struct {
 
 	// A list of default property values to apply to your template.
 	CustomizedDefaults []TemplateCustomizedDefaultProperty `json:"customized_defaults" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewTemplate : Instantiate Template (Generic Model Constructor)
@@ -3723,6 +5732,97 @@ func (*ConfigurationGovernanceV1) NewTemplate(accountID string, name string, des
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Template
+func (o *Template) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Template
+func (o *Template) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Template
+func (o *Template) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Template
+func (o *Template) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Template
+func (o *Template) MarshalJSON() (buffer []byte, err error) {
+	type model Template
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the Template is nil
+func (o *Template) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Template is nil
+func (o *Template) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Template is nil
+func (o *Template) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetTemplateID returns the value of TemplateID, or the zero value when TemplateID or the Template is nil
+func (o *Template) GetTemplateID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TemplateID)
+}
+
+// GetTarget returns Target, or nil when the Template is nil
+func (o *Template) GetTarget() *SimpleTargetResource {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetCustomizedDefaults returns CustomizedDefaults, or nil when the Template is nil
+func (o *Template) GetCustomizedDefaults() []TemplateCustomizedDefaultProperty {
+	if o == nil {
+		return nil
+	}
+	return o.CustomizedDefaults
+}
+
 // UnmarshalTemplate unmarshals an instance of Template from the specified map of raw messages.
 func UnmarshalTemplate(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Template)
@@ -3750,6 +5850,10 @@ func UnmarshalTemplate(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "name", "description", "template_id", "target", "customized_defaults")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3769,6 +5873,92 @@ type TemplateAttachment struct {
 	IncludedScope *TemplateScope `json:"included_scope" validate:"required"`
 
 	ExcludedScopes []TemplateScope `json:"excluded_scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateAttachment
+func (o *TemplateAttachment) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateAttachment
+func (o *TemplateAttachment) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateAttachment
+func (o *TemplateAttachment) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateAttachment
+func (o *TemplateAttachment) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateAttachment
+func (o *TemplateAttachment) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateAttachment
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAttachmentID returns the value of AttachmentID, or the zero value when AttachmentID or the TemplateAttachment is nil
+func (o *TemplateAttachment) GetAttachmentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AttachmentID)
+}
+
+// GetTemplateID returns the value of TemplateID, or the zero value when TemplateID or the TemplateAttachment is nil
+func (o *TemplateAttachment) GetTemplateID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TemplateID)
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the TemplateAttachment is nil
+func (o *TemplateAttachment) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetIncludedScope returns IncludedScope, or nil when the TemplateAttachment is nil
+func (o *TemplateAttachment) GetIncludedScope() *TemplateScope {
+	if o == nil {
+		return nil
+	}
+	return o.IncludedScope
+}
+
+// GetExcludedScopes returns ExcludedScopes, or nil when the TemplateAttachment is nil
+func (o *TemplateAttachment) GetExcludedScopes() []TemplateScope {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedScopes
 }
 
 // UnmarshalTemplateAttachment unmarshals an instance of TemplateAttachment from the specified map of raw messages.
@@ -3794,6 +5984,10 @@ func UnmarshalTemplateAttachment(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "attachment_id", "template_id", "account_id", "included_scope", "excluded_scopes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3815,7 +6009,101 @@ type TemplateAttachmentList struct {
 	// The last page of available items.
 	Last *Link `json:"last" validate:"required"`
 
-	Attachments []TemplateAttachment `json:"attachments" validate:"required"`
+	Attachments []TemplateAttachment `json:"attachments" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateAttachmentList
+func (o *TemplateAttachmentList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateAttachmentList
+func (o *TemplateAttachmentList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateAttachmentList
+func (o *TemplateAttachmentList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateAttachmentList
+func (o *TemplateAttachmentList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateAttachmentList
+func (o *TemplateAttachmentList) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateAttachmentList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetFirst() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetLast() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetAttachments returns Attachments, or nil when the TemplateAttachmentList is nil
+func (o *TemplateAttachmentList) GetAttachments() []TemplateAttachment {
+	if o == nil {
+		return nil
+	}
+	return o.Attachments
 }
 
 // UnmarshalTemplateAttachmentList unmarshals an instance of TemplateAttachmentList from the specified map of raw messages.
@@ -3845,6 +6133,10 @@ func UnmarshalTemplateAttachmentList(m map[string]json.RawMessage, result interf
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "attachments")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3858,6 +6150,9 @@ type TemplateAttachmentRequest struct {
 	IncludedScope *TemplateScope `json:"included_scope" validate:"required"`
 
 	ExcludedScopes []TemplateScope `json:"excluded_scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewTemplateAttachmentRequest : Instantiate TemplateAttachmentRequest (Generic Model Constructor)
@@ -3870,6 +6165,73 @@ func (*ConfigurationGovernanceV1) NewTemplateAttachmentRequest(accountID string,
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateAttachmentRequest
+func (o *TemplateAttachmentRequest) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateAttachmentRequest
+func (o *TemplateAttachmentRequest) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateAttachmentRequest
+func (o *TemplateAttachmentRequest) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateAttachmentRequest
+func (o *TemplateAttachmentRequest) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateAttachmentRequest
+func (o *TemplateAttachmentRequest) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateAttachmentRequest
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the TemplateAttachmentRequest is nil
+func (o *TemplateAttachmentRequest) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetIncludedScope returns IncludedScope, or nil when the TemplateAttachmentRequest is nil
+func (o *TemplateAttachmentRequest) GetIncludedScope() *TemplateScope {
+	if o == nil {
+		return nil
+	}
+	return o.IncludedScope
+}
+
+// GetExcludedScopes returns ExcludedScopes, or nil when the TemplateAttachmentRequest is nil
+func (o *TemplateAttachmentRequest) GetExcludedScopes() []TemplateScope {
+	if o == nil {
+		return nil
+	}
+	return o.ExcludedScopes
+}
+
 // UnmarshalTemplateAttachmentRequest unmarshals an instance of TemplateAttachmentRequest from the specified map of raw messages.
 func UnmarshalTemplateAttachmentRequest(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TemplateAttachmentRequest)
@@ -3885,6 +6247,10 @@ func UnmarshalTemplateAttachmentRequest(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "included_scope", "excluded_scopes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3903,6 +6269,9 @@ type TemplateCustomizedDefaultProperty struct {
 	// options differ depending on the resource that you are configuring. To learn more about your options, refer to the
 	// service documentation.
 	Value *string `json:"value" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewTemplateCustomizedDefaultProperty : Instantiate TemplateCustomizedDefaultProperty (Generic Model Constructor)
@@ -3915,6 +6284,65 @@ func (*ConfigurationGovernanceV1) NewTemplateCustomizedDefaultProperty(property
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateCustomizedDefaultProperty
+func (o *TemplateCustomizedDefaultProperty) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateCustomizedDefaultProperty
+func (o *TemplateCustomizedDefaultProperty) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateCustomizedDefaultProperty
+func (o *TemplateCustomizedDefaultProperty) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateCustomizedDefaultProperty
+func (o *TemplateCustomizedDefaultProperty) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateCustomizedDefaultProperty
+func (o *TemplateCustomizedDefaultProperty) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateCustomizedDefaultProperty
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the TemplateCustomizedDefaultProperty is nil
+func (o *TemplateCustomizedDefaultProperty) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the TemplateCustomizedDefaultProperty is nil
+func (o *TemplateCustomizedDefaultProperty) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalTemplateCustomizedDefaultProperty unmarshals an instance of TemplateCustomizedDefaultProperty from the specified map of raw messages.
 func UnmarshalTemplateCustomizedDefaultProperty(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TemplateCustomizedDefaultProperty)
@@ -3926,6 +6354,10 @@ func UnmarshalTemplateCustomizedDefaultProperty(m map[string]json.RawMessage, re
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "property", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3949,6 +6381,100 @@ type TemplateList struct {
 
 	// An array of templates.
 	Templates []Template `json:"templates" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateList
+func (o *TemplateList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateList
+func (o *TemplateList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateList
+func (o *TemplateList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateList
+func (o *TemplateList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateList
+func (o *TemplateList) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the TemplateList is nil
+func (o *TemplateList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the TemplateList is nil
+func (o *TemplateList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the TemplateList is nil
+func (o *TemplateList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the TemplateList is nil
+func (o *TemplateList) GetFirst() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the TemplateList is nil
+func (o *TemplateList) GetLast() *Link {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetTemplates returns Templates, or nil when the TemplateList is nil
+func (o *TemplateList) GetTemplates() []Template {
+	if o == nil {
+		return nil
+	}
+	return o.Templates
 }
 
 // UnmarshalTemplateList unmarshals an instance of TemplateList from the specified map of raw messages.
@@ -3978,6 +6504,10 @@ func UnmarshalTemplateList(m map[string]json.RawMessage, result interface{}) (er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "templates")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4014,6 +6544,132 @@ type TemplateResponse struct {
 
 	// The unique identifier for the user or application that last modified the resource.
 	ModifiedBy *string `json:"modified_by,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateResponse
+func (o *TemplateResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateResponse
+func (o *TemplateResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateResponse
+func (o *TemplateResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateResponse
+func (o *TemplateResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateResponse
+func (o *TemplateResponse) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the TemplateResponse is nil
+func (o *TemplateResponse) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the TemplateResponse is nil
+func (o *TemplateResponse) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the TemplateResponse is nil
+func (o *TemplateResponse) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetTemplateID returns the value of TemplateID, or the zero value when TemplateID or the TemplateResponse is nil
+func (o *TemplateResponse) GetTemplateID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TemplateID)
+}
+
+// GetTarget returns Target, or nil when the TemplateResponse is nil
+func (o *TemplateResponse) GetTarget() *SimpleTargetResource {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetCustomizedDefaults returns CustomizedDefaults, or nil when the TemplateResponse is nil
+func (o *TemplateResponse) GetCustomizedDefaults() []TemplateCustomizedDefaultProperty {
+	if o == nil {
+		return nil
+	}
+	return o.CustomizedDefaults
+}
+
+// GetCreationDate returns the value of CreationDate, or the zero value when CreationDate or the TemplateResponse is nil
+func (o *TemplateResponse) GetCreationDate() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreationDate)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the TemplateResponse is nil
+func (o *TemplateResponse) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModificationDate returns the value of ModificationDate, or the zero value when ModificationDate or the TemplateResponse is nil
+func (o *TemplateResponse) GetModificationDate() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ModificationDate)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the TemplateResponse is nil
+func (o *TemplateResponse) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
 }
 
 // UnmarshalTemplateResponse unmarshals an instance of TemplateResponse from the specified map of raw messages.
@@ -4059,6 +6715,10 @@ func UnmarshalTemplateResponse(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "account_id", "name", "description", "template_id", "target", "customized_defaults", "creation_date", "created_by", "modification_date", "modified_by")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4070,6 +6730,68 @@ type TemplateResponseError struct {
 
 	// Describes the problem.
 	Message *string `json:"message" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateResponseError
+func (o *TemplateResponseError) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateResponseError
+func (o *TemplateResponseError) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateResponseError
+func (o *TemplateResponseError) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateResponseError
+func (o *TemplateResponseError) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateResponseError
+func (o *TemplateResponseError) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateResponseError
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCode returns the value of Code, or the zero value when Code or the TemplateResponseError is nil
+func (o *TemplateResponseError) GetCode() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Code)
+}
+
+// GetMessage returns the value of Message, or the zero value when Message or the TemplateResponseError is nil
+func (o *TemplateResponseError) GetMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Message)
 }
 
 // UnmarshalTemplateResponseError unmarshals an instance of TemplateResponseError from the specified map of raw messages.
@@ -4083,6 +6805,10 @@ func UnmarshalTemplateResponseError(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "code", "message")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4098,6 +6824,9 @@ type TemplateScope struct {
 
 	// The type of scope.
 	ScopeType *string `json:"scope_type" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the TemplateScope.ScopeType property.
@@ -4120,6 +6849,73 @@ func (*ConfigurationGovernanceV1) NewTemplateScope(scopeID string, scopeType str
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TemplateScope
+func (o *TemplateScope) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TemplateScope
+func (o *TemplateScope) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TemplateScope
+func (o *TemplateScope) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TemplateScope
+func (o *TemplateScope) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TemplateScope
+func (o *TemplateScope) MarshalJSON() (buffer []byte, err error) {
+	type model TemplateScope
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetNote returns the value of Note, or the zero value when Note or the TemplateScope is nil
+func (o *TemplateScope) GetNote() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Note)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the TemplateScope is nil
+func (o *TemplateScope) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeType returns the value of ScopeType, or the zero value when ScopeType or the TemplateScope is nil
+func (o *TemplateScope) GetScopeType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeType)
+}
+
 // UnmarshalTemplateScope unmarshals an instance of TemplateScope from the specified map of raw messages.
 func UnmarshalTemplateScope(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TemplateScope)
@@ -4135,6 +6931,10 @@ func UnmarshalTemplateScope(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "note", "scope_id", "scope_type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4170,6 +6970,10 @@ type UpdateRuleAttachmentOptions struct {
 	// with each request.
 	TransactionID *string
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -4233,6 +7037,24 @@ func (options *UpdateRuleAttachmentOptions) SetHeaders(param map[string]string)
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateRuleAttachmentOptions) SetAdditionalProperty(key string, value interface{}) *UpdateRuleAttachmentOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateRuleAttachmentOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateRuleAttachmentOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // UpdateRuleOptions : The UpdateRule options.
 type UpdateRuleOptions struct {
 	// The UUID that uniquely identifies the rule.
@@ -4278,6 +7100,10 @@ type UpdateRuleOptions struct {
 	// with each request.
 	TransactionID *string
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -4367,9 +7193,27 @@ func (_options *UpdateRuleOptions) SetTransactionID(transactionID string) *Updat
 	return _options
 }
 
-// SetHeaders : Allow user to set Headers
-func (options *UpdateRuleOptions) SetHeaders(param map[string]string) *UpdateRuleOptions {
-	options.Headers = param
+// SetHeaders : Allow user to set Headers
+func (options *UpdateRuleOptions) SetHeaders(param map[string]string) *UpdateRuleOptions {
+	options.Headers = param
+	return options
+}
+
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateRuleOptions) SetAdditionalProperty(key string, value interface{}) *UpdateRuleOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateRuleOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateRuleOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
 	return options
 }
 
@@ -4403,6 +7247,10 @@ type UpdateTemplateAttachmentOptions struct {
 	// with each request.
 	TransactionID *string
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -4466,6 +7314,24 @@ func (options *UpdateTemplateAttachmentOptions) SetHeaders(param map[string]stri
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateTemplateAttachmentOptions) SetAdditionalProperty(key string, value interface{}) *UpdateTemplateAttachmentOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateTemplateAttachmentOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateTemplateAttachmentOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // UpdateTemplateOptions : The UpdateTemplate options.
 type UpdateTemplateOptions struct {
 	// The UUID that uniquely identifies the template.
@@ -4501,6 +7367,10 @@ type UpdateTemplateOptions struct {
 	// with each request.
 	TransactionID *string
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -4572,12 +7442,33 @@ func (options *UpdateTemplateOptions) SetHeaders(param map[string]string) *Updat
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateTemplateOptions) SetAdditionalProperty(key string, value interface{}) *UpdateTemplateOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateTemplateOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateTemplateOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // RuleConditionAndLvl2 : A condition with the `and` logical operator.
 // This model "extends" RuleCondition
 type RuleConditionAndLvl2 struct {
 	Description *string `json:"description,omitempty"`
 
 	And []RuleSingleProperty `json:"and" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewRuleConditionAndLvl2 : Instantiate RuleConditionAndLvl2 (Generic Model Constructor)
@@ -4593,6 +7484,65 @@ func (*RuleConditionAndLvl2) isaRuleCondition() bool {
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleConditionAndLvl2
+func (o *RuleConditionAndLvl2) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleConditionAndLvl2
+func (o *RuleConditionAndLvl2) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleConditionAndLvl2
+func (o *RuleConditionAndLvl2) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleConditionAndLvl2
+func (o *RuleConditionAndLvl2) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleConditionAndLvl2
+func (o *RuleConditionAndLvl2) MarshalJSON() (buffer []byte, err error) {
+	type model RuleConditionAndLvl2
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleConditionAndLvl2 is nil
+func (o *RuleConditionAndLvl2) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetAnd returns And, or nil when the RuleConditionAndLvl2 is nil
+func (o *RuleConditionAndLvl2) GetAnd() []RuleSingleProperty {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
 // UnmarshalRuleConditionAndLvl2 unmarshals an instance of RuleConditionAndLvl2 from the specified map of raw messages.
 func UnmarshalRuleConditionAndLvl2(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleConditionAndLvl2)
@@ -4604,6 +7554,10 @@ func UnmarshalRuleConditionAndLvl2(m map[string]json.RawMessage, result interfac
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "and")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4614,6 +7568,9 @@ type RuleConditionOrLvl2 struct {
 	Description *string `json:"description,omitempty"`
 
 	Or []RuleSingleProperty `json:"or" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewRuleConditionOrLvl2 : Instantiate RuleConditionOrLvl2 (Generic Model Constructor)
@@ -4629,6 +7586,65 @@ func (*RuleConditionOrLvl2) isaRuleCondition() bool {
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleConditionOrLvl2
+func (o *RuleConditionOrLvl2) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleConditionOrLvl2
+func (o *RuleConditionOrLvl2) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleConditionOrLvl2
+func (o *RuleConditionOrLvl2) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleConditionOrLvl2
+func (o *RuleConditionOrLvl2) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleConditionOrLvl2
+func (o *RuleConditionOrLvl2) MarshalJSON() (buffer []byte, err error) {
+	type model RuleConditionOrLvl2
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleConditionOrLvl2 is nil
+func (o *RuleConditionOrLvl2) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetOr returns Or, or nil when the RuleConditionOrLvl2 is nil
+func (o *RuleConditionOrLvl2) GetOr() []RuleSingleProperty {
+	if o == nil {
+		return nil
+	}
+	return o.Or
+}
+
 // UnmarshalRuleConditionOrLvl2 unmarshals an instance of RuleConditionOrLvl2 from the specified map of raw messages.
 func UnmarshalRuleConditionOrLvl2(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleConditionOrLvl2)
@@ -4640,6 +7656,10 @@ func UnmarshalRuleConditionOrLvl2(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "or")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4667,6 +7687,9 @@ type RuleConditionSingleProperty struct {
 	// Value options differ depending on the rule that you configure. If you use a boolean operator, you do not need to
 	// input a value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleConditionSingleProperty.Operator property.
@@ -4706,6 +7729,81 @@ func (*RuleConditionSingleProperty) isaRuleCondition() bool {
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleConditionSingleProperty
+func (o *RuleConditionSingleProperty) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleConditionSingleProperty
+func (o *RuleConditionSingleProperty) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleConditionSingleProperty
+func (o *RuleConditionSingleProperty) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleConditionSingleProperty
+func (o *RuleConditionSingleProperty) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleConditionSingleProperty
+func (o *RuleConditionSingleProperty) MarshalJSON() (buffer []byte, err error) {
+	type model RuleConditionSingleProperty
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleConditionSingleProperty is nil
+func (o *RuleConditionSingleProperty) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the RuleConditionSingleProperty is nil
+func (o *RuleConditionSingleProperty) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the RuleConditionSingleProperty is nil
+func (o *RuleConditionSingleProperty) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the RuleConditionSingleProperty is nil
+func (o *RuleConditionSingleProperty) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalRuleConditionSingleProperty unmarshals an instance of RuleConditionSingleProperty from the specified map of raw messages.
 func UnmarshalRuleConditionSingleProperty(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleConditionSingleProperty)
@@ -4725,6 +7823,10 @@ func UnmarshalRuleConditionSingleProperty(m map[string]json.RawMessage, result i
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "property", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4743,6 +7845,9 @@ type RuleRequiredConfigMultipleProperties struct {
 	Or []RuleConditionIntf `json:"or,omitempty"`
 
 	And []RuleConditionIntf `json:"and,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 func (*RuleRequiredConfigMultipleProperties) isaRuleRequiredConfigMultipleProperties() bool {
@@ -4758,6 +7863,73 @@ func (*RuleRequiredConfigMultipleProperties) isaRuleRequiredConfig() bool {
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequiredConfigMultipleProperties
+func (o *RuleRequiredConfigMultipleProperties) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequiredConfigMultipleProperties
+func (o *RuleRequiredConfigMultipleProperties) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequiredConfigMultipleProperties
+func (o *RuleRequiredConfigMultipleProperties) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequiredConfigMultipleProperties
+func (o *RuleRequiredConfigMultipleProperties) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequiredConfigMultipleProperties
+func (o *RuleRequiredConfigMultipleProperties) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequiredConfigMultipleProperties
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequiredConfigMultipleProperties is nil
+func (o *RuleRequiredConfigMultipleProperties) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetOr returns Or, or nil when the RuleRequiredConfigMultipleProperties is nil
+func (o *RuleRequiredConfigMultipleProperties) GetOr() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.Or
+}
+
+// GetAnd returns And, or nil when the RuleRequiredConfigMultipleProperties is nil
+func (o *RuleRequiredConfigMultipleProperties) GetAnd() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
 // UnmarshalRuleRequiredConfigMultipleProperties unmarshals an instance of RuleRequiredConfigMultipleProperties from the specified map of raw messages.
 func UnmarshalRuleRequiredConfigMultipleProperties(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequiredConfigMultipleProperties)
@@ -4773,6 +7945,10 @@ func UnmarshalRuleRequiredConfigMultipleProperties(m map[string]json.RawMessage,
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "or", "and")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4800,6 +7976,9 @@ type RuleRequiredConfigSingleProperty struct {
 	// Value options differ depending on the rule that you configure. If you use a boolean operator, you do not need to
 	// input a value.
 	Value *string `json:"value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the RuleRequiredConfigSingleProperty.Operator property.
@@ -4839,6 +8018,81 @@ func (*RuleRequiredConfigSingleProperty) isaRuleRequiredConfig() bool {
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequiredConfigSingleProperty
+func (o *RuleRequiredConfigSingleProperty) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequiredConfigSingleProperty
+func (o *RuleRequiredConfigSingleProperty) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequiredConfigSingleProperty
+func (o *RuleRequiredConfigSingleProperty) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequiredConfigSingleProperty
+func (o *RuleRequiredConfigSingleProperty) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequiredConfigSingleProperty
+func (o *RuleRequiredConfigSingleProperty) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequiredConfigSingleProperty
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequiredConfigSingleProperty is nil
+func (o *RuleRequiredConfigSingleProperty) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the RuleRequiredConfigSingleProperty is nil
+func (o *RuleRequiredConfigSingleProperty) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the RuleRequiredConfigSingleProperty is nil
+func (o *RuleRequiredConfigSingleProperty) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetValue returns the value of Value, or the zero value when Value or the RuleRequiredConfigSingleProperty is nil
+func (o *RuleRequiredConfigSingleProperty) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Value)
+}
+
 // UnmarshalRuleRequiredConfigSingleProperty unmarshals an instance of RuleRequiredConfigSingleProperty from the specified map of raw messages.
 func UnmarshalRuleRequiredConfigSingleProperty(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequiredConfigSingleProperty)
@@ -4858,6 +8112,10 @@ func UnmarshalRuleRequiredConfigSingleProperty(m map[string]json.RawMessage, res
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "property", "operator", "value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4868,6 +8126,9 @@ type RuleRequiredConfigMultiplePropertiesConditionAnd struct {
 	Description *string `json:"description,omitempty"`
 
 	And []RuleConditionIntf `json:"and" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewRuleRequiredConfigMultiplePropertiesConditionAnd : Instantiate RuleRequiredConfigMultiplePropertiesConditionAnd (Generic Model Constructor)
@@ -4887,6 +8148,65 @@ func (*RuleRequiredConfigMultiplePropertiesConditionAnd) isaRuleRequiredConfig()
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequiredConfigMultiplePropertiesConditionAnd
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequiredConfigMultiplePropertiesConditionAnd
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequiredConfigMultiplePropertiesConditionAnd
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequiredConfigMultiplePropertiesConditionAnd
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequiredConfigMultiplePropertiesConditionAnd
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequiredConfigMultiplePropertiesConditionAnd
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequiredConfigMultiplePropertiesConditionAnd is nil
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetAnd returns And, or nil when the RuleRequiredConfigMultiplePropertiesConditionAnd is nil
+func (o *RuleRequiredConfigMultiplePropertiesConditionAnd) GetAnd() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.And
+}
+
 // UnmarshalRuleRequiredConfigMultiplePropertiesConditionAnd unmarshals an instance of RuleRequiredConfigMultiplePropertiesConditionAnd from the specified map of raw messages.
 func UnmarshalRuleRequiredConfigMultiplePropertiesConditionAnd(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequiredConfigMultiplePropertiesConditionAnd)
@@ -4898,6 +8218,10 @@ func UnmarshalRuleRequiredConfigMultiplePropertiesConditionAnd(m map[string]json
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "and")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4908,6 +8232,9 @@ type RuleRequiredConfigMultiplePropertiesConditionOr struct {
 	Description *string `json:"description,omitempty"`
 
 	Or []RuleConditionIntf `json:"or" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewRuleRequiredConfigMultiplePropertiesConditionOr : Instantiate RuleRequiredConfigMultiplePropertiesConditionOr (Generic Model Constructor)
@@ -4927,6 +8254,65 @@ func (*RuleRequiredConfigMultiplePropertiesConditionOr) isaRuleRequiredConfig()
 	return true
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of RuleRequiredConfigMultiplePropertiesConditionOr
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of RuleRequiredConfigMultiplePropertiesConditionOr
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of RuleRequiredConfigMultiplePropertiesConditionOr
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of RuleRequiredConfigMultiplePropertiesConditionOr
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of RuleRequiredConfigMultiplePropertiesConditionOr
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) MarshalJSON() (buffer []byte, err error) {
+	type model RuleRequiredConfigMultiplePropertiesConditionOr
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the RuleRequiredConfigMultiplePropertiesConditionOr is nil
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetOr returns Or, or nil when the RuleRequiredConfigMultiplePropertiesConditionOr is nil
+func (o *RuleRequiredConfigMultiplePropertiesConditionOr) GetOr() []RuleConditionIntf {
+	if o == nil {
+		return nil
+	}
+	return o.Or
+}
+
 // UnmarshalRuleRequiredConfigMultiplePropertiesConditionOr unmarshals an instance of RuleRequiredConfigMultiplePropertiesConditionOr from the specified map of raw messages.
 func UnmarshalRuleRequiredConfigMultiplePropertiesConditionOr(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(RuleRequiredConfigMultiplePropertiesConditionOr)
@@ -4938,6 +8324,10 @@ func UnmarshalRuleRequiredConfigMultiplePropertiesConditionOr(m map[string]json.
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "or")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fixtureserver provides the canned-response HTTP server that the service tests share.
+package fixtureserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// Server is a test server that answers a request with the JSON body that is registered for its method and
+// escaped path, for example "GET /reports/r1/summary", and with a 404 error when none is registered. An empty body is
+// answered with 204. The server records every request it receives.
+type Server struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	responses map[string]string
+	requests  []Request
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	Key   string
+	Query url.Values
+	Body  string
+}
+
+// New starts a Server that answers with the given bodies, keyed by method and escaped path. The caller closes it.
+func New(responses map[string]string) *Server {
+	server := &Server{
+		responses: make(map[string]string, len(responses)),
+	}
+	for key, body := range responses {
+		server.responses[key] = body
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+func (server *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	key := req.Method + " " + req.URL.EscapedPath()
+	requestBody, _ := ioutil.ReadAll(req.Body)
+
+	server.mutex.Lock()
+	server.requests = append(server.requests, Request{Key: key, Query: req.URL.Query(), Body: string(requestBody)})
+	body, ok := server.responses[key]
+	server.mutex.Unlock()
+
+	if !ok {
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+		return
+	}
+	if body == "" {
+		res.WriteHeader(204)
+		return
+	}
+	res.Header().Set("Content-type", "application/json")
+	res.WriteHeader(200)
+	fmt.Fprint(res, body)
+}
+
+// SetResponse registers the body of the response to the requests with the given key.
+func (server *Server) SetResponse(key string, body string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.responses[key] = body
+}
+
+// DeleteResponse makes the requests with the given key fail with 404.
+func (server *Server) DeleteResponse(key string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	delete(server.responses, key)
+}
+
+// Requests returns the keys of the received requests in order.
+func (server *Server) Requests() []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	keys := make([]string, 0, len(server.requests))
+	for _, request := range server.requests {
+		keys = append(keys, request.Key)
+	}
+	return keys
+}
+
+// RequestsTo returns the received requests with the given key in order.
+func (server *Server) RequestsTo(key string) []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	var requests []Request
+	for _, request := range server.requests {
+		if request.Key == key {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
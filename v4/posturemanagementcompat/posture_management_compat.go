@@ -0,0 +1,882 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package posturemanagementcompat : A version-neutral interface over the PostureManagementV1 and PostureManagementV2
+// services, so that tools can switch between the two versions through configuration.
+package posturemanagementcompat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv1"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// Version : The version of the posture management API.
+type Version string
+
+// Constants associated with Version.
+const (
+	VersionV1Const Version = "v1"
+	VersionV2Const Version = "v2"
+)
+
+// ParseVersion : Parse a version such as "v1", "V2" or "2". An empty string selects VersionV2Const.
+func ParseVersion(s string) (Version, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "v1", "1":
+		return VersionV1Const, nil
+	case "", "v2", "2":
+		return VersionV2Const, nil
+	}
+	return "", fmt.Errorf("unknown posture management API version %q, expected v1 or v2", s)
+}
+
+// PostureManagement : The operations that both versions of the posture management API support.
+// Parameters that only one version understands are rejected with an *UnsupportedOperationError by the other version.
+type PostureManagement interface {
+	// The version of the API that requests are sent to.
+	Version() Version
+
+	ListLatestScans(listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error)
+	ListLatestScansWithContext(ctx context.Context, listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error)
+
+	ScansSummary(scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error)
+	ScansSummaryWithContext(ctx context.Context, scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error)
+
+	ScanSummaries(scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error)
+	ScanSummariesWithContext(ctx context.Context, scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error)
+
+	CreateValidation(createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error)
+	CreateValidationWithContext(ctx context.Context, createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error)
+
+	CreateScope(createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+	CreateScopeWithContext(ctx context.Context, createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	CreateCollector(createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error)
+	CreateCollectorWithContext(ctx context.Context, createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error)
+
+	CreateCredential(createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error)
+	CreateCredentialWithContext(ctx context.Context, createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error)
+}
+
+// UnsupportedOperationError : Returned when an operation or one of its parameters is not available in the configured
+// version of the API.
+type UnsupportedOperationError struct {
+	// The version that was called.
+	Version Version
+
+	// The operation that was called.
+	Operation string
+
+	// Why the operation cannot be performed.
+	Reason string
+}
+
+func (e *UnsupportedOperationError) Error() string {
+	return fmt.Sprintf("%s is not supported by posture management %s: %s", e.Operation, e.Version, e.Reason)
+}
+
+// RequireV2 : Return the PostureManagementV2 service behind postureManagement, for operations that only exist in v2.
+// An *UnsupportedOperationError naming operation is returned when postureManagement is not backed by v2.
+func RequireV2(postureManagement PostureManagement, operation string) (*posturemanagementv2.PostureManagementV2, error) {
+	if adapter, ok := postureManagement.(*PostureManagementV2Adapter); ok {
+		return adapter.Service, nil
+	}
+	return nil, &UnsupportedOperationError{
+		Version:   postureManagement.Version(),
+		Operation: operation,
+		Reason:    "the operation is only available in v2",
+	}
+}
+
+// PostureManagementOptions : Service options
+type PostureManagementOptions struct {
+	// The version to use. Empty selects v2, or the API_VERSION property when the external configuration is used.
+	Version Version
+
+	ServiceName   string
+	URL           string
+	Authenticator core.Authenticator
+
+	// Your IBM Cloud account ID. Required for v1, which sends it with every request. For v2 it is the default for
+	// options that do not set an AccountID.
+	AccountID *string
+}
+
+// NewPostureManagement : constructs a PostureManagement of the configured version.
+func NewPostureManagement(options *PostureManagementOptions) (postureManagement PostureManagement, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	version, err := ParseVersion(string(options.Version))
+	if err != nil {
+		return
+	}
+	if version == VersionV1Const {
+		var service *posturemanagementv1.PostureManagementV1
+		service, err = posturemanagementv1.NewPostureManagementV1(&posturemanagementv1.PostureManagementV1Options{
+			ServiceName:   options.ServiceName,
+			URL:           options.URL,
+			Authenticator: options.Authenticator,
+			AccountID:     options.AccountID,
+		})
+		if err != nil {
+			return
+		}
+		return NewPostureManagementV1Adapter(service), nil
+	}
+	service, err := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+		ServiceName:   options.ServiceName,
+		URL:           options.URL,
+		Authenticator: options.Authenticator,
+	})
+	if err != nil {
+		return
+	}
+	return NewPostureManagementV2Adapter(service, options.AccountID), nil
+}
+
+// NewPostureManagementUsingExternalConfig : constructs a PostureManagement with passed in options and external
+// configuration. Besides the properties that the services read, the API_VERSION and ACCOUNT_ID properties of the
+// service (for example POSTURE_MANAGEMENT_API_VERSION) select the version and the account when the options do not.
+func NewPostureManagementUsingExternalConfig(options *PostureManagementOptions) (postureManagement PostureManagement, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	// The external configuration is filled in on a copy, so that the caller's options can be used again.
+	optionsCopy := *options
+	options = &optionsCopy
+	if options.ServiceName == "" {
+		options.ServiceName = posturemanagementv2.DefaultServiceName
+	}
+	props, err := core.GetServiceProperties(options.ServiceName)
+	if err != nil {
+		return
+	}
+	if options.Version == "" {
+		options.Version = Version(props["API_VERSION"])
+	}
+	if options.AccountID == nil && props["ACCOUNT_ID"] != "" {
+		options.AccountID = core.StringPtr(props["ACCOUNT_ID"])
+	}
+	version, err := ParseVersion(string(options.Version))
+	if err != nil {
+		return
+	}
+	if version == VersionV1Const {
+		var service *posturemanagementv1.PostureManagementV1
+		service, err = posturemanagementv1.NewPostureManagementV1UsingExternalConfig(&posturemanagementv1.PostureManagementV1Options{
+			ServiceName:   options.ServiceName,
+			URL:           options.URL,
+			Authenticator: options.Authenticator,
+			AccountID:     options.AccountID,
+		})
+		if err != nil {
+			return
+		}
+		return NewPostureManagementV1Adapter(service), nil
+	}
+	service, err := posturemanagementv2.NewPostureManagementV2UsingExternalConfig(&posturemanagementv2.PostureManagementV2Options{
+		ServiceName:   options.ServiceName,
+		URL:           options.URL,
+		Authenticator: options.Authenticator,
+	})
+	if err != nil {
+		return
+	}
+	return NewPostureManagementV2Adapter(service, options.AccountID), nil
+}
+
+// ListLatestScansOptions : The ListLatestScans options.
+type ListLatestScansOptions struct {
+	// The offset of the scans, for paging.
+	Offset *int64
+
+	// The number of scans to return, for paging.
+	Limit *int64
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewListLatestScansOptions : Instantiate ListLatestScansOptions
+func NewListLatestScansOptions() *ListLatestScansOptions {
+	return &ListLatestScansOptions{}
+}
+
+// SetOffset : Allow user to set Offset
+func (_options *ListLatestScansOptions) SetOffset(offset int64) *ListLatestScansOptions {
+	_options.Offset = core.Int64Ptr(offset)
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *ListLatestScansOptions) SetLimit(limit int64) *ListLatestScansOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *ListLatestScansOptions) SetAccountID(accountID string) *ListLatestScansOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// ScansSummaryOptions : The ScansSummary options.
+type ScansSummaryOptions struct {
+	// The ID of the scan.
+	ScanID *string `validate:"required,ne="`
+
+	// The ID of the profile.
+	ProfileID *string `validate:"required"`
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewScansSummaryOptions : Instantiate ScansSummaryOptions
+func NewScansSummaryOptions(scanID string, profileID string) *ScansSummaryOptions {
+	return &ScansSummaryOptions{
+		ScanID:    core.StringPtr(scanID),
+		ProfileID: core.StringPtr(profileID),
+	}
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *ScansSummaryOptions) SetAccountID(accountID string) *ScansSummaryOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// ScanSummariesOptions : The ScanSummaries options.
+// v1 lists the summaries of a profile on a scope and needs ProfileID and ScopeID. v2 lists the summaries of a report
+// setting; when only ProfileID and ScopeID are set, the report setting of the latest scan of the profile on the scope
+// is used.
+type ScanSummariesOptions struct {
+	// The report setting ID of the scans. Only supported by v2.
+	ReportSettingID *string
+
+	// The ID of the profile.
+	ProfileID *string
+
+	// The ID of the scope.
+	ScopeID *string
+
+	// The offset of the summaries, for paging.
+	Offset *int64
+
+	// The number of summaries to return, for paging.
+	Limit *int64
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewScanSummariesOptions : Instantiate ScanSummariesOptions for the summaries of a profile on a scope
+func NewScanSummariesOptions(profileID string, scopeID string) *ScanSummariesOptions {
+	return &ScanSummariesOptions{
+		ProfileID: core.StringPtr(profileID),
+		ScopeID:   core.StringPtr(scopeID),
+	}
+}
+
+// SetReportSettingID : Allow user to set ReportSettingID
+func (_options *ScanSummariesOptions) SetReportSettingID(reportSettingID string) *ScanSummariesOptions {
+	_options.ReportSettingID = core.StringPtr(reportSettingID)
+	return _options
+}
+
+// SetOffset : Allow user to set Offset
+func (_options *ScanSummariesOptions) SetOffset(offset int64) *ScanSummariesOptions {
+	_options.Offset = core.Int64Ptr(offset)
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *ScanSummariesOptions) SetLimit(limit int64) *ScanSummariesOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *ScanSummariesOptions) SetAccountID(accountID string) *ScanSummariesOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// CreateValidationOptions : The CreateValidation options.
+type CreateValidationOptions struct {
+	// The unique ID of the scope.
+	ScopeID *string `validate:"required"`
+
+	// The unique ID of the profile.
+	ProfileID *string `validate:"required"`
+
+	// The ID of the profile group.
+	GroupProfileID *string
+
+	// The name of a scheduled scan. Only supported by v2.
+	Name *string
+
+	// The description of a scheduled scan. Only supported by v2.
+	Description *string
+
+	// The frequency of a scheduled scan in milliseconds. Only supported by v2.
+	Frequency *int64
+
+	// The number of times that a scheduled scan runs. Only supported by v2.
+	NoOfOccurrences *int64
+
+	// The date and time after which a scheduled scan no longer runs. Only supported by v2.
+	EndTime *strfmt.DateTime
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateValidationOptions : Instantiate CreateValidationOptions
+func NewCreateValidationOptions(scopeID string, profileID string) *CreateValidationOptions {
+	return &CreateValidationOptions{
+		ScopeID:   core.StringPtr(scopeID),
+		ProfileID: core.StringPtr(profileID),
+	}
+}
+
+// SetGroupProfileID : Allow user to set GroupProfileID
+func (_options *CreateValidationOptions) SetGroupProfileID(groupProfileID string) *CreateValidationOptions {
+	_options.GroupProfileID = core.StringPtr(groupProfileID)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *CreateValidationOptions) SetAccountID(accountID string) *CreateValidationOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// CreateScopeOptions : The CreateScope options.
+type CreateScopeOptions struct {
+	// A unique name for your scope.
+	Name *string `validate:"required"`
+
+	// A detailed description of the scope.
+	Description *string `validate:"required"`
+
+	// The unique IDs of the collectors that are attached to the scope.
+	CollectorIds []string `validate:"required"`
+
+	// The unique identifier of the credential.
+	CredentialID *string `validate:"required"`
+
+	// The environment that the scope is targeted to, such as "ibm" or "aws". Sent as the environment type by v1 and as
+	// the credential type by v2, which use the same values.
+	EnvironmentType *string `validate:"required"`
+
+	// The interval of scheduled discovery in seconds. Only supported by v2.
+	Interval *int64
+
+	// Whether discovery is scheduled. Only supported by v2.
+	IsDiscoveryScheduled *bool
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateScopeOptions : Instantiate CreateScopeOptions
+func NewCreateScopeOptions(name string, description string, collectorIds []string, credentialID string, environmentType string) *CreateScopeOptions {
+	return &CreateScopeOptions{
+		Name:            core.StringPtr(name),
+		Description:     core.StringPtr(description),
+		CollectorIds:    collectorIds,
+		CredentialID:    core.StringPtr(credentialID),
+		EnvironmentType: core.StringPtr(environmentType),
+	}
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *CreateScopeOptions) SetAccountID(accountID string) *CreateScopeOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// CreateCollectorOptions : The CreateCollector options.
+type CreateCollectorOptions struct {
+	// A unique name for your collector.
+	Name *string `validate:"required"`
+
+	// Whether the collector endpoint is accessible on a public network.
+	IsPublic *bool `validate:"required"`
+
+	// Whether the collector is managed by "ibm" or by the "customer".
+	ManagedBy *string `validate:"required"`
+
+	// A detailed description of the collector.
+	Description *string
+
+	// The passphrase that is used to generate the data encryption key of the credentials.
+	Passphrase *string
+
+	// Whether the collector has a UBI image. Only supported by v2.
+	IsUbiImage *bool
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateCollectorOptions : Instantiate CreateCollectorOptions
+func NewCreateCollectorOptions(name string, isPublic bool, managedBy string) *CreateCollectorOptions {
+	return &CreateCollectorOptions{
+		Name:      core.StringPtr(name),
+		IsPublic:  core.BoolPtr(isPublic),
+		ManagedBy: core.StringPtr(managedBy),
+	}
+}
+
+// SetDescription : Allow user to set Description
+func (_options *CreateCollectorOptions) SetDescription(description string) *CreateCollectorOptions {
+	_options.Description = core.StringPtr(description)
+	return _options
+}
+
+// SetPassphrase : Allow user to set Passphrase
+func (_options *CreateCollectorOptions) SetPassphrase(passphrase string) *CreateCollectorOptions {
+	_options.Passphrase = core.StringPtr(passphrase)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *CreateCollectorOptions) SetAccountID(accountID string) *CreateCollectorOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// CreateCredentialOptions : The CreateCredential options.
+// v1 creates credentials from an uploaded credential file and v2 from typed fields, so the options are either
+// file-based, see NewCreateCredentialOptionsFromFile, or spec-based, see NewCreateCredentialOptionsFromSpec.
+type CreateCredentialOptions struct {
+	// The credential file. Only supported by v1.
+	CredentialDataFile io.ReadCloser
+
+	// The PEM file of the credential. Only supported by v1.
+	PemFile io.ReadCloser
+
+	// The name of the credential. Only supported by v2.
+	Name *string
+
+	// The description of the credential. Only supported by v2.
+	Description *string
+
+	// The purpose of the credential. Only supported by v2.
+	Purpose *string
+
+	// The typed credential. Only supported by v2.
+	Spec posturemanagementv2.CredentialSpec
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// The unique identifier that is used to trace an entire request.
+	TransactionID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewCreateCredentialOptionsFromFile : Instantiate CreateCredentialOptions for v1 from a credential file
+func NewCreateCredentialOptionsFromFile(credentialDataFile io.ReadCloser) *CreateCredentialOptions {
+	return &CreateCredentialOptions{
+		CredentialDataFile: credentialDataFile,
+	}
+}
+
+// NewCreateCredentialOptionsFromSpec : Instantiate CreateCredentialOptions for v2 from a typed credential spec
+func NewCreateCredentialOptionsFromSpec(name string, description string, purpose string, spec posturemanagementv2.CredentialSpec) *CreateCredentialOptions {
+	return &CreateCredentialOptions{
+		Name:        core.StringPtr(name),
+		Description: core.StringPtr(description),
+		Purpose:     core.StringPtr(purpose),
+		Spec:        spec,
+	}
+}
+
+// SetPemFile : Allow user to set PemFile
+func (_options *CreateCredentialOptions) SetPemFile(pemFile io.ReadCloser) *CreateCredentialOptions {
+	_options.PemFile = pemFile
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *CreateCredentialOptions) SetAccountID(accountID string) *CreateCredentialOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// Constants associated with ProfileItem.Type and ProfileResult.Type. The profile types of v1 are mapped to these:
+// "standard", "standard_cv" and "standard_certificate" to predefined, "authored" to custom and "temmplategroup" to
+// template_group.
+const (
+	ProfileTypeCustomConst        = "custom"
+	ProfileTypePredefinedConst    = "predefined"
+	ProfileTypeTemplateGroupConst = "template_group"
+)
+
+// NormalizeProfileType : Map a v1 or v2 profile type to the v2 profile types. Unknown types are returned unchanged.
+func NormalizeProfileType(profileType string) string {
+	switch profileType {
+	case "standard", "standard_cv", "standard_certificate":
+		return ProfileTypePredefinedConst
+	case "authored":
+		return ProfileTypeCustomConst
+	case "temmplategroup", "templategroup":
+		return ProfileTypeTemplateGroupConst
+	}
+	return profileType
+}
+
+// ScanList : A page of the latest scans.
+type ScanList struct {
+	// The offset of the page. Not returned by v1.
+	Offset *int64
+
+	// The number of scans requested. Not returned by v1.
+	Limit *int64
+
+	// The total number of scans. Not returned by v1.
+	TotalCount *int64
+
+	// The latest scans.
+	LatestScans []ScanItem
+}
+
+// ScanItem : A scan.
+type ScanItem struct {
+	// The ID of the scan.
+	ScanID *string
+
+	// The name of the scan.
+	ScanName *string
+
+	// The scope ID of the scan.
+	ScopeID *string
+
+	// The name of the scope.
+	ScopeName *string
+
+	// The profiles of the scan. v1 returns a single profile.
+	Profiles []ProfileItem
+
+	// The ID of the profile group.
+	GroupProfileID *string
+
+	// The name of the profile group.
+	GroupProfileName *string
+
+	// The entity that ran the report.
+	ReportRunBy *string
+
+	// The date and time the scan was run.
+	StartTime *strfmt.DateTime
+
+	// The date and time the scan completed.
+	EndTime *strfmt.DateTime
+
+	// The report setting ID of the scan. Not returned by v1.
+	ReportSettingID *string
+
+	// The result of the scan.
+	Result *ScanResult
+}
+
+// ProfileItem : A profile of a scan.
+type ProfileItem struct {
+	// The ID of the profile.
+	ID *string
+
+	// The name of the profile.
+	Name *string
+
+	// The type of the profile, see NormalizeProfileType.
+	Type *string
+}
+
+// ScanResult : The result counts of a scan.
+type ScanResult struct {
+	GoalsPassCount               *int64
+	GoalsUnableToPerformCount    *int64
+	GoalsNotApplicableCount      *int64
+	GoalsFailCount               *int64
+	GoalsTotalCount              *int64
+	ControlsPassCount            *int64
+	ControlsFailCount            *int64
+	ControlsNotApplicableCount   *int64
+	ControlsUnableToPerformCount *int64
+	ControlsTotalCount           *int64
+}
+
+// Summary : The summary of a scan.
+type Summary struct {
+	// The ID of the scan.
+	ScanID *string
+
+	// The ID of the discovery of the scan.
+	DiscoverID *string
+
+	// The ID of the profile.
+	ProfileID *string
+
+	// The name of the profile.
+	ProfileName *string
+
+	// The ID of the scope.
+	ScopeID *string
+
+	// The controls of the profile.
+	Controls []Control
+}
+
+// Control : The result of a control.
+type Control struct {
+	// The ID of the control.
+	ID *string
+
+	// The status of the control. v1 and v2 use the same status values.
+	Status *string
+
+	// The external ID of the control.
+	ExternalControlID *string
+
+	// The description of the control.
+	Description *string
+
+	// The goals of the control.
+	Goals []Goal
+
+	// The resource counts of the control.
+	ResourceStatistics *ResourceStatistics
+}
+
+// ResourceStatistics : The resource counts of a control.
+type ResourceStatistics struct {
+	PassCount            *int64
+	FailCount            *int64
+	UnableToPerformCount *int64
+	NotApplicableCount   *int64
+}
+
+// Goal : The result of a goal.
+type Goal struct {
+	// The ID of the goal.
+	ID *string
+
+	// The description of the goal.
+	Description *string
+
+	// The status of the goal.
+	Status *string
+
+	// The severity of the goal.
+	Severity *string
+
+	// The time that the goal was evaluated.
+	CompletedTime *strfmt.DateTime
+
+	// The error of the goal.
+	Error *string
+
+	// The results of the resources.
+	ResourceResult []ResourceResult
+}
+
+// ResourceResult : The result of a goal on a resource.
+type ResourceResult struct {
+	// The name of the resource.
+	Name *string
+
+	// The types of the resource.
+	Types *string
+
+	// The status of the resource.
+	Status *string
+
+	// The expected value.
+	DisplayExpectedValue *string
+
+	// The actual value.
+	ActualValue *string
+
+	// Information about the result.
+	ResultsInfo *string
+
+	// Why the goal does not apply.
+	NotApplicableReason *string
+}
+
+// SummaryList : A page of scan summaries.
+type SummaryList struct {
+	// The offset of the page. Not returned by v1.
+	Offset *int64
+
+	// The number of summaries requested. Not returned by v1.
+	Limit *int64
+
+	// The total number of summaries. Not returned by v1.
+	TotalCount *int64
+
+	// The summaries.
+	Summaries []SummaryItem
+}
+
+// SummaryItem : The summary of a scan in a list.
+type SummaryItem struct {
+	// The ID of the scan.
+	ID *string
+
+	// The name of the scan.
+	Name *string
+
+	// The ID of the scope.
+	ScopeID *string
+
+	// The name of the scope.
+	ScopeName *string
+
+	// The entity that ran the report.
+	ReportRunBy *string
+
+	// The date and time the scan was run.
+	StartTime *strfmt.DateTime
+
+	// The date and time the scan completed.
+	EndTime *strfmt.DateTime
+
+	// The status of the scan. v1 and v2 use the same status values.
+	Status *string
+
+	// The profiles of the scan. v1 returns a single profile.
+	Profiles []ProfileResult
+
+	// The profile groups of the scan. v1 returns a single group.
+	GroupProfiles []ProfileResult
+}
+
+// ProfileResult : The result of a profile.
+type ProfileResult struct {
+	// The ID of the profile.
+	ID *string
+
+	// The name of the profile.
+	Name *string
+
+	// The type of the profile, see NormalizeProfileType.
+	Type *string
+
+	// The result of the profile.
+	ValidationResult *ScanResult
+}
+
+// Result : The result of a request.
+type Result struct {
+	Result  *bool
+	Message *string
+}
+
+// Scope : A scope.
+type Scope struct {
+	// The ID of the scope.
+	ID *string
+
+	// The name of the scope.
+	Name *string
+
+	// The description of the scope.
+	Description *string
+
+	// The IDs of the collectors of the scope.
+	CollectorIds []string
+
+	// The ID of the credential of the scope.
+	CredentialID *string
+
+	// The environment of the scope.
+	EnvironmentType *string
+
+	// The time that the scope was created.
+	CreatedTime *strfmt.DateTime
+}
+
+// Collector : A collector. v1 only returns the ID.
+type Collector struct {
+	ID               *string
+	Name             *string
+	DisplayName      *string
+	Status           *string
+	ManagedBy        *string
+	IsPublic         *bool
+	RegistrationCode *string
+}
+
+// Credential : A credential. v1 does not return the type and the purpose.
+type Credential struct {
+	ID          *string
+	Name        *string
+	Type        *string
+	Purpose     *string
+	CreatedTime *strfmt.DateTime
+}
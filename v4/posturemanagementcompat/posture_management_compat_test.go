@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementcompat_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementcompat"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementCompat`, func() {
+	var testServer *fixtureserver.Server
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /posture/v1/scans/validations/latest_scans": `{"latest_scans": [{"scan_id": "s1", "scope_id": "scope-1", "profile_id": "p1", "profile_name": "CIS", "profile_type": "standard_cv", "result": {"goals_fail_count": 2}}]}`,
+			"GET /posture/v2/scans/validations/latest_scans": `{"offset": 0, "limit": 100, "total_count": 2, "first": {"href": "f"}, "last": {"href": "l"}, "latest_scans": [
+				{"scan_id": "s0", "scope_id": "scope-2", "profiles": [{"id": "p1", "name": "CIS", "type": "predefined"}], "report_setting_id": "rs-0"},
+				{"scan_id": "s1", "scope_id": "scope-1", "profiles": [{"id": "p1", "name": "CIS", "type": "predefined"}], "report_setting_id": "rs-1", "result": {"goals_fail_count": 2}}
+			]}`,
+			"GET /posture/v1/scans/validations/s1/summary": `{"scan_id": "s1", "profile_id": "p1", "controls": [{"control_id": "c1", "control_desciption": "d", "resource_statistics": {"resource_fail_count": 1},
+				"goals": [{"goal_id": "g1", "goal_description": "gd", "resource_result": [{"resource_name": "r1", "resource_status": "fail"}]}]}]}`,
+			"GET /posture/v2/scans/validations/s1/summary": `{"id": "s1", "discover_id": "d", "profile_id": "p1", "profile_name": "CIS", "scope_id": "scope-1", "controls": [{"id": "c1", "description": "d", "resource_statistics": {"fail_count": 1},
+				"goals": [{"id": "g1", "description": "gd", "resource_result": [{"name": "r1", "status": "fail"}]}]}]}`,
+			"GET /posture/v1/scans/validations/summaries": `{"summaries": [{"scan_id": "s1", "status": "validation_completed", "profile": {"profile_id": "p1", "profile_type": "authored"}}]}`,
+			"GET /posture/v2/scans/validations/summaries": `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "f"}, "last": {"href": "l"}, "summaries": [
+				{"id": "s1", "name": "n", "scope_id": "scope-1", "scope_name": "s", "report_run_by": "u", "start_time": "2022-01-01T00:00:00Z", "end_time": "2022-01-01T00:00:00Z", "status": "validation_completed",
+				"profiles": [{"id": "p1", "name": "CIS", "type": "custom", "validation_result": {}}], "group_profiles": []}
+			]}`,
+			"POST /posture/v1/collectors": `{"collector_id": "col-1"}`,
+			"POST /posture/v2/collectors": `{"id": "col-1", "name": "c", "display_name": "c", "status": "ready_to_install", "registration_code": "reg", "managed_by": "customer", "is_public": true}`,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newPostureManagement := func(version posturemanagementcompat.Version) posturemanagementcompat.PostureManagement {
+		postureManagement, err := posturemanagementcompat.NewPostureManagement(&posturemanagementcompat.PostureManagementOptions{
+			Version:       version,
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			AccountID:     core.StringPtr("acc-1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(postureManagement.Version()).To(Equal(version))
+		return postureManagement
+	}
+
+	for _, version := range []posturemanagementcompat.Version{posturemanagementcompat.VersionV1Const, posturemanagementcompat.VersionV2Const} {
+		version := version
+		Context(fmt.Sprintf(`With %s`, version), func() {
+			It(`Maps the latest scans`, func() {
+				scans, _, err := newPostureManagement(version).ListLatestScans(posturemanagementcompat.NewListLatestScansOptions())
+				Expect(err).To(BeNil())
+				scan := scans.LatestScans[len(scans.LatestScans)-1]
+				Expect(*scan.ScanID).To(Equal("s1"))
+				Expect(*scan.Profiles[0].ID).To(Equal("p1"))
+				Expect(*scan.Profiles[0].Type).To(Equal(posturemanagementcompat.ProfileTypePredefinedConst))
+				Expect(*scan.Result.GoalsFailCount).To(Equal(int64(2)))
+				latestScansRequests := testServer.RequestsTo("GET /posture/" + string(version) + "/scans/validations/latest_scans")
+				Expect(latestScansRequests).To(HaveLen(1))
+				Expect(latestScansRequests[0].Query.Get("account_id")).To(Equal("acc-1"))
+			})
+			It(`Maps the summary of a scan`, func() {
+				summary, _, err := newPostureManagement(version).ScansSummary(posturemanagementcompat.NewScansSummaryOptions("s1", "p1"))
+				Expect(err).To(BeNil())
+				Expect(*summary.ScanID).To(Equal("s1"))
+				control := summary.Controls[0]
+				Expect(*control.ID).To(Equal("c1"))
+				Expect(*control.Description).To(Equal("d"))
+				Expect(*control.ResourceStatistics.FailCount).To(Equal(int64(1)))
+				Expect(*control.Goals[0].ID).To(Equal("g1"))
+				Expect(*control.Goals[0].ResourceResult[0].Name).To(Equal("r1"))
+				Expect(*control.Goals[0].ResourceResult[0].Status).To(Equal("fail"))
+			})
+			It(`Lists the summaries of a profile on a scope`, func() {
+				summaries, _, err := newPostureManagement(version).ScanSummaries(posturemanagementcompat.NewScanSummariesOptions("p1", "scope-1"))
+				Expect(err).To(BeNil())
+				Expect(*summaries.Summaries[0].ID).To(Equal("s1"))
+				Expect(*summaries.Summaries[0].Status).To(Equal("validation_completed"))
+				Expect(*summaries.Summaries[0].Profiles[0].Type).To(Equal(posturemanagementcompat.ProfileTypeCustomConst))
+			})
+			It(`Creates a collector`, func() {
+				collector, _, err := newPostureManagement(version).CreateCollector(posturemanagementcompat.NewCreateCollectorOptions("c", true, "customer"))
+				Expect(err).To(BeNil())
+				Expect(*collector.ID).To(Equal("col-1"))
+				Expect(*collector.ManagedBy).To(Equal("customer"))
+			})
+			It(`Returns no result for empty responses`, func() {
+				testServer.SetResponse("GET /posture/"+string(version)+"/scans/validations/latest_scans", "")
+				testServer.SetResponse("GET /posture/"+string(version)+"/scans/validations/s1/summary", "")
+				postureManagement := newPostureManagement(version)
+				scans, _, err := postureManagement.ListLatestScans(posturemanagementcompat.NewListLatestScansOptions())
+				Expect(err).To(BeNil())
+				Expect(scans).To(BeNil())
+				summary, _, err := postureManagement.ScansSummary(posturemanagementcompat.NewScansSummaryOptions("s1", "p1"))
+				Expect(err).To(BeNil())
+				Expect(summary).To(BeNil())
+			})
+		})
+	}
+
+	It(`Resolves the report setting of v2 summaries from the latest scans`, func() {
+		_, _, err := newPostureManagement(posturemanagementcompat.VersionV2Const).ScanSummaries(posturemanagementcompat.NewScanSummariesOptions("p1", "scope-1"))
+		Expect(err).To(BeNil())
+		Expect(testServer.Requests()).To(HaveLen(2))
+		summariesRequests := testServer.RequestsTo("GET /posture/v2/scans/validations/summaries")
+		Expect(summariesRequests).To(HaveLen(1))
+		Expect(summariesRequests[0].Query.Get("report_setting_id")).To(Equal("rs-1"))
+
+		_, _, err = newPostureManagement(posturemanagementcompat.VersionV2Const).ScanSummaries(posturemanagementcompat.NewScanSummariesOptions("p1", "scope-3"))
+		Expect(err).To(MatchError("no scan of profile p1 on scope scope-3 was found to list the summaries of"))
+	})
+	It(`Rejects v2-only parameters and operations on v1`, func() {
+		postureManagement := newPostureManagement(posturemanagementcompat.VersionV1Const)
+		var unsupportedErr *posturemanagementcompat.UnsupportedOperationError
+
+		_, _, err := postureManagement.ScanSummaries(&posturemanagementcompat.ScanSummariesOptions{ReportSettingID: core.StringPtr("rs-1")})
+		Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+		Expect(unsupportedErr.Operation).To(Equal("ScanSummaries"))
+
+		createCollectorOptions := posturemanagementcompat.NewCreateCollectorOptions("c", true, "customer")
+		createCollectorOptions.IsUbiImage = core.BoolPtr(true)
+		_, _, err = postureManagement.CreateCollector(createCollectorOptions)
+		Expect(err).To(MatchError("CreateCollector is not supported by posture management v1: UBI images are only available in v2"))
+
+		_, _, err = postureManagement.CreateCredential(posturemanagementcompat.NewCreateCredentialOptionsFromSpec("n", "d", "discovery_collection", &posturemanagementv2.IBMCredential{APIKey: "key"}))
+		Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+
+		_, _, err = postureManagement.ScanSummaries(posturemanagementcompat.NewScanSummariesOptions("p1", "scope-1").SetReportSettingID("rs-1"))
+		Expect(err).To(MatchError("ScanSummaries is not supported by posture management v1: report settings are only available in v2"))
+
+		createCredentialOptions := posturemanagementcompat.NewCreateCredentialOptionsFromFile(io.NopCloser(strings.NewReader("{}")))
+		createCredentialOptions.Name = core.StringPtr("n")
+		_, _, err = postureManagement.CreateCredential(createCredentialOptions)
+		Expect(err).To(MatchError("CreateCredential is not supported by posture management v1: the name, description and purpose are read from the credential file"))
+
+		_, _, err = postureManagement.ListLatestScans(posturemanagementcompat.NewListLatestScansOptions().SetAccountID("other"))
+		Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+
+		_, err = posturemanagementcompat.RequireV2(postureManagement, "ListCredentials")
+		Expect(err).To(MatchError("ListCredentials is not supported by posture management v1: the operation is only available in v2"))
+		Expect(testServer.Requests()).To(BeEmpty())
+	})
+	It(`Rejects nil options`, func() {
+		_, err := posturemanagementcompat.NewPostureManagement(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = posturemanagementcompat.NewPostureManagementUsingExternalConfig(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Rejects file-based credentials on v2`, func() {
+		postureManagement := newPostureManagement(posturemanagementcompat.VersionV2Const)
+		_, _, err := postureManagement.CreateCredential(posturemanagementcompat.NewCreateCredentialOptionsFromFile(nil))
+		var unsupportedErr *posturemanagementcompat.UnsupportedOperationError
+		Expect(errors.As(err, &unsupportedErr)).To(BeTrue())
+
+		createCredentialOptions := posturemanagementcompat.NewCreateCredentialOptionsFromSpec("n", "d", "discovery_collection", &posturemanagementv2.IBMCredential{APIKey: "key"}).
+			SetPemFile(io.NopCloser(strings.NewReader("pem")))
+		_, _, err = postureManagement.CreateCredential(createCredentialOptions)
+		Expect(err).To(MatchError("CreateCredential is not supported by posture management v2: credential and PEM files are only supported by v1"))
+		Expect(testServer.Requests()).To(BeEmpty())
+	})
+	It(`Selects the version from the external configuration`, func() {
+		os.Setenv("COMPAT_TEST_API_VERSION", "v1")
+		os.Setenv("COMPAT_TEST_ACCOUNT_ID", "acc-2")
+		os.Setenv("COMPAT_TEST_AUTH_TYPE", "noauth")
+		defer os.Unsetenv("COMPAT_TEST_API_VERSION")
+		defer os.Unsetenv("COMPAT_TEST_ACCOUNT_ID")
+		defer os.Unsetenv("COMPAT_TEST_AUTH_TYPE")
+
+		options := &posturemanagementcompat.PostureManagementOptions{
+			ServiceName: "compat_test",
+			URL:         testServer.URL,
+		}
+		postureManagement, err := posturemanagementcompat.NewPostureManagementUsingExternalConfig(options)
+		Expect(err).To(BeNil())
+		Expect(options.Version).To(BeEmpty())
+		Expect(options.AccountID).To(BeNil())
+		Expect(postureManagement.Version()).To(Equal(posturemanagementcompat.VersionV1Const))
+		_, _, err = postureManagement.ListLatestScans(posturemanagementcompat.NewListLatestScansOptions())
+		Expect(err).To(BeNil())
+		latestScansRequests := testServer.RequestsTo("GET /posture/v1/scans/validations/latest_scans")
+		Expect(latestScansRequests).To(HaveLen(1))
+		Expect(latestScansRequests[0].Query.Get("account_id")).To(Equal("acc-2"))
+
+		os.Setenv("COMPAT_TEST_API_VERSION", "v2")
+		os.Unsetenv("COMPAT_TEST_ACCOUNT_ID")
+		postureManagement, err = posturemanagementcompat.NewPostureManagementUsingExternalConfig(options)
+		Expect(err).To(BeNil())
+		Expect(postureManagement.Version()).To(Equal(posturemanagementcompat.VersionV2Const))
+
+		_, err = posturemanagementcompat.ParseVersion("v3")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,463 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementcompat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv1"
+)
+
+// PostureManagementV1Adapter : The PostureManagement of a PostureManagementV1 service.
+type PostureManagementV1Adapter struct {
+	Service *posturemanagementv1.PostureManagementV1
+}
+
+// NewPostureManagementV1Adapter : Instantiate PostureManagementV1Adapter
+func NewPostureManagementV1Adapter(service *posturemanagementv1.PostureManagementV1) *PostureManagementV1Adapter {
+	return &PostureManagementV1Adapter{
+		Service: service,
+	}
+}
+
+// Version returns VersionV1Const.
+func (*PostureManagementV1Adapter) Version() Version {
+	return VersionV1Const
+}
+
+// unsupported returns an *UnsupportedOperationError for v1.
+func (*PostureManagementV1Adapter) unsupported(operation string, format string, args ...interface{}) error {
+	return &UnsupportedOperationError{
+		Version:   VersionV1Const,
+		Operation: operation,
+		Reason:    fmt.Sprintf(format, args...),
+	}
+}
+
+// checkAccountID rejects an account ID other than that of the service, because v1 sends the account ID of the service
+// with every request.
+func (adapter *PostureManagementV1Adapter) checkAccountID(operation string, accountID *string) error {
+	if accountID != nil && *accountID != core.StringNilMapper(adapter.Service.AccountID) {
+		return adapter.unsupported(operation, "the account ID is set on the service and cannot be changed per request")
+	}
+	return nil
+}
+
+// ListLatestScans : List latest scans
+func (adapter *PostureManagementV1Adapter) ListLatestScans(listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error) {
+	return adapter.ListLatestScansWithContext(context.Background(), listLatestScansOptions)
+}
+
+// ListLatestScansWithContext is an alternate form of the ListLatestScans method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) ListLatestScansWithContext(ctx context.Context, listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(listLatestScansOptions, "listLatestScansOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = adapter.checkAccountID("ListLatestScans", listLatestScansOptions.AccountID)
+	if err != nil {
+		return
+	}
+	scans, response, err := adapter.Service.ListLatestScansWithContext(ctx, &posturemanagementv1.ListLatestScansOptions{
+		TransactionID: listLatestScansOptions.TransactionID,
+		Offset:        listLatestScansOptions.Offset,
+		Limit:         listLatestScansOptions.Limit,
+		Headers:       listLatestScansOptions.Headers,
+	})
+	if err != nil || scans == nil {
+		return
+	}
+	result = &ScanList{}
+	for i := range scans.LatestScans {
+		result.LatestScans = append(result.LatestScans, scanItemFromV1(&scans.LatestScans[i]))
+	}
+	return
+}
+
+// ScansSummary : Retrieve the summary of a specific scan
+func (adapter *PostureManagementV1Adapter) ScansSummary(scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error) {
+	return adapter.ScansSummaryWithContext(context.Background(), scansSummaryOptions)
+}
+
+// ScansSummaryWithContext is an alternate form of the ScansSummary method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) ScansSummaryWithContext(ctx context.Context, scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(scansSummaryOptions, "scansSummaryOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(scansSummaryOptions, "scansSummaryOptions")
+	if err != nil {
+		return
+	}
+	err = adapter.checkAccountID("ScansSummary", scansSummaryOptions.AccountID)
+	if err != nil {
+		return
+	}
+	summary, response, err := adapter.Service.ScansSummaryWithContext(ctx, &posturemanagementv1.ScansSummaryOptions{
+		ScanID:        scansSummaryOptions.ScanID,
+		ProfileID:     scansSummaryOptions.ProfileID,
+		TransactionID: scansSummaryOptions.TransactionID,
+		Headers:       scansSummaryOptions.Headers,
+	})
+	if err != nil || summary == nil {
+		return
+	}
+	result = &Summary{
+		ScanID:      summary.ScanID,
+		DiscoverID:  summary.DiscoverID,
+		ProfileID:   summary.ProfileID,
+		ProfileName: summary.ProfileName,
+		ScopeID:     summary.ScopeID,
+	}
+	for _, control := range summary.Controls {
+		result.Controls = append(result.Controls, controlFromV1(&control))
+	}
+	return
+}
+
+// ScanSummaries : List the scan summaries of a profile on a scope
+func (adapter *PostureManagementV1Adapter) ScanSummaries(scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error) {
+	return adapter.ScanSummariesWithContext(context.Background(), scanSummariesOptions)
+}
+
+// ScanSummariesWithContext is an alternate form of the ScanSummaries method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) ScanSummariesWithContext(ctx context.Context, scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(scanSummariesOptions, "scanSummariesOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	if scanSummariesOptions.ReportSettingID != nil {
+		err = adapter.unsupported("ScanSummaries", "report settings are only available in v2")
+		return
+	}
+	if scanSummariesOptions.ProfileID == nil || scanSummariesOptions.ScopeID == nil {
+		err = adapter.unsupported("ScanSummaries", "summaries are listed by profile and scope, set ProfileID and ScopeID")
+		return
+	}
+	err = adapter.checkAccountID("ScanSummaries", scanSummariesOptions.AccountID)
+	if err != nil {
+		return
+	}
+	summaries, response, err := adapter.Service.ScanSummariesWithContext(ctx, &posturemanagementv1.ScanSummariesOptions{
+		ProfileID:     scanSummariesOptions.ProfileID,
+		ScopeID:       scanSummariesOptions.ScopeID,
+		TransactionID: scanSummariesOptions.TransactionID,
+		Offset:        scanSummariesOptions.Offset,
+		Limit:         scanSummariesOptions.Limit,
+		Headers:       scanSummariesOptions.Headers,
+	})
+	if err != nil || summaries == nil {
+		return
+	}
+	result = &SummaryList{}
+	for _, item := range summaries.Summaries {
+		summary := SummaryItem{
+			ID:          item.ScanID,
+			Name:        item.ScanName,
+			ScopeID:     item.ScopeID,
+			ScopeName:   item.ScopeName,
+			ReportRunBy: item.ReportRunBy,
+			StartTime:   item.StartTime,
+			EndTime:     item.EndTime,
+			Status:      item.Status,
+		}
+		if item.Profile != nil {
+			summary.Profiles = []ProfileResult{{
+				ID:               item.Profile.ProfileID,
+				Name:             item.Profile.ProfileName,
+				Type:             normalizeProfileTypePtr(item.Profile.ProfileType),
+				ValidationResult: scanResultFromV1(item.Profile.ValidationResult),
+			}}
+		}
+		if item.GroupProfiles != nil {
+			summary.GroupProfiles = []ProfileResult{{
+				ID:               item.GroupProfiles.GroupProfileID,
+				Name:             item.GroupProfiles.GroupProfileName,
+				Type:             normalizeProfileTypePtr(item.GroupProfiles.ProfileType),
+				ValidationResult: scanResultFromV1(item.GroupProfiles.ValidationResult),
+			}}
+		}
+		result.Summaries = append(result.Summaries, summary)
+	}
+	return
+}
+
+// CreateValidation : Initiate a validation scan
+func (adapter *PostureManagementV1Adapter) CreateValidation(createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error) {
+	return adapter.CreateValidationWithContext(context.Background(), createValidationOptions)
+}
+
+// CreateValidationWithContext is an alternate form of the CreateValidation method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) CreateValidationWithContext(ctx context.Context, createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createValidationOptions, "createValidationOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createValidationOptions, "createValidationOptions")
+	if err != nil {
+		return
+	}
+	if createValidationOptions.Name != nil || createValidationOptions.Description != nil || createValidationOptions.Frequency != nil ||
+		createValidationOptions.NoOfOccurrences != nil || createValidationOptions.EndTime != nil {
+		err = adapter.unsupported("CreateValidation", "scheduled scans are only available in v2")
+		return
+	}
+	err = adapter.checkAccountID("CreateValidation", createValidationOptions.AccountID)
+	if err != nil {
+		return
+	}
+	validation, response, err := adapter.Service.CreateValidationWithContext(ctx, &posturemanagementv1.CreateValidationOptions{
+		ScopeID:        createValidationOptions.ScopeID,
+		ProfileID:      createValidationOptions.ProfileID,
+		GroupProfileID: createValidationOptions.GroupProfileID,
+		TransactionID:  createValidationOptions.TransactionID,
+		Headers:        createValidationOptions.Headers,
+	})
+	if err != nil || validation == nil {
+		return
+	}
+	result = &Result{
+		Result:  validation.Result,
+		Message: validation.Message,
+	}
+	return
+}
+
+// CreateScope : Create a scope
+func (adapter *PostureManagementV1Adapter) CreateScope(createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error) {
+	return adapter.CreateScopeWithContext(context.Background(), createScopeOptions)
+}
+
+// CreateScopeWithContext is an alternate form of the CreateScope method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) CreateScopeWithContext(ctx context.Context, createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createScopeOptions, "createScopeOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createScopeOptions, "createScopeOptions")
+	if err != nil {
+		return
+	}
+	if createScopeOptions.Interval != nil || createScopeOptions.IsDiscoveryScheduled != nil {
+		err = adapter.unsupported("CreateScope", "scheduled discovery is only available in v2")
+		return
+	}
+	err = adapter.checkAccountID("CreateScope", createScopeOptions.AccountID)
+	if err != nil {
+		return
+	}
+	scope, response, err := adapter.Service.CreateScopeWithContext(ctx, &posturemanagementv1.CreateScopeOptions{
+		ScopeName:        createScopeOptions.Name,
+		ScopeDescription: createScopeOptions.Description,
+		CollectorIds:     createScopeOptions.CollectorIds,
+		CredentialID:     createScopeOptions.CredentialID,
+		EnvironmentType:  createScopeOptions.EnvironmentType,
+		TransactionID:    createScopeOptions.TransactionID,
+		Headers:          createScopeOptions.Headers,
+	})
+	if err != nil || scope == nil {
+		return
+	}
+	result = &Scope{
+		ID:              scope.ScopeID,
+		Name:            scope.ScopeName,
+		Description:     scope.ScopeDescription,
+		CollectorIds:    scope.CollectorIds,
+		CredentialID:    scope.CredentialID,
+		EnvironmentType: scope.EnvironmentType,
+		CreatedTime:     scope.CreatedTime,
+	}
+	return
+}
+
+// CreateCollector : Create a collector
+func (adapter *PostureManagementV1Adapter) CreateCollector(createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error) {
+	return adapter.CreateCollectorWithContext(context.Background(), createCollectorOptions)
+}
+
+// CreateCollectorWithContext is an alternate form of the CreateCollector method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) CreateCollectorWithContext(ctx context.Context, createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createCollectorOptions, "createCollectorOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createCollectorOptions, "createCollectorOptions")
+	if err != nil {
+		return
+	}
+	if createCollectorOptions.IsUbiImage != nil {
+		err = adapter.unsupported("CreateCollector", "UBI images are only available in v2")
+		return
+	}
+	err = adapter.checkAccountID("CreateCollector", createCollectorOptions.AccountID)
+	if err != nil {
+		return
+	}
+	collector, response, err := adapter.Service.CreateCollectorWithContext(ctx, &posturemanagementv1.CreateCollectorOptions{
+		CollectorName:        createCollectorOptions.Name,
+		CollectorDescription: createCollectorOptions.Description,
+		IsPublic:             createCollectorOptions.IsPublic,
+		ManagedBy:            createCollectorOptions.ManagedBy,
+		PassPhrase:           createCollectorOptions.Passphrase,
+		TransactionID:        createCollectorOptions.TransactionID,
+		Headers:              createCollectorOptions.Headers,
+	})
+	if err != nil || collector == nil {
+		return
+	}
+	result = &Collector{
+		ID:        collector.CollectorID,
+		Name:      createCollectorOptions.Name,
+		ManagedBy: createCollectorOptions.ManagedBy,
+		IsPublic:  createCollectorOptions.IsPublic,
+	}
+	return
+}
+
+// CreateCredential : Create a credential from a credential file
+func (adapter *PostureManagementV1Adapter) CreateCredential(createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error) {
+	return adapter.CreateCredentialWithContext(context.Background(), createCredentialOptions)
+}
+
+// CreateCredentialWithContext is an alternate form of the CreateCredential method which supports a Context parameter
+func (adapter *PostureManagementV1Adapter) CreateCredentialWithContext(ctx context.Context, createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createCredentialOptions, "createCredentialOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	if createCredentialOptions.Spec != nil {
+		err = adapter.unsupported("CreateCredential", "credentials are created from a credential file, see NewCreateCredentialOptionsFromFile")
+		return
+	}
+	if createCredentialOptions.Name != nil || createCredentialOptions.Description != nil || createCredentialOptions.Purpose != nil {
+		err = adapter.unsupported("CreateCredential", "the name, description and purpose are read from the credential file")
+		return
+	}
+	if createCredentialOptions.CredentialDataFile == nil {
+		err = fmt.Errorf("createCredentialOptions.CredentialDataFile cannot be nil")
+		return
+	}
+	err = adapter.checkAccountID("CreateCredential", createCredentialOptions.AccountID)
+	if err != nil {
+		return
+	}
+	credential, response, err := adapter.Service.CreateCredentialWithContext(ctx, &posturemanagementv1.CreateCredentialOptions{
+		CredentialDataFile: createCredentialOptions.CredentialDataFile,
+		PemFile:            createCredentialOptions.PemFile,
+		TransactionID:      createCredentialOptions.TransactionID,
+		Headers:            createCredentialOptions.Headers,
+	})
+	if err != nil || credential == nil {
+		return
+	}
+	result = &Credential{
+		ID:          credential.CredentialID,
+		Name:        credential.CredentialName,
+		CreatedTime: credential.CreatedTime,
+	}
+	return
+}
+
+func scanItemFromV1(scan *posturemanagementv1.ScanItem) ScanItem {
+	item := ScanItem{
+		ScanID:           scan.ScanID,
+		ScanName:         scan.ScanName,
+		ScopeID:          scan.ScopeID,
+		ScopeName:        scan.ScopeName,
+		GroupProfileID:   scan.GroupProfileID,
+		GroupProfileName: scan.GroupProfileName,
+		ReportRunBy:      scan.ReportRunBy,
+		StartTime:        scan.StartTime,
+		EndTime:          scan.EndTime,
+		Result:           scanResultFromV1(scan.Result),
+	}
+	if scan.ProfileID != nil {
+		item.Profiles = []ProfileItem{{
+			ID:   scan.ProfileID,
+			Name: scan.ProfileName,
+			Type: normalizeProfileTypePtr(scan.ProfileType),
+		}}
+	}
+	return item
+}
+
+func scanResultFromV1(result *posturemanagementv1.ScanResult) *ScanResult {
+	if result == nil {
+		return nil
+	}
+	return &ScanResult{
+		GoalsPassCount:               result.GoalsPassCount,
+		GoalsUnableToPerformCount:    result.GoalsUnableToPerformCount,
+		GoalsNotApplicableCount:      result.GoalsNotApplicableCount,
+		GoalsFailCount:               result.GoalsFailCount,
+		GoalsTotalCount:              result.GoalsTotalCount,
+		ControlsPassCount:            result.ControlsPassCount,
+		ControlsFailCount:            result.ControlsFailCount,
+		ControlsNotApplicableCount:   result.ControlsNotApplicableCount,
+		ControlsUnableToPerformCount: result.ControlsUnableToPerformCount,
+		ControlsTotalCount:           result.ControlsTotalCount,
+	}
+}
+
+func controlFromV1(control *posturemanagementv1.Control) Control {
+	result := Control{
+		ID:                control.ControlID,
+		Status:            control.Status,
+		ExternalControlID: control.ExternalControlID,
+		Description:       control.ControlDesciption,
+	}
+	if stats := control.ResourceStatistics; stats != nil {
+		result.ResourceStatistics = &ResourceStatistics{
+			PassCount:            stats.ResourcePassCount,
+			FailCount:            stats.ResourceFailCount,
+			UnableToPerformCount: stats.ResourceUnableToPerformCount,
+			NotApplicableCount:   stats.ResourceNotApplicableCount,
+		}
+	}
+	for _, goal := range control.Goals {
+		mapped := Goal{
+			ID:            goal.GoalID,
+			Description:   goal.GoalDescription,
+			Status:        goal.Status,
+			Severity:      goal.Severity,
+			CompletedTime: goal.CompletedTime,
+			Error:         goal.Error,
+		}
+		for _, resource := range goal.ResourceResult {
+			mapped.ResourceResult = append(mapped.ResourceResult, ResourceResult{
+				Name:                 resource.ResourceName,
+				Types:                resource.ResourceTypes,
+				Status:               resource.ResourceStatus,
+				DisplayExpectedValue: resource.DisplayExpectedValue,
+				ActualValue:          resource.ActualValue,
+				ResultsInfo:          resource.ResultsInfo,
+				NotApplicableReason:  resource.NotApplicableReason,
+			})
+		}
+		result.Goals = append(result.Goals, mapped)
+	}
+	return result
+}
+
+func normalizeProfileTypePtr(profileType *string) *string {
+	if profileType == nil {
+		return nil
+	}
+	return core.StringPtr(NormalizeProfileType(*profileType))
+}
@@ -0,0 +1,501 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementcompat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// PostureManagementV2Adapter : The PostureManagement of a PostureManagementV2 service.
+type PostureManagementV2Adapter struct {
+	Service *posturemanagementv2.PostureManagementV2
+
+	// The account ID of requests whose options do not set one.
+	AccountID *string
+}
+
+// NewPostureManagementV2Adapter : Instantiate PostureManagementV2Adapter
+func NewPostureManagementV2Adapter(service *posturemanagementv2.PostureManagementV2, accountID *string) *PostureManagementV2Adapter {
+	return &PostureManagementV2Adapter{
+		Service:   service,
+		AccountID: accountID,
+	}
+}
+
+// Version returns VersionV2Const.
+func (*PostureManagementV2Adapter) Version() Version {
+	return VersionV2Const
+}
+
+func (adapter *PostureManagementV2Adapter) accountID(accountID *string) *string {
+	if accountID != nil {
+		return accountID
+	}
+	return adapter.AccountID
+}
+
+// ListLatestScans : List latest scans
+func (adapter *PostureManagementV2Adapter) ListLatestScans(listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error) {
+	return adapter.ListLatestScansWithContext(context.Background(), listLatestScansOptions)
+}
+
+// ListLatestScansWithContext is an alternate form of the ListLatestScans method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) ListLatestScansWithContext(ctx context.Context, listLatestScansOptions *ListLatestScansOptions) (result *ScanList, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(listLatestScansOptions, "listLatestScansOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	scans, response, err := adapter.Service.ListLatestScansWithContext(ctx, &posturemanagementv2.ListLatestScansOptions{
+		AccountID:     adapter.accountID(listLatestScansOptions.AccountID),
+		TransactionID: listLatestScansOptions.TransactionID,
+		Offset:        listLatestScansOptions.Offset,
+		Limit:         listLatestScansOptions.Limit,
+		Headers:       listLatestScansOptions.Headers,
+	})
+	if err != nil || scans == nil {
+		return
+	}
+	result = &ScanList{
+		Offset:     scans.Offset,
+		Limit:      scans.Limit,
+		TotalCount: scans.TotalCount,
+	}
+	for i := range scans.LatestScans {
+		result.LatestScans = append(result.LatestScans, scanItemFromV2(&scans.LatestScans[i]))
+	}
+	return
+}
+
+// ScansSummary : Retrieve the summary of a specific scan
+func (adapter *PostureManagementV2Adapter) ScansSummary(scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error) {
+	return adapter.ScansSummaryWithContext(context.Background(), scansSummaryOptions)
+}
+
+// ScansSummaryWithContext is an alternate form of the ScansSummary method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) ScansSummaryWithContext(ctx context.Context, scansSummaryOptions *ScansSummaryOptions) (result *Summary, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(scansSummaryOptions, "scansSummaryOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(scansSummaryOptions, "scansSummaryOptions")
+	if err != nil {
+		return
+	}
+	summary, response, err := adapter.Service.ScansSummaryWithContext(ctx, &posturemanagementv2.ScansSummaryOptions{
+		ScanID:        scansSummaryOptions.ScanID,
+		ProfileID:     scansSummaryOptions.ProfileID,
+		AccountID:     adapter.accountID(scansSummaryOptions.AccountID),
+		TransactionID: scansSummaryOptions.TransactionID,
+		Headers:       scansSummaryOptions.Headers,
+	})
+	if err != nil || summary == nil {
+		return
+	}
+	result = &Summary{
+		ScanID:      summary.ID,
+		DiscoverID:  summary.DiscoverID,
+		ProfileID:   summary.ProfileID,
+		ProfileName: summary.ProfileName,
+		ScopeID:     summary.ScopeID,
+	}
+	for _, control := range summary.Controls {
+		result.Controls = append(result.Controls, controlFromV2(&control))
+	}
+	return
+}
+
+// ScanSummaries : List the scan summaries of a report setting, or of a profile on a scope
+func (adapter *PostureManagementV2Adapter) ScanSummaries(scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error) {
+	return adapter.ScanSummariesWithContext(context.Background(), scanSummariesOptions)
+}
+
+// ScanSummariesWithContext is an alternate form of the ScanSummaries method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) ScanSummariesWithContext(ctx context.Context, scanSummariesOptions *ScanSummariesOptions) (result *SummaryList, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(scanSummariesOptions, "scanSummariesOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	reportSettingID := scanSummariesOptions.ReportSettingID
+	if reportSettingID == nil {
+		if scanSummariesOptions.ProfileID == nil || scanSummariesOptions.ScopeID == nil {
+			err = fmt.Errorf("scanSummariesOptions needs ReportSettingID, or ProfileID and ScopeID")
+			return
+		}
+		reportSettingID, err = adapter.findReportSettingID(ctx, scanSummariesOptions)
+		if err != nil {
+			return
+		}
+	}
+	summaries, response, err := adapter.Service.ScanSummariesWithContext(ctx, &posturemanagementv2.ScanSummariesOptions{
+		ReportSettingID: reportSettingID,
+		AccountID:       adapter.accountID(scanSummariesOptions.AccountID),
+		TransactionID:   scanSummariesOptions.TransactionID,
+		Offset:          scanSummariesOptions.Offset,
+		Limit:           scanSummariesOptions.Limit,
+		Headers:         scanSummariesOptions.Headers,
+	})
+	if err != nil || summaries == nil {
+		return
+	}
+	result = &SummaryList{
+		Offset:     summaries.Offset,
+		Limit:      summaries.Limit,
+		TotalCount: summaries.TotalCount,
+	}
+	for _, item := range summaries.Summaries {
+		summary := SummaryItem{
+			ID:          item.ID,
+			Name:        item.Name,
+			ScopeID:     item.ScopeID,
+			ScopeName:   item.ScopeName,
+			ReportRunBy: item.ReportRunBy,
+			StartTime:   item.StartTime,
+			EndTime:     item.EndTime,
+			Status:      item.Status,
+		}
+		summary.Profiles = profileResultsFromV2(item.Profiles)
+		summary.GroupProfiles = profileResultsFromV2(item.GroupProfiles)
+		result.Summaries = append(result.Summaries, summary)
+	}
+	return
+}
+
+// findReportSettingID returns the report setting ID of the latest scan of the profile on the scope.
+func (adapter *PostureManagementV2Adapter) findReportSettingID(ctx context.Context, scanSummariesOptions *ScanSummariesOptions) (*string, error) {
+	var offset int64
+	for {
+		scans, _, err := adapter.Service.ListLatestScansWithContext(ctx, &posturemanagementv2.ListLatestScansOptions{
+			AccountID:     adapter.accountID(scanSummariesOptions.AccountID),
+			TransactionID: scanSummariesOptions.TransactionID,
+			Offset:        core.Int64Ptr(offset),
+			Limit:         core.Int64Ptr(100),
+			Headers:       scanSummariesOptions.Headers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if scans == nil {
+			break
+		}
+		for _, scan := range scans.LatestScans {
+			if core.StringNilMapper(scan.ScopeID) != *scanSummariesOptions.ScopeID || scan.ReportSettingID == nil {
+				continue
+			}
+			for _, profile := range scan.Profiles {
+				if core.StringNilMapper(profile.ID) == *scanSummariesOptions.ProfileID {
+					return scan.ReportSettingID, nil
+				}
+			}
+		}
+		offset += int64(len(scans.LatestScans))
+		if len(scans.LatestScans) == 0 || scans.TotalCount == nil || offset >= *scans.TotalCount {
+			break
+		}
+	}
+	return nil, fmt.Errorf("no scan of profile %s on scope %s was found to list the summaries of", *scanSummariesOptions.ProfileID, *scanSummariesOptions.ScopeID)
+}
+
+// CreateValidation : Initiate a validation scan
+func (adapter *PostureManagementV2Adapter) CreateValidation(createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error) {
+	return adapter.CreateValidationWithContext(context.Background(), createValidationOptions)
+}
+
+// CreateValidationWithContext is an alternate form of the CreateValidation method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) CreateValidationWithContext(ctx context.Context, createValidationOptions *CreateValidationOptions) (result *Result, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createValidationOptions, "createValidationOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createValidationOptions, "createValidationOptions")
+	if err != nil {
+		return
+	}
+	validation, response, err := adapter.Service.CreateValidationWithContext(ctx, &posturemanagementv2.CreateValidationOptions{
+		ScopeID:         createValidationOptions.ScopeID,
+		ProfileID:       createValidationOptions.ProfileID,
+		GroupProfileID:  createValidationOptions.GroupProfileID,
+		Name:            createValidationOptions.Name,
+		Description:     createValidationOptions.Description,
+		Frequency:       createValidationOptions.Frequency,
+		NoOfOccurrences: createValidationOptions.NoOfOccurrences,
+		EndTime:         createValidationOptions.EndTime,
+		AccountID:       adapter.accountID(createValidationOptions.AccountID),
+		TransactionID:   createValidationOptions.TransactionID,
+		Headers:         createValidationOptions.Headers,
+	})
+	if err != nil || validation == nil {
+		return
+	}
+	result = &Result{
+		Result:  validation.Result,
+		Message: validation.Message,
+	}
+	return
+}
+
+// CreateScope : Create a scope
+func (adapter *PostureManagementV2Adapter) CreateScope(createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error) {
+	return adapter.CreateScopeWithContext(context.Background(), createScopeOptions)
+}
+
+// CreateScopeWithContext is an alternate form of the CreateScope method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) CreateScopeWithContext(ctx context.Context, createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createScopeOptions, "createScopeOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createScopeOptions, "createScopeOptions")
+	if err != nil {
+		return
+	}
+	scope, response, err := adapter.Service.CreateScopeWithContext(ctx, &posturemanagementv2.CreateScopeOptions{
+		Name:                 createScopeOptions.Name,
+		Description:          createScopeOptions.Description,
+		CollectorIds:         createScopeOptions.CollectorIds,
+		CredentialID:         createScopeOptions.CredentialID,
+		CredentialType:       createScopeOptions.EnvironmentType,
+		Interval:             createScopeOptions.Interval,
+		IsDiscoveryScheduled: createScopeOptions.IsDiscoveryScheduled,
+		AccountID:            adapter.accountID(createScopeOptions.AccountID),
+		TransactionID:        createScopeOptions.TransactionID,
+		Headers:              createScopeOptions.Headers,
+	})
+	if err != nil || scope == nil {
+		return
+	}
+	// The response does not always repeat the collectors, credential and environment, so the requested ones are
+	// used when it does not.
+	result = &Scope{
+		ID:              scope.ID,
+		Name:            scope.Name,
+		Description:     scope.Description,
+		CollectorIds:    createScopeOptions.CollectorIds,
+		CredentialID:    createScopeOptions.CredentialID,
+		EnvironmentType: createScopeOptions.EnvironmentType,
+	}
+	if len(scope.Collectors) > 0 {
+		result.CollectorIds = nil
+		for _, collector := range scope.Collectors {
+			result.CollectorIds = append(result.CollectorIds, core.StringNilMapper(collector.ID))
+		}
+	}
+	if scope.TldCredentail != nil && scope.TldCredentail.ID != nil {
+		result.CredentialID = scope.TldCredentail.ID
+	}
+	if scope.CloudType != nil {
+		result.EnvironmentType = scope.CloudType
+	}
+	if scope.CreatedAt != nil {
+		if createdTime, parseErr := strfmt.ParseDateTime(*scope.CreatedAt); parseErr == nil {
+			result.CreatedTime = &createdTime
+		}
+	}
+	return
+}
+
+// CreateCollector : Create a collector
+func (adapter *PostureManagementV2Adapter) CreateCollector(createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error) {
+	return adapter.CreateCollectorWithContext(context.Background(), createCollectorOptions)
+}
+
+// CreateCollectorWithContext is an alternate form of the CreateCollector method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) CreateCollectorWithContext(ctx context.Context, createCollectorOptions *CreateCollectorOptions) (result *Collector, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createCollectorOptions, "createCollectorOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(createCollectorOptions, "createCollectorOptions")
+	if err != nil {
+		return
+	}
+	collector, response, err := adapter.Service.CreateCollectorWithContext(ctx, &posturemanagementv2.CreateCollectorOptions{
+		Name:          createCollectorOptions.Name,
+		IsPublic:      createCollectorOptions.IsPublic,
+		ManagedBy:     createCollectorOptions.ManagedBy,
+		Description:   createCollectorOptions.Description,
+		Passphrase:    createCollectorOptions.Passphrase,
+		IsUbiImage:    createCollectorOptions.IsUbiImage,
+		AccountID:     adapter.accountID(createCollectorOptions.AccountID),
+		TransactionID: createCollectorOptions.TransactionID,
+		Headers:       createCollectorOptions.Headers,
+	})
+	if err != nil || collector == nil {
+		return
+	}
+	result = &Collector{
+		ID:               collector.ID,
+		Name:             collector.Name,
+		DisplayName:      collector.DisplayName,
+		Status:           collector.Status,
+		ManagedBy:        collector.ManagedBy,
+		IsPublic:         collector.IsPublic,
+		RegistrationCode: collector.RegistrationCode,
+	}
+	return
+}
+
+// CreateCredential : Create a credential from a typed credential spec
+func (adapter *PostureManagementV2Adapter) CreateCredential(createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error) {
+	return adapter.CreateCredentialWithContext(context.Background(), createCredentialOptions)
+}
+
+// CreateCredentialWithContext is an alternate form of the CreateCredential method which supports a Context parameter
+func (adapter *PostureManagementV2Adapter) CreateCredentialWithContext(ctx context.Context, createCredentialOptions *CreateCredentialOptions) (result *Credential, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createCredentialOptions, "createCredentialOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	if createCredentialOptions.Spec == nil {
+		err = &UnsupportedOperationError{
+			Version:   VersionV2Const,
+			Operation: "CreateCredential",
+			Reason:    "credentials are created from typed fields, not from a credential file, see NewCreateCredentialOptionsFromSpec",
+		}
+		return
+	}
+	if createCredentialOptions.CredentialDataFile != nil || createCredentialOptions.PemFile != nil {
+		err = &UnsupportedOperationError{
+			Version:   VersionV2Const,
+			Operation: "CreateCredential",
+			Reason:    "credential and PEM files are only supported by v1",
+		}
+		return
+	}
+	options, err := adapter.Service.NewCreateCredentialOptionsFromSpec(
+		core.StringNilMapper(createCredentialOptions.Name),
+		core.StringNilMapper(createCredentialOptions.Description),
+		core.StringNilMapper(createCredentialOptions.Purpose),
+		createCredentialOptions.Spec,
+	)
+	if err != nil {
+		return
+	}
+	options.AccountID = adapter.accountID(createCredentialOptions.AccountID)
+	options.TransactionID = createCredentialOptions.TransactionID
+	options.Headers = createCredentialOptions.Headers
+	credential, response, err := adapter.Service.CreateCredentialWithContext(ctx, options)
+	if err != nil || credential == nil {
+		return
+	}
+	result = &Credential{
+		ID:          credential.ID,
+		Name:        credential.Name,
+		Type:        credential.Type,
+		Purpose:     credential.Purpose,
+		CreatedTime: credential.CreatedAt,
+	}
+	return
+}
+
+func scanItemFromV2(scan *posturemanagementv2.ScanItem) ScanItem {
+	item := ScanItem{
+		ScanID:           scan.ScanID,
+		ScanName:         scan.ScanName,
+		ScopeID:          scan.ScopeID,
+		ScopeName:        scan.ScopeName,
+		GroupProfileID:   scan.GroupProfileID,
+		GroupProfileName: scan.GroupProfileName,
+		ReportRunBy:      scan.ReportRunBy,
+		StartTime:        scan.StartTime,
+		EndTime:          scan.EndTime,
+		ReportSettingID:  scan.ReportSettingID,
+		Result:           scanResultFromV2(scan.Result),
+	}
+	for _, profile := range scan.Profiles {
+		item.Profiles = append(item.Profiles, ProfileItem{
+			ID:   profile.ID,
+			Name: profile.Name,
+			Type: normalizeProfileTypePtr(profile.Type),
+		})
+	}
+	return item
+}
+
+func profileResultsFromV2(profiles []posturemanagementv2.ProfileResult) (results []ProfileResult) {
+	for _, profile := range profiles {
+		results = append(results, ProfileResult{
+			ID:               profile.ID,
+			Name:             profile.Name,
+			Type:             normalizeProfileTypePtr(profile.Type),
+			ValidationResult: scanResultFromV2(profile.ValidationResult),
+		})
+	}
+	return
+}
+
+func scanResultFromV2(result *posturemanagementv2.ScanResult) *ScanResult {
+	if result == nil {
+		return nil
+	}
+	return &ScanResult{
+		GoalsPassCount:               result.GoalsPassCount,
+		GoalsUnableToPerformCount:    result.GoalsUnableToPerformCount,
+		GoalsNotApplicableCount:      result.GoalsNotApplicableCount,
+		GoalsFailCount:               result.GoalsFailCount,
+		GoalsTotalCount:              result.GoalsTotalCount,
+		ControlsPassCount:            result.ControlsPassCount,
+		ControlsFailCount:            result.ControlsFailCount,
+		ControlsNotApplicableCount:   result.ControlsNotApplicableCount,
+		ControlsUnableToPerformCount: result.ControlsUnableToPerformCount,
+		ControlsTotalCount:           result.ControlsTotalCount,
+	}
+}
+
+func controlFromV2(control *posturemanagementv2.Control) Control {
+	result := Control{
+		ID:                control.ID,
+		Status:            control.Status,
+		ExternalControlID: control.ExternalControlID,
+		Description:       control.Description,
+	}
+	if stats := control.ResourceStatistics; stats != nil {
+		result.ResourceStatistics = &ResourceStatistics{
+			PassCount:            stats.PassCount,
+			FailCount:            stats.FailCount,
+			UnableToPerformCount: stats.UnableToPerformCount,
+			NotApplicableCount:   stats.NotApplicableCount,
+		}
+	}
+	for _, goal := range control.Goals {
+		mapped := Goal{
+			ID:            goal.ID,
+			Description:   goal.Description,
+			Status:        goal.Status,
+			Severity:      goal.Severity,
+			CompletedTime: goal.CompletedTime,
+			Error:         goal.Error,
+		}
+		for _, resource := range goal.ResourceResult {
+			mapped.ResourceResult = append(mapped.ResourceResult, ResourceResult{
+				Name:                 resource.Name,
+				Types:                resource.Types,
+				Status:               resource.Status,
+				DisplayExpectedValue: resource.DisplayExpectedValue,
+				ActualValue:          resource.ActualValue,
+				ResultsInfo:          resource.ResultsInfo,
+				NotApplicableReason:  resource.NotApplicableReason,
+			})
+		}
+		result.Goals = append(result.Goals, mapped)
+	}
+	return result
+}
@@ -872,6 +872,156 @@ type ApplicabilityCriteria struct {
 
 	// The resource that is scanned as part of your scope.
 	ResourceDescription map[string]string `json:"resource_description,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) MarshalJSON() (buffer []byte, err error) {
+	type model ApplicabilityCriteria
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEnvironment returns Environment, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironment() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Environment
+}
+
+// GetResource returns Resource, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResource() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Resource
+}
+
+// GetEnvironmentCategory returns EnvironmentCategory, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategory
+}
+
+// GetResourceCategory returns ResourceCategory, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategory
+}
+
+// GetResourceType returns ResourceType, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceType() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceType
+}
+
+// GetSoftwareDetails returns SoftwareDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetSoftwareDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.SoftwareDetails
+}
+
+// GetOsDetails returns OsDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetOsDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.OsDetails
+}
+
+// GetAdditionalDetails returns AdditionalDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetAdditionalDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalDetails
+}
+
+// GetEnvironmentCategoryDescription returns EnvironmentCategoryDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategoryDescription
+}
+
+// GetEnvironmentDescription returns EnvironmentDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentDescription
+}
+
+// GetResourceCategoryDescription returns ResourceCategoryDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategoryDescription
+}
+
+// GetResourceTypeDescription returns ResourceTypeDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceTypeDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTypeDescription
+}
+
+// GetResourceDescription returns ResourceDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceDescription
 }
 
 // UnmarshalApplicabilityCriteria unmarshals an instance of ApplicabilityCriteria from the specified map of raw messages.
@@ -929,6 +1079,10 @@ func UnmarshalApplicabilityCriteria(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "environment", "resource", "environment_category", "resource_category", "resource_type", "software_details", "os_details", "additional_details", "environment_category_description", "environment_description", "resource_category_description", "resource_type_description", "resource_description")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -937,6 +1091,60 @@ func UnmarshalApplicabilityCriteria(m map[string]json.RawMessage, result interfa
 type Collector struct {
 	// An identifier of the collector.
 	CollectorID *string `json:"collector_id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Collector
+func (o *Collector) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Collector
+func (o *Collector) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Collector
+func (o *Collector) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Collector
+func (o *Collector) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Collector
+func (o *Collector) MarshalJSON() (buffer []byte, err error) {
+	type model Collector
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCollectorID returns the value of CollectorID, or the zero value when CollectorID or the Collector is nil
+func (o *Collector) GetCollectorID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CollectorID)
 }
 
 // UnmarshalCollector unmarshals an instance of Collector from the specified map of raw messages.
@@ -946,6 +1154,10 @@ func UnmarshalCollector(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "collector_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -969,6 +1181,9 @@ type Control struct {
 
 	// A scans summary controls.
 	ResourceStatistics *ResourceStatistics `json:"resource_statistics,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Control.Status property.
@@ -978,6 +1193,97 @@ const (
 	ControlStatusUnableToPerformConst = "unable_to_perform"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Control
+func (o *Control) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Control
+func (o *Control) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Control
+func (o *Control) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Control
+func (o *Control) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Control
+func (o *Control) MarshalJSON() (buffer []byte, err error) {
+	type model Control
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetControlID returns the value of ControlID, or the zero value when ControlID or the Control is nil
+func (o *Control) GetControlID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Control is nil
+func (o *Control) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetExternalControlID returns the value of ExternalControlID, or the zero value when ExternalControlID or the Control is nil
+func (o *Control) GetExternalControlID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ExternalControlID)
+}
+
+// GetControlDesciption returns the value of ControlDesciption, or the zero value when ControlDesciption or the Control is nil
+func (o *Control) GetControlDesciption() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlDesciption)
+}
+
+// GetGoals returns Goals, or nil when the Control is nil
+func (o *Control) GetGoals() []Goal {
+	if o == nil {
+		return nil
+	}
+	return o.Goals
+}
+
+// GetResourceStatistics returns ResourceStatistics, or nil when the Control is nil
+func (o *Control) GetResourceStatistics() *ResourceStatistics {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceStatistics
+}
+
 // UnmarshalControl unmarshals an instance of Control from the specified map of raw messages.
 func UnmarshalControl(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Control)
@@ -1005,6 +1311,10 @@ func UnmarshalControl(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "control_id", "status", "external_control_id", "control_desciption", "goals", "resource_statistics")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1301,6 +1611,76 @@ type Credential struct {
 
 	// The creation time of the credential.
 	CreatedTime *strfmt.DateTime `json:"created_time,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Credential
+func (o *Credential) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Credential
+func (o *Credential) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Credential
+func (o *Credential) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Credential
+func (o *Credential) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Credential
+func (o *Credential) MarshalJSON() (buffer []byte, err error) {
+	type model Credential
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCredentialID returns the value of CredentialID, or the zero value when CredentialID or the Credential is nil
+func (o *Credential) GetCredentialID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialID)
+}
+
+// GetCredentialName returns the value of CredentialName, or the zero value when CredentialName or the Credential is nil
+func (o *Credential) GetCredentialName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialName)
+}
+
+// GetCreatedTime returns the value of CreatedTime, or the zero value when CreatedTime or the Credential is nil
+func (o *Credential) GetCreatedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedTime)
 }
 
 // UnmarshalCredential unmarshals an instance of Credential from the specified map of raw messages.
@@ -1318,6 +1698,10 @@ func UnmarshalCredential(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "credential_id", "credential_name", "created_time")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1347,6 +1731,9 @@ type Goal struct {
 
 	// The criteria that defines how a profile applies.
 	GoalApplicabilityCriteria *GoalApplicabilityCriteria `json:"goal_applicability_criteria,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Goal.Status property.
@@ -1356,6 +1743,113 @@ const (
 	GoalStatusPassConst = "pass"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Goal
+func (o *Goal) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Goal
+func (o *Goal) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Goal
+func (o *Goal) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Goal
+func (o *Goal) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Goal
+func (o *Goal) MarshalJSON() (buffer []byte, err error) {
+	type model Goal
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetGoalDescription returns the value of GoalDescription, or the zero value when GoalDescription or the Goal is nil
+func (o *Goal) GetGoalDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GoalDescription)
+}
+
+// GetGoalID returns the value of GoalID, or the zero value when GoalID or the Goal is nil
+func (o *Goal) GetGoalID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GoalID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Goal is nil
+func (o *Goal) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetSeverity returns the value of Severity, or the zero value when Severity or the Goal is nil
+func (o *Goal) GetSeverity() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Severity)
+}
+
+// GetCompletedTime returns the value of CompletedTime, or the zero value when CompletedTime or the Goal is nil
+func (o *Goal) GetCompletedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CompletedTime)
+}
+
+// GetError returns the value of Error, or the zero value when Error or the Goal is nil
+func (o *Goal) GetError() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Error)
+}
+
+// GetResourceResult returns ResourceResult, or nil when the Goal is nil
+func (o *Goal) GetResourceResult() []ResourceResult {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceResult
+}
+
+// GetGoalApplicabilityCriteria returns GoalApplicabilityCriteria, or nil when the Goal is nil
+func (o *Goal) GetGoalApplicabilityCriteria() *GoalApplicabilityCriteria {
+	if o == nil {
+		return nil
+	}
+	return o.GoalApplicabilityCriteria
+}
+
 // UnmarshalGoal unmarshals an instance of Goal from the specified map of raw messages.
 func UnmarshalGoal(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Goal)
@@ -1391,6 +1885,10 @@ func UnmarshalGoal(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "goal_description", "goal_id", "status", "severity", "completed_time", "error", "resource_result", "goal_applicability_criteria")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1435,28 +1933,178 @@ type GoalApplicabilityCriteria struct {
 
 	// The resource that is scanned as part of your scope.
 	ResourceDescription map[string]string `json:"resource_description,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalGoalApplicabilityCriteria unmarshals an instance of GoalApplicabilityCriteria from the specified map of raw messages.
-func UnmarshalGoalApplicabilityCriteria(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(GoalApplicabilityCriteria)
-	err = core.UnmarshalPrimitive(m, "environment", &obj.Environment)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "resource", &obj.Resource)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "environment_category", &obj.EnvironmentCategory)
-	if err != nil {
-		return
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalPrimitive(m, "resource_category", &obj.ResourceCategory)
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) MarshalJSON() (buffer []byte, err error) {
+	type model GoalApplicabilityCriteria
+	buffer, err = json.Marshal((*model)(o))
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEnvironment returns Environment, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironment() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Environment
+}
+
+// GetResource returns Resource, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResource() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Resource
+}
+
+// GetEnvironmentCategory returns EnvironmentCategory, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategory
+}
+
+// GetResourceCategory returns ResourceCategory, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategory
+}
+
+// GetResourceType returns ResourceType, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceType() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceType
+}
+
+// GetSoftwareDetails returns SoftwareDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetSoftwareDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.SoftwareDetails
+}
+
+// GetOsDetails returns OsDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetOsDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.OsDetails
+}
+
+// GetAdditionalDetails returns AdditionalDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetAdditionalDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalDetails
+}
+
+// GetEnvironmentCategoryDescription returns EnvironmentCategoryDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategoryDescription
+}
+
+// GetEnvironmentDescription returns EnvironmentDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentDescription
+}
+
+// GetResourceCategoryDescription returns ResourceCategoryDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategoryDescription
+}
+
+// GetResourceTypeDescription returns ResourceTypeDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceTypeDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTypeDescription
+}
+
+// GetResourceDescription returns ResourceDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceDescription
+}
+
+// UnmarshalGoalApplicabilityCriteria unmarshals an instance of GoalApplicabilityCriteria from the specified map of raw messages.
+func UnmarshalGoalApplicabilityCriteria(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(GoalApplicabilityCriteria)
+	err = core.UnmarshalPrimitive(m, "environment", &obj.Environment)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "resource", &obj.Resource)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "environment_category", &obj.EnvironmentCategory)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "resource_category", &obj.ResourceCategory)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
 	if err != nil {
 		return
 	}
@@ -1492,6 +2140,10 @@ func UnmarshalGoalApplicabilityCriteria(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "environment", "resource", "environment_category", "resource_category", "resource_type", "software_details", "os_details", "additional_details", "environment_category_description", "environment_description", "resource_category_description", "resource_type_description", "resource_description")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1513,6 +2165,9 @@ type GroupProfileResult struct {
 
 	// The result of a each profile in group profile.
 	Profiles []ProfilesResult `json:"profiles,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the GroupProfileResult.ProfileType property.
@@ -1527,6 +2182,89 @@ const (
 	GroupProfileResultProfileTypeTemmplategroupConst      = "temmplategroup"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GroupProfileResult
+func (o *GroupProfileResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GroupProfileResult
+func (o *GroupProfileResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GroupProfileResult
+func (o *GroupProfileResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GroupProfileResult
+func (o *GroupProfileResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GroupProfileResult
+func (o *GroupProfileResult) MarshalJSON() (buffer []byte, err error) {
+	type model GroupProfileResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetGroupProfileID returns the value of GroupProfileID, or the zero value when GroupProfileID or the GroupProfileResult is nil
+func (o *GroupProfileResult) GetGroupProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileID)
+}
+
+// GetGroupProfileName returns the value of GroupProfileName, or the zero value when GroupProfileName or the GroupProfileResult is nil
+func (o *GroupProfileResult) GetGroupProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileName)
+}
+
+// GetProfileType returns the value of ProfileType, or the zero value when ProfileType or the GroupProfileResult is nil
+func (o *GroupProfileResult) GetProfileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileType)
+}
+
+// GetValidationResult returns ValidationResult, or nil when the GroupProfileResult is nil
+func (o *GroupProfileResult) GetValidationResult() *ScanResult {
+	if o == nil {
+		return nil
+	}
+	return o.ValidationResult
+}
+
+// GetProfiles returns Profiles, or nil when the GroupProfileResult is nil
+func (o *GroupProfileResult) GetProfiles() []ProfilesResult {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
+}
+
 // UnmarshalGroupProfileResult unmarshals an instance of GroupProfileResult from the specified map of raw messages.
 func UnmarshalGroupProfileResult(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(GroupProfileResult)
@@ -1550,6 +2288,10 @@ func UnmarshalGroupProfileResult(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "group_profile_id", "group_profile_name", "profile_type", "validation_result", "profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1711,6 +2453,9 @@ type ProfileItem struct {
 
 	// The profile status. If the profile is enabled, the value is true. If the profile is disabled, the value is false.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ProfileItem.ProfileType property.
@@ -1721,6 +2466,153 @@ const (
 	ProfileItemProfileTypeTemplateGroupConst = "template_group"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfileItem
+func (o *ProfileItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfileItem
+func (o *ProfileItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfileItem
+func (o *ProfileItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfileItem
+func (o *ProfileItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfileItem
+func (o *ProfileItem) MarshalJSON() (buffer []byte, err error) {
+	type model ProfileItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ProfileItem is nil
+func (o *ProfileItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ProfileItem is nil
+func (o *ProfileItem) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetVersion returns the value of Version, or the zero value when Version or the ProfileItem is nil
+func (o *ProfileItem) GetVersion() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Version)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the ProfileItem is nil
+func (o *ProfileItem) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the ProfileItem is nil
+func (o *ProfileItem) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetReasonForDelete returns the value of ReasonForDelete, or the zero value when ReasonForDelete or the ProfileItem is nil
+func (o *ProfileItem) GetReasonForDelete() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReasonForDelete)
+}
+
+// GetApplicabilityCriteria returns ApplicabilityCriteria, or nil when the ProfileItem is nil
+func (o *ProfileItem) GetApplicabilityCriteria() *ApplicabilityCriteria {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicabilityCriteria
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the ProfileItem is nil
+func (o *ProfileItem) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetBaseProfile returns the value of BaseProfile, or the zero value when BaseProfile or the ProfileItem is nil
+func (o *ProfileItem) GetBaseProfile() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.BaseProfile)
+}
+
+// GetProfileType returns the value of ProfileType, or the zero value when ProfileType or the ProfileItem is nil
+func (o *ProfileItem) GetProfileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileType)
+}
+
+// GetCreatedTime returns the value of CreatedTime, or the zero value when CreatedTime or the ProfileItem is nil
+func (o *ProfileItem) GetCreatedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedTime)
+}
+
+// GetModifiedTime returns the value of ModifiedTime, or the zero value when ModifiedTime or the ProfileItem is nil
+func (o *ProfileItem) GetModifiedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ModifiedTime)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the ProfileItem is nil
+func (o *ProfileItem) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
 // UnmarshalProfileItem unmarshals an instance of ProfileItem from the specified map of raw messages.
 func UnmarshalProfileItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ProfileItem)
@@ -1776,6 +2668,10 @@ func UnmarshalProfileItem(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "description", "version", "created_by", "modified_by", "reason_for_delete", "applicability_criteria", "profile_id", "base_profile", "profile_type", "created_time", "modified_time", "enabled")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1794,6 +2690,9 @@ type ProfileResult struct {
 
 	// The result of a scan.
 	ValidationResult *ScanResult `json:"validation_result,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ProfileResult.ProfileType property.
@@ -1808,6 +2707,81 @@ const (
 	ProfileResultProfileTypeTemmplategroupConst      = "temmplategroup"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfileResult
+func (o *ProfileResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfileResult
+func (o *ProfileResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfileResult
+func (o *ProfileResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfileResult
+func (o *ProfileResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfileResult
+func (o *ProfileResult) MarshalJSON() (buffer []byte, err error) {
+	type model ProfileResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the ProfileResult is nil
+func (o *ProfileResult) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetProfileName returns the value of ProfileName, or the zero value when ProfileName or the ProfileResult is nil
+func (o *ProfileResult) GetProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileName)
+}
+
+// GetProfileType returns the value of ProfileType, or the zero value when ProfileType or the ProfileResult is nil
+func (o *ProfileResult) GetProfileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileType)
+}
+
+// GetValidationResult returns ValidationResult, or nil when the ProfileResult is nil
+func (o *ProfileResult) GetValidationResult() *ScanResult {
+	if o == nil {
+		return nil
+	}
+	return o.ValidationResult
+}
+
 // UnmarshalProfileResult unmarshals an instance of ProfileResult from the specified map of raw messages.
 func UnmarshalProfileResult(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ProfileResult)
@@ -1827,6 +2801,10 @@ func UnmarshalProfileResult(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "profile_id", "profile_name", "profile_type", "validation_result")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1856,43 +2834,157 @@ type ProfilesList struct {
 
 	// Profiles.
 	Profiles []ProfileItem `json:"profiles,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalProfilesList unmarshals an instance of ProfilesList from the specified map of raw messages.
-func UnmarshalProfilesList(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(ProfilesList)
-	err = core.UnmarshalPrimitive(m, "offset", &obj.Offset)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesList
+func (o *ProfilesList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesList
+func (o *ProfilesList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
-	if err != nil {
-		return
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesList
+func (o *ProfilesList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalProfilesListFirst)
-	if err != nil {
-		return
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesList
+func (o *ProfilesList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalModel(m, "last", &obj.Last, UnmarshalProfilesListLast)
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesList
+func (o *ProfilesList) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesList
+	buffer, err = json.Marshal((*model)(o))
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalModel(m, "previous", &obj.Previous, UnmarshalProfilesListPrevious)
-	if err != nil {
-		return
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ProfilesList is nil
+func (o *ProfilesList) GetOffset() int64 {
+	if o == nil {
+		return 0
 	}
-	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalProfilesListNext)
-	if err != nil {
-		return
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ProfilesList is nil
+func (o *ProfilesList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ProfilesList is nil
+func (o *ProfilesList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ProfilesList is nil
+func (o *ProfilesList) GetFirst() *ProfilesListFirst {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ProfilesList is nil
+func (o *ProfilesList) GetLast() *ProfilesListLast {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ProfilesList is nil
+func (o *ProfilesList) GetPrevious() *ProfilesListPrevious {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ProfilesList is nil
+func (o *ProfilesList) GetNext() *ProfilesListNext {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetProfiles returns Profiles, or nil when the ProfilesList is nil
+func (o *ProfilesList) GetProfiles() []ProfileItem {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
+}
+
+// UnmarshalProfilesList unmarshals an instance of ProfilesList from the specified map of raw messages.
+func UnmarshalProfilesList(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ProfilesList)
+	err = core.UnmarshalPrimitive(m, "offset", &obj.Offset)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalProfilesListFirst)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "last", &obj.Last, UnmarshalProfilesListLast)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "previous", &obj.Previous, UnmarshalProfilesListPrevious)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalProfilesListNext)
+	if err != nil {
+		return
 	}
 	err = core.UnmarshalModel(m, "profiles", &obj.Profiles, UnmarshalProfileItem)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1918,6 +3010,60 @@ func (resp *ProfilesList) GetNextOffset() (*int64, error) {
 type ProfilesListFirst struct {
 	// The URL of the first page of profiles.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesListFirst
+func (o *ProfilesListFirst) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesListFirst
+func (o *ProfilesListFirst) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesListFirst
+func (o *ProfilesListFirst) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesListFirst
+func (o *ProfilesListFirst) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesListFirst
+func (o *ProfilesListFirst) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesListFirst
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ProfilesListFirst is nil
+func (o *ProfilesListFirst) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalProfilesListFirst unmarshals an instance of ProfilesListFirst from the specified map of raw messages.
@@ -1927,6 +3073,10 @@ func UnmarshalProfilesListFirst(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1935,6 +3085,60 @@ func UnmarshalProfilesListFirst(m map[string]json.RawMessage, result interface{}
 type ProfilesListLast struct {
 	// The URL of the last page of profiles.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesListLast
+func (o *ProfilesListLast) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesListLast
+func (o *ProfilesListLast) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesListLast
+func (o *ProfilesListLast) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesListLast
+func (o *ProfilesListLast) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesListLast
+func (o *ProfilesListLast) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesListLast
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ProfilesListLast is nil
+func (o *ProfilesListLast) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalProfilesListLast unmarshals an instance of ProfilesListLast from the specified map of raw messages.
@@ -1944,6 +3148,10 @@ func UnmarshalProfilesListLast(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1952,6 +3160,60 @@ func UnmarshalProfilesListLast(m map[string]json.RawMessage, result interface{})
 type ProfilesListNext struct {
 	// The URL of the next page of profiles.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesListNext
+func (o *ProfilesListNext) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesListNext
+func (o *ProfilesListNext) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesListNext
+func (o *ProfilesListNext) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesListNext
+func (o *ProfilesListNext) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesListNext
+func (o *ProfilesListNext) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesListNext
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ProfilesListNext is nil
+func (o *ProfilesListNext) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalProfilesListNext unmarshals an instance of ProfilesListNext from the specified map of raw messages.
@@ -1961,6 +3223,10 @@ func UnmarshalProfilesListNext(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1969,6 +3235,60 @@ func UnmarshalProfilesListNext(m map[string]json.RawMessage, result interface{})
 type ProfilesListPrevious struct {
 	// The URL of the previous page of profiles.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesListPrevious
+func (o *ProfilesListPrevious) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesListPrevious
+func (o *ProfilesListPrevious) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesListPrevious
+func (o *ProfilesListPrevious) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesListPrevious
+func (o *ProfilesListPrevious) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesListPrevious
+func (o *ProfilesListPrevious) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesListPrevious
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ProfilesListPrevious is nil
+func (o *ProfilesListPrevious) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalProfilesListPrevious unmarshals an instance of ProfilesListPrevious from the specified map of raw messages.
@@ -1978,6 +3298,10 @@ func UnmarshalProfilesListPrevious(m map[string]json.RawMessage, result interfac
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1996,6 +3320,9 @@ type ProfilesResult struct {
 
 	// The result of a scan.
 	ValidationResult *Results `json:"validation_result,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ProfilesResult.ProfileType property.
@@ -2010,6 +3337,81 @@ const (
 	ProfilesResultProfileTypeTemmplategroupConst      = "temmplategroup"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfilesResult
+func (o *ProfilesResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfilesResult
+func (o *ProfilesResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfilesResult
+func (o *ProfilesResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfilesResult
+func (o *ProfilesResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfilesResult
+func (o *ProfilesResult) MarshalJSON() (buffer []byte, err error) {
+	type model ProfilesResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the ProfilesResult is nil
+func (o *ProfilesResult) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetProfileName returns the value of ProfileName, or the zero value when ProfileName or the ProfilesResult is nil
+func (o *ProfilesResult) GetProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileName)
+}
+
+// GetProfileType returns the value of ProfileType, or the zero value when ProfileType or the ProfilesResult is nil
+func (o *ProfilesResult) GetProfileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileType)
+}
+
+// GetValidationResult returns ValidationResult, or nil when the ProfilesResult is nil
+func (o *ProfilesResult) GetValidationResult() *Results {
+	if o == nil {
+		return nil
+	}
+	return o.ValidationResult
+}
+
 // UnmarshalProfilesResult unmarshals an instance of ProfilesResult from the specified map of raw messages.
 func UnmarshalProfilesResult(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ProfilesResult)
@@ -2029,6 +3431,10 @@ func UnmarshalProfilesResult(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "profile_id", "profile_name", "profile_type", "validation_result")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2055,6 +3461,9 @@ type ResourceResult struct {
 
 	// The reason for goal not applicable for a resource.
 	NotApplicableReason *string `json:"not_applicable_reason,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ResourceResult.ResourceStatus property.
@@ -2064,6 +3473,105 @@ const (
 	ResourceResultResourceStatusUnableToPerformConst = "unable_to_perform"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceResult
+func (o *ResourceResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceResult
+func (o *ResourceResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceResult
+func (o *ResourceResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceResult
+func (o *ResourceResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceResult
+func (o *ResourceResult) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetResourceName returns the value of ResourceName, or the zero value when ResourceName or the ResourceResult is nil
+func (o *ResourceResult) GetResourceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceName)
+}
+
+// GetResourceTypes returns the value of ResourceTypes, or the zero value when ResourceTypes or the ResourceResult is nil
+func (o *ResourceResult) GetResourceTypes() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceTypes)
+}
+
+// GetResourceStatus returns the value of ResourceStatus, or the zero value when ResourceStatus or the ResourceResult is nil
+func (o *ResourceResult) GetResourceStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceStatus)
+}
+
+// GetDisplayExpectedValue returns the value of DisplayExpectedValue, or the zero value when DisplayExpectedValue or the ResourceResult is nil
+func (o *ResourceResult) GetDisplayExpectedValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DisplayExpectedValue)
+}
+
+// GetActualValue returns the value of ActualValue, or the zero value when ActualValue or the ResourceResult is nil
+func (o *ResourceResult) GetActualValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ActualValue)
+}
+
+// GetResultsInfo returns the value of ResultsInfo, or the zero value when ResultsInfo or the ResourceResult is nil
+func (o *ResourceResult) GetResultsInfo() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResultsInfo)
+}
+
+// GetNotApplicableReason returns the value of NotApplicableReason, or the zero value when NotApplicableReason or the ResourceResult is nil
+func (o *ResourceResult) GetNotApplicableReason() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.NotApplicableReason)
+}
+
 // UnmarshalResourceResult unmarshals an instance of ResourceResult from the specified map of raw messages.
 func UnmarshalResourceResult(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ResourceResult)
@@ -2095,6 +3603,10 @@ func UnmarshalResourceResult(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "resource_name", "resource_types", "resource_status", "display_expected_value", "actual_value", "results_info", "not_applicable_reason")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2112,6 +3624,84 @@ type ResourceStatistics struct {
 
 	// The resource count of not applicable(na) controls.
 	ResourceNotApplicableCount *int64 `json:"resource_not_applicable_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceStatistics
+func (o *ResourceStatistics) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceStatistics
+func (o *ResourceStatistics) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceStatistics
+func (o *ResourceStatistics) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceStatistics
+func (o *ResourceStatistics) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceStatistics
+func (o *ResourceStatistics) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceStatistics
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetResourcePassCount returns the value of ResourcePassCount, or the zero value when ResourcePassCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetResourcePassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ResourcePassCount)
+}
+
+// GetResourceFailCount returns the value of ResourceFailCount, or the zero value when ResourceFailCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetResourceFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ResourceFailCount)
+}
+
+// GetResourceUnableToPerformCount returns the value of ResourceUnableToPerformCount, or the zero value when ResourceUnableToPerformCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetResourceUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ResourceUnableToPerformCount)
+}
+
+// GetResourceNotApplicableCount returns the value of ResourceNotApplicableCount, or the zero value when ResourceNotApplicableCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetResourceNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ResourceNotApplicableCount)
 }
 
 // UnmarshalResourceStatistics unmarshals an instance of ResourceStatistics from the specified map of raw messages.
@@ -2133,6 +3723,10 @@ func UnmarshalResourceStatistics(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "resource_pass_count", "resource_fail_count", "resource_unable_to_perform_count", "resource_not_applicable_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2144,6 +3738,68 @@ type Result struct {
 
 	// A message is returned.
 	Message *string `json:"message,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Result
+func (o *Result) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Result
+func (o *Result) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Result
+func (o *Result) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Result
+func (o *Result) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Result
+func (o *Result) MarshalJSON() (buffer []byte, err error) {
+	type model Result
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetResult returns the value of Result, or the zero value when Result or the Result is nil
+func (o *Result) GetResult() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Result)
+}
+
+// GetMessage returns the value of Message, or the zero value when Message or the Result is nil
+func (o *Result) GetMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Message)
 }
 
 // UnmarshalResult unmarshals an instance of Result from the specified map of raw messages.
@@ -2157,6 +3813,10 @@ func UnmarshalResult(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "result", "message")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2179,6 +3839,92 @@ type Results struct {
 
 	// The total number of controls that were included in the scan.
 	ControlsTotalCount *int64 `json:"controls_total_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Results
+func (o *Results) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Results
+func (o *Results) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Results
+func (o *Results) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Results
+func (o *Results) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Results
+func (o *Results) MarshalJSON() (buffer []byte, err error) {
+	type model Results
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetControlsPassCount returns the value of ControlsPassCount, or the zero value when ControlsPassCount or the Results is nil
+func (o *Results) GetControlsPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsPassCount)
+}
+
+// GetControlsFailCount returns the value of ControlsFailCount, or the zero value when ControlsFailCount or the Results is nil
+func (o *Results) GetControlsFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsFailCount)
+}
+
+// GetControlsNotApplicableCount returns the value of ControlsNotApplicableCount, or the zero value when ControlsNotApplicableCount or the Results is nil
+func (o *Results) GetControlsNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsNotApplicableCount)
+}
+
+// GetControlsUnableToPerformCount returns the value of ControlsUnableToPerformCount, or the zero value when ControlsUnableToPerformCount or the Results is nil
+func (o *Results) GetControlsUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsUnableToPerformCount)
+}
+
+// GetControlsTotalCount returns the value of ControlsTotalCount, or the zero value when ControlsTotalCount or the Results is nil
+func (o *Results) GetControlsTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsTotalCount)
 }
 
 // UnmarshalResults unmarshals an instance of Results from the specified map of raw messages.
@@ -2200,7 +3946,11 @@ func UnmarshalResults(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalPrimitive(m, "controls_total_count", &obj.ControlsTotalCount)
+	err = core.UnmarshalPrimitive(m, "controls_total_count", &obj.ControlsTotalCount)
+	if err != nil {
+		return
+	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "controls_pass_count", "controls_fail_count", "controls_not_applicable_count", "controls_unable_to_perform_count", "controls_total_count")
 	if err != nil {
 		return
 	}
@@ -2221,6 +3971,9 @@ type Scan struct {
 
 	// The current status of the collector.
 	StatusMessage *string `json:"status_message,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Scan.Status property.
@@ -2268,6 +4021,81 @@ const (
 	ScanStatusWaitingForRefineConst                = "waiting_for_refine"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Scan
+func (o *Scan) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Scan
+func (o *Scan) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Scan
+func (o *Scan) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Scan
+func (o *Scan) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Scan
+func (o *Scan) MarshalJSON() (buffer []byte, err error) {
+	type model Scan
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScanID returns the value of ScanID, or the zero value when ScanID or the Scan is nil
+func (o *Scan) GetScanID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanID)
+}
+
+// GetDiscoverID returns the value of DiscoverID, or the zero value when DiscoverID or the Scan is nil
+func (o *Scan) GetDiscoverID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DiscoverID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Scan is nil
+func (o *Scan) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetStatusMessage returns the value of StatusMessage, or the zero value when StatusMessage or the Scan is nil
+func (o *Scan) GetStatusMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StatusMessage)
+}
+
 // UnmarshalScan unmarshals an instance of Scan from the specified map of raw messages.
 func UnmarshalScan(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Scan)
@@ -2287,6 +4115,10 @@ func UnmarshalScan(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scan_id", "discover_id", "status", "status_message")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2333,6 +4165,9 @@ type ScanItem struct {
 
 	// The result of a scan.
 	Result *ScanResult `json:"result,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ScanItem.ProfileType property.
@@ -2347,6 +4182,153 @@ const (
 	ScanItemProfileTypeTemmplategroupConst      = "temmplategroup"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScanItem
+func (o *ScanItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScanItem
+func (o *ScanItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScanItem
+func (o *ScanItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScanItem
+func (o *ScanItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScanItem
+func (o *ScanItem) MarshalJSON() (buffer []byte, err error) {
+	type model ScanItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScanID returns the value of ScanID, or the zero value when ScanID or the ScanItem is nil
+func (o *ScanItem) GetScanID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanID)
+}
+
+// GetScanName returns the value of ScanName, or the zero value when ScanName or the ScanItem is nil
+func (o *ScanItem) GetScanName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanName)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the ScanItem is nil
+func (o *ScanItem) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeName returns the value of ScopeName, or the zero value when ScopeName or the ScanItem is nil
+func (o *ScanItem) GetScopeName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeName)
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the ScanItem is nil
+func (o *ScanItem) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetProfileName returns the value of ProfileName, or the zero value when ProfileName or the ScanItem is nil
+func (o *ScanItem) GetProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileName)
+}
+
+// GetProfileType returns the value of ProfileType, or the zero value when ProfileType or the ScanItem is nil
+func (o *ScanItem) GetProfileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileType)
+}
+
+// GetGroupProfileID returns the value of GroupProfileID, or the zero value when GroupProfileID or the ScanItem is nil
+func (o *ScanItem) GetGroupProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileID)
+}
+
+// GetGroupProfileName returns the value of GroupProfileName, or the zero value when GroupProfileName or the ScanItem is nil
+func (o *ScanItem) GetGroupProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileName)
+}
+
+// GetReportRunBy returns the value of ReportRunBy, or the zero value when ReportRunBy or the ScanItem is nil
+func (o *ScanItem) GetReportRunBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportRunBy)
+}
+
+// GetStartTime returns the value of StartTime, or the zero value when StartTime or the ScanItem is nil
+func (o *ScanItem) GetStartTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.StartTime)
+}
+
+// GetEndTime returns the value of EndTime, or the zero value when EndTime or the ScanItem is nil
+func (o *ScanItem) GetEndTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.EndTime)
+}
+
+// GetResult returns Result, or nil when the ScanItem is nil
+func (o *ScanItem) GetResult() *ScanResult {
+	if o == nil {
+		return nil
+	}
+	return o.Result
+}
+
 // UnmarshalScanItem unmarshals an instance of ScanItem from the specified map of raw messages.
 func UnmarshalScanItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ScanItem)
@@ -2402,6 +4384,10 @@ func UnmarshalScanItem(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scan_id", "scan_name", "scope_id", "scope_name", "profile_id", "profile_name", "profile_type", "group_profile_id", "group_profile_name", "report_run_by", "start_time", "end_time", "result")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2441,6 +4427,132 @@ type ScanResult struct {
 
 	// The total number of controls that were included in the scan.
 	ControlsTotalCount *int64 `json:"controls_total_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScanResult
+func (o *ScanResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScanResult
+func (o *ScanResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScanResult
+func (o *ScanResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScanResult
+func (o *ScanResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScanResult
+func (o *ScanResult) MarshalJSON() (buffer []byte, err error) {
+	type model ScanResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetGoalsPassCount returns the value of GoalsPassCount, or the zero value when GoalsPassCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsPassCount)
+}
+
+// GetGoalsUnableToPerformCount returns the value of GoalsUnableToPerformCount, or the zero value when GoalsUnableToPerformCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsUnableToPerformCount)
+}
+
+// GetGoalsNotApplicableCount returns the value of GoalsNotApplicableCount, or the zero value when GoalsNotApplicableCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsNotApplicableCount)
+}
+
+// GetGoalsFailCount returns the value of GoalsFailCount, or the zero value when GoalsFailCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsFailCount)
+}
+
+// GetGoalsTotalCount returns the value of GoalsTotalCount, or the zero value when GoalsTotalCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsTotalCount)
+}
+
+// GetControlsPassCount returns the value of ControlsPassCount, or the zero value when ControlsPassCount or the ScanResult is nil
+func (o *ScanResult) GetControlsPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsPassCount)
+}
+
+// GetControlsFailCount returns the value of ControlsFailCount, or the zero value when ControlsFailCount or the ScanResult is nil
+func (o *ScanResult) GetControlsFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsFailCount)
+}
+
+// GetControlsNotApplicableCount returns the value of ControlsNotApplicableCount, or the zero value when ControlsNotApplicableCount or the ScanResult is nil
+func (o *ScanResult) GetControlsNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsNotApplicableCount)
+}
+
+// GetControlsUnableToPerformCount returns the value of ControlsUnableToPerformCount, or the zero value when ControlsUnableToPerformCount or the ScanResult is nil
+func (o *ScanResult) GetControlsUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsUnableToPerformCount)
+}
+
+// GetControlsTotalCount returns the value of ControlsTotalCount, or the zero value when ControlsTotalCount or the ScanResult is nil
+func (o *ScanResult) GetControlsTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsTotalCount)
 }
 
 // UnmarshalScanResult unmarshals an instance of ScanResult from the specified map of raw messages.
@@ -2486,6 +4598,10 @@ func UnmarshalScanResult(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "goals_pass_count", "goals_unable_to_perform_count", "goals_not_applicable_count", "goals_fail_count", "goals_total_count", "controls_pass_count", "controls_fail_count", "controls_not_applicable_count", "controls_unable_to_perform_count", "controls_total_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2583,6 +4699,116 @@ type ScansList struct {
 
 	// The details of a scan.
 	LatestScans []ScanItem `json:"latest_scans,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScansList
+func (o *ScansList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScansList
+func (o *ScansList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScansList
+func (o *ScansList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScansList
+func (o *ScansList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScansList
+func (o *ScansList) MarshalJSON() (buffer []byte, err error) {
+	type model ScansList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ScansList is nil
+func (o *ScansList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ScansList is nil
+func (o *ScansList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ScansList is nil
+func (o *ScansList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ScansList is nil
+func (o *ScansList) GetFirst() *ScansListFirst {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ScansList is nil
+func (o *ScansList) GetLast() *ScansListLast {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ScansList is nil
+func (o *ScansList) GetPrevious() *ScansListPrevious {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ScansList is nil
+func (o *ScansList) GetNext() *ScansListNext {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetLatestScans returns LatestScans, or nil when the ScansList is nil
+func (o *ScansList) GetLatestScans() []ScanItem {
+	if o == nil {
+		return nil
+	}
+	return o.LatestScans
 }
 
 // UnmarshalScansList unmarshals an instance of ScansList from the specified map of raw messages.
@@ -2620,6 +4846,10 @@ func UnmarshalScansList(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "latest_scans")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2645,6 +4875,60 @@ func (resp *ScansList) GetNextOffset() (*int64, error) {
 type ScansListFirst struct {
 	// The URL of the first page of scans.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScansListFirst
+func (o *ScansListFirst) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScansListFirst
+func (o *ScansListFirst) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScansListFirst
+func (o *ScansListFirst) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScansListFirst
+func (o *ScansListFirst) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScansListFirst
+func (o *ScansListFirst) MarshalJSON() (buffer []byte, err error) {
+	type model ScansListFirst
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ScansListFirst is nil
+func (o *ScansListFirst) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalScansListFirst unmarshals an instance of ScansListFirst from the specified map of raw messages.
@@ -2654,6 +4938,10 @@ func UnmarshalScansListFirst(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2662,6 +4950,60 @@ func UnmarshalScansListFirst(m map[string]json.RawMessage, result interface{}) (
 type ScansListLast struct {
 	// The URL of the last page of scans.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScansListLast
+func (o *ScansListLast) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScansListLast
+func (o *ScansListLast) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScansListLast
+func (o *ScansListLast) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScansListLast
+func (o *ScansListLast) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScansListLast
+func (o *ScansListLast) MarshalJSON() (buffer []byte, err error) {
+	type model ScansListLast
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ScansListLast is nil
+func (o *ScansListLast) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalScansListLast unmarshals an instance of ScansListLast from the specified map of raw messages.
@@ -2671,6 +5013,10 @@ func UnmarshalScansListLast(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2679,6 +5025,60 @@ func UnmarshalScansListLast(m map[string]json.RawMessage, result interface{}) (e
 type ScansListNext struct {
 	// The URL of the next page of scans.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScansListNext
+func (o *ScansListNext) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScansListNext
+func (o *ScansListNext) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScansListNext
+func (o *ScansListNext) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScansListNext
+func (o *ScansListNext) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScansListNext
+func (o *ScansListNext) MarshalJSON() (buffer []byte, err error) {
+	type model ScansListNext
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ScansListNext is nil
+func (o *ScansListNext) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalScansListNext unmarshals an instance of ScansListNext from the specified map of raw messages.
@@ -2688,6 +5088,10 @@ func UnmarshalScansListNext(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2696,6 +5100,60 @@ func UnmarshalScansListNext(m map[string]json.RawMessage, result interface{}) (e
 type ScansListPrevious struct {
 	// The URL of the previous page of scans.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScansListPrevious
+func (o *ScansListPrevious) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScansListPrevious
+func (o *ScansListPrevious) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScansListPrevious
+func (o *ScansListPrevious) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScansListPrevious
+func (o *ScansListPrevious) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScansListPrevious
+func (o *ScansListPrevious) MarshalJSON() (buffer []byte, err error) {
+	type model ScansListPrevious
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the ScansListPrevious is nil
+func (o *ScansListPrevious) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalScansListPrevious unmarshals an instance of ScansListPrevious from the specified map of raw messages.
@@ -2705,6 +5163,10 @@ func UnmarshalScansListPrevious(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2783,6 +5245,9 @@ type Scope struct {
 
 	// The time that the scope was last modified in UTC.
 	ModifiedTime *strfmt.DateTime `json:"modified_time,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Scope.EnvironmentType property.
@@ -2798,6 +5263,113 @@ const (
 	ScopeEnvironmentTypeServicesConst  = "services"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Scope
+func (o *Scope) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Scope
+func (o *Scope) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Scope
+func (o *Scope) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Scope
+func (o *Scope) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Scope
+func (o *Scope) MarshalJSON() (buffer []byte, err error) {
+	type model Scope
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the Scope is nil
+func (o *Scope) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeName returns the value of ScopeName, or the zero value when ScopeName or the Scope is nil
+func (o *Scope) GetScopeName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeName)
+}
+
+// GetScopeDescription returns the value of ScopeDescription, or the zero value when ScopeDescription or the Scope is nil
+func (o *Scope) GetScopeDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeDescription)
+}
+
+// GetCollectorIds returns CollectorIds, or nil when the Scope is nil
+func (o *Scope) GetCollectorIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.CollectorIds
+}
+
+// GetCredentialID returns the value of CredentialID, or the zero value when CredentialID or the Scope is nil
+func (o *Scope) GetCredentialID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialID)
+}
+
+// GetEnvironmentType returns the value of EnvironmentType, or the zero value when EnvironmentType or the Scope is nil
+func (o *Scope) GetEnvironmentType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.EnvironmentType)
+}
+
+// GetCreatedTime returns the value of CreatedTime, or the zero value when CreatedTime or the Scope is nil
+func (o *Scope) GetCreatedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedTime)
+}
+
+// GetModifiedTime returns the value of ModifiedTime, or the zero value when ModifiedTime or the Scope is nil
+func (o *Scope) GetModifiedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ModifiedTime)
+}
+
 // UnmarshalScope unmarshals an instance of Scope from the specified map of raw messages.
 func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Scope)
@@ -2833,6 +5405,10 @@ func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scope_id", "scope_name", "scope_description", "collector_ids", "credential_id", "environment_type", "created_time", "modified_time")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2880,6 +5456,9 @@ type ScopeItem struct {
 
 	// A list of the scans that have been run on the scope.
 	Scans []Scan `json:"scans,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ScopeItem.EnvironmentType property.
@@ -2909,6 +5488,161 @@ const (
 	ScopeItemLastScanTypeValidationConst     = "validation"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeItem
+func (o *ScopeItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeItem
+func (o *ScopeItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeItem
+func (o *ScopeItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeItem
+func (o *ScopeItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeItem
+func (o *ScopeItem) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ScopeItem is nil
+func (o *ScopeItem) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the ScopeItem is nil
+func (o *ScopeItem) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the ScopeItem is nil
+func (o *ScopeItem) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the ScopeItem is nil
+func (o *ScopeItem) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ScopeItem is nil
+func (o *ScopeItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the ScopeItem is nil
+func (o *ScopeItem) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
+// GetEnvironmentType returns the value of EnvironmentType, or the zero value when EnvironmentType or the ScopeItem is nil
+func (o *ScopeItem) GetEnvironmentType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.EnvironmentType)
+}
+
+// GetCreatedTime returns the value of CreatedTime, or the zero value when CreatedTime or the ScopeItem is nil
+func (o *ScopeItem) GetCreatedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedTime)
+}
+
+// GetModifiedTime returns the value of ModifiedTime, or the zero value when ModifiedTime or the ScopeItem is nil
+func (o *ScopeItem) GetModifiedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ModifiedTime)
+}
+
+// GetLastScanType returns the value of LastScanType, or the zero value when LastScanType or the ScopeItem is nil
+func (o *ScopeItem) GetLastScanType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastScanType)
+}
+
+// GetLastScanTypeDescription returns the value of LastScanTypeDescription, or the zero value when LastScanTypeDescription or the ScopeItem is nil
+func (o *ScopeItem) GetLastScanTypeDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastScanTypeDescription)
+}
+
+// GetLastScanStatusUpdatedTime returns the value of LastScanStatusUpdatedTime, or the zero value when LastScanStatusUpdatedTime or the ScopeItem is nil
+func (o *ScopeItem) GetLastScanStatusUpdatedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.LastScanStatusUpdatedTime)
+}
+
+// GetCollectorsID returns CollectorsID, or nil when the ScopeItem is nil
+func (o *ScopeItem) GetCollectorsID() []string {
+	if o == nil {
+		return nil
+	}
+	return o.CollectorsID
+}
+
+// GetScans returns Scans, or nil when the ScopeItem is nil
+func (o *ScopeItem) GetScans() []Scan {
+	if o == nil {
+		return nil
+	}
+	return o.Scans
+}
+
 // UnmarshalScopeItem unmarshals an instance of ScopeItem from the specified map of raw messages.
 func UnmarshalScopeItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ScopeItem)
@@ -2968,6 +5702,10 @@ func UnmarshalScopeItem(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "created_by", "modified_by", "scope_id", "name", "enabled", "environment_type", "created_time", "modified_time", "last_scan_type", "last_scan_type_description", "last_scan_status_updated_time", "collectors_id", "scans")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2976,44 +5714,212 @@ func UnmarshalScopeItem(m map[string]json.RawMessage, result interface{}) (err e
 type ScopesList struct {
 	// Scopes.
 	Scopes []ScopeItem `json:"scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopesList
+func (o *ScopesList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopesList
+func (o *ScopesList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopesList
+func (o *ScopesList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopesList
+func (o *ScopesList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopesList
+func (o *ScopesList) MarshalJSON() (buffer []byte, err error) {
+	type model ScopesList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScopes returns Scopes, or nil when the ScopesList is nil
+func (o *ScopesList) GetScopes() []ScopeItem {
+	if o == nil {
+		return nil
+	}
+	return o.Scopes
+}
+
+// UnmarshalScopesList unmarshals an instance of ScopesList from the specified map of raw messages.
+func UnmarshalScopesList(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ScopesList)
+	err = core.UnmarshalModel(m, "scopes", &obj.Scopes, UnmarshalScopeItem)
+	if err != nil {
+		return
+	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scopes")
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SummariesList : A list of scan summaries.
+type SummariesList struct {
+	// The offset of the page.
+	Offset *int64 `json:"offset" validate:"required"`
+
+	// The number of scans displayed per page.
+	Limit *int64 `json:"limit" validate:"required"`
+
+	// The total number of scans available in the list of summaries.
+	TotalCount *int64 `json:"total_count,omitempty"`
+
+	// he URL of the first scan summary.
+	First *SummariesListFirst `json:"first,omitempty"`
+
+	// The URL of the last scan summary.
+	Last *SummariesListLast `json:"last,omitempty"`
+
+	// The URL of the previous scan summary.
+	Previous *SummariesListPrevious `json:"previous,omitempty"`
+
+	// The URL of the previous scan summary.
+	Next *SummariesListNext `json:"next,omitempty"`
+
+	// Summaries.
+	Summaries []SummaryItem `json:"summaries,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummariesList
+func (o *SummariesList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummariesList
+func (o *SummariesList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummariesList
+func (o *SummariesList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummariesList
+func (o *SummariesList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
 }
 
-// UnmarshalScopesList unmarshals an instance of ScopesList from the specified map of raw messages.
-func UnmarshalScopesList(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(ScopesList)
-	err = core.UnmarshalModel(m, "scopes", &obj.Scopes, UnmarshalScopeItem)
+// MarshalJSON performs custom serialization for instances of SummariesList
+func (o *SummariesList) MarshalJSON() (buffer []byte, err error) {
+	type model SummariesList
+	buffer, err = json.Marshal((*model)(o))
 	if err != nil {
 		return
 	}
-	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
 	return
 }
 
-// SummariesList : A list of scan summaries.
-type SummariesList struct {
-	// The offset of the page.
-	Offset *int64 `json:"offset" validate:"required"`
+// GetOffset returns the value of Offset, or the zero value when Offset or the SummariesList is nil
+func (o *SummariesList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
 
-	// The number of scans displayed per page.
-	Limit *int64 `json:"limit" validate:"required"`
+// GetLimit returns the value of Limit, or the zero value when Limit or the SummariesList is nil
+func (o *SummariesList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
 
-	// The total number of scans available in the list of summaries.
-	TotalCount *int64 `json:"total_count,omitempty"`
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the SummariesList is nil
+func (o *SummariesList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
 
-	// he URL of the first scan summary.
-	First *SummariesListFirst `json:"first,omitempty"`
+// GetFirst returns First, or nil when the SummariesList is nil
+func (o *SummariesList) GetFirst() *SummariesListFirst {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
 
-	// The URL of the last scan summary.
-	Last *SummariesListLast `json:"last,omitempty"`
+// GetLast returns Last, or nil when the SummariesList is nil
+func (o *SummariesList) GetLast() *SummariesListLast {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
 
-	// The URL of the previous scan summary.
-	Previous *SummariesListPrevious `json:"previous,omitempty"`
+// GetPrevious returns Previous, or nil when the SummariesList is nil
+func (o *SummariesList) GetPrevious() *SummariesListPrevious {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
 
-	// The URL of the previous scan summary.
-	Next *SummariesListNext `json:"next,omitempty"`
+// GetNext returns Next, or nil when the SummariesList is nil
+func (o *SummariesList) GetNext() *SummariesListNext {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
 
-	// Summaries.
-	Summaries []SummaryItem `json:"summaries,omitempty"`
+// GetSummaries returns Summaries, or nil when the SummariesList is nil
+func (o *SummariesList) GetSummaries() []SummaryItem {
+	if o == nil {
+		return nil
+	}
+	return o.Summaries
 }
 
 // UnmarshalSummariesList unmarshals an instance of SummariesList from the specified map of raw messages.
@@ -3051,6 +5957,10 @@ func UnmarshalSummariesList(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "summaries")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3076,6 +5986,60 @@ func (resp *SummariesList) GetNextOffset() (*int64, error) {
 type SummariesListFirst struct {
 	// The URL of the first scan summary.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummariesListFirst
+func (o *SummariesListFirst) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummariesListFirst
+func (o *SummariesListFirst) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummariesListFirst
+func (o *SummariesListFirst) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummariesListFirst
+func (o *SummariesListFirst) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummariesListFirst
+func (o *SummariesListFirst) MarshalJSON() (buffer []byte, err error) {
+	type model SummariesListFirst
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the SummariesListFirst is nil
+func (o *SummariesListFirst) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalSummariesListFirst unmarshals an instance of SummariesListFirst from the specified map of raw messages.
@@ -3085,6 +6049,10 @@ func UnmarshalSummariesListFirst(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3093,6 +6061,60 @@ func UnmarshalSummariesListFirst(m map[string]json.RawMessage, result interface{
 type SummariesListLast struct {
 	// The URL of the last scan summary.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummariesListLast
+func (o *SummariesListLast) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummariesListLast
+func (o *SummariesListLast) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummariesListLast
+func (o *SummariesListLast) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummariesListLast
+func (o *SummariesListLast) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummariesListLast
+func (o *SummariesListLast) MarshalJSON() (buffer []byte, err error) {
+	type model SummariesListLast
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the SummariesListLast is nil
+func (o *SummariesListLast) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalSummariesListLast unmarshals an instance of SummariesListLast from the specified map of raw messages.
@@ -3102,6 +6124,10 @@ func UnmarshalSummariesListLast(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3110,6 +6136,60 @@ func UnmarshalSummariesListLast(m map[string]json.RawMessage, result interface{}
 type SummariesListNext struct {
 	// The URL of the next scan summary.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummariesListNext
+func (o *SummariesListNext) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummariesListNext
+func (o *SummariesListNext) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummariesListNext
+func (o *SummariesListNext) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummariesListNext
+func (o *SummariesListNext) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummariesListNext
+func (o *SummariesListNext) MarshalJSON() (buffer []byte, err error) {
+	type model SummariesListNext
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the SummariesListNext is nil
+func (o *SummariesListNext) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalSummariesListNext unmarshals an instance of SummariesListNext from the specified map of raw messages.
@@ -3119,6 +6199,10 @@ func UnmarshalSummariesListNext(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3127,6 +6211,60 @@ func UnmarshalSummariesListNext(m map[string]json.RawMessage, result interface{}
 type SummariesListPrevious struct {
 	// The URL of the previous scan summary.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummariesListPrevious
+func (o *SummariesListPrevious) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummariesListPrevious
+func (o *SummariesListPrevious) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummariesListPrevious
+func (o *SummariesListPrevious) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummariesListPrevious
+func (o *SummariesListPrevious) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummariesListPrevious
+func (o *SummariesListPrevious) MarshalJSON() (buffer []byte, err error) {
+	type model SummariesListPrevious
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the SummariesListPrevious is nil
+func (o *SummariesListPrevious) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalSummariesListPrevious unmarshals an instance of SummariesListPrevious from the specified map of raw messages.
@@ -3136,6 +6274,10 @@ func UnmarshalSummariesListPrevious(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3159,6 +6301,100 @@ type Summary struct {
 
 	// The list of controls on the scan summary.
 	Controls []Control `json:"controls,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Summary
+func (o *Summary) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Summary
+func (o *Summary) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Summary
+func (o *Summary) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Summary
+func (o *Summary) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Summary
+func (o *Summary) MarshalJSON() (buffer []byte, err error) {
+	type model Summary
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScanID returns the value of ScanID, or the zero value when ScanID or the Summary is nil
+func (o *Summary) GetScanID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanID)
+}
+
+// GetDiscoverID returns the value of DiscoverID, or the zero value when DiscoverID or the Summary is nil
+func (o *Summary) GetDiscoverID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DiscoverID)
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the Summary is nil
+func (o *Summary) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetProfileName returns the value of ProfileName, or the zero value when ProfileName or the Summary is nil
+func (o *Summary) GetProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileName)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the Summary is nil
+func (o *Summary) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetControls returns Controls, or nil when the Summary is nil
+func (o *Summary) GetControls() []Control {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
 }
 
 // UnmarshalSummary unmarshals an instance of Summary from the specified map of raw messages.
@@ -3188,6 +6424,10 @@ func UnmarshalSummary(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scan_id", "discover_id", "profile_id", "profile_name", "scope_id", "controls")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3224,6 +6464,9 @@ type SummaryItem struct {
 
 	// The result of a group profile.
 	GroupProfiles *GroupProfileResult `json:"group_profiles,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the SummaryItem.Status property.
@@ -3271,6 +6514,129 @@ const (
 	SummaryItemStatusWaitingForRefineConst                = "waiting_for_refine"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummaryItem
+func (o *SummaryItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummaryItem
+func (o *SummaryItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummaryItem
+func (o *SummaryItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummaryItem
+func (o *SummaryItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummaryItem
+func (o *SummaryItem) MarshalJSON() (buffer []byte, err error) {
+	type model SummaryItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScanID returns the value of ScanID, or the zero value when ScanID or the SummaryItem is nil
+func (o *SummaryItem) GetScanID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanID)
+}
+
+// GetScanName returns the value of ScanName, or the zero value when ScanName or the SummaryItem is nil
+func (o *SummaryItem) GetScanName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanName)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the SummaryItem is nil
+func (o *SummaryItem) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeName returns the value of ScopeName, or the zero value when ScopeName or the SummaryItem is nil
+func (o *SummaryItem) GetScopeName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeName)
+}
+
+// GetReportRunBy returns the value of ReportRunBy, or the zero value when ReportRunBy or the SummaryItem is nil
+func (o *SummaryItem) GetReportRunBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportRunBy)
+}
+
+// GetStartTime returns the value of StartTime, or the zero value when StartTime or the SummaryItem is nil
+func (o *SummaryItem) GetStartTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.StartTime)
+}
+
+// GetEndTime returns the value of EndTime, or the zero value when EndTime or the SummaryItem is nil
+func (o *SummaryItem) GetEndTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.EndTime)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the SummaryItem is nil
+func (o *SummaryItem) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetProfile returns Profile, or nil when the SummaryItem is nil
+func (o *SummaryItem) GetProfile() *ProfileResult {
+	if o == nil {
+		return nil
+	}
+	return o.Profile
+}
+
+// GetGroupProfiles returns GroupProfiles, or nil when the SummaryItem is nil
+func (o *SummaryItem) GetGroupProfiles() *GroupProfileResult {
+	if o == nil {
+		return nil
+	}
+	return o.GroupProfiles
+}
+
 // UnmarshalSummaryItem unmarshals an instance of SummaryItem from the specified map of raw messages.
 func UnmarshalSummaryItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SummaryItem)
@@ -3314,6 +6680,10 @@ func UnmarshalSummaryItem(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scan_id", "scan_name", "scope_id", "scope_name", "report_run_by", "start_time", "end_time", "status", "profile", "group_profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -0,0 +1,110 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 additional properties`, func() {
+	rawModel := func(body string) (m map[string]json.RawMessage) {
+		Expect(json.Unmarshal([]byte(body), &m)).To(Succeed())
+		return
+	}
+
+	It(`Keeps the properties of TaskLogs`, func() {
+		body := `{"logs": [{"level": "info", "message": "started"}], "count": 1}`
+		var taskLogs *posturemanagementv2.TaskLogs
+		Expect(core.UnmarshalModel(rawModel(body), "", &taskLogs, posturemanagementv2.UnmarshalTaskLogs)).To(Succeed())
+		Expect(taskLogs.GetAdditionalProperty("count")).To(Equal(json.RawMessage(`1`)))
+		Expect(taskLogs.GetAdditionalProperties()).To(HaveLen(2))
+
+		data, err := json.Marshal(taskLogs)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(body))
+	})
+	It(`Round-trips unknown properties next to the declared ones`, func() {
+		body := `{"id": "p1", "name": "CIS", "type": "predefined", "new_field": {"nested": true}}`
+		var profile *posturemanagementv2.ProfileItem
+		Expect(core.UnmarshalModel(rawModel(body), "", &profile, posturemanagementv2.UnmarshalProfileItem)).To(Succeed())
+		Expect(*profile.Name).To(Equal("CIS"))
+		Expect(profile.GetAdditionalProperties()).To(Equal(map[string]interface{}{"new_field": json.RawMessage(`{"nested": true}`)}))
+
+		profile.Name = core.StringPtr("CIS v2")
+		profile.SetAdditionalProperty("name", "ignored")
+		data, err := json.Marshal(profile)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{"id": "p1", "name": "CIS v2", "type": "predefined", "new_field": {"nested": true}}`))
+	})
+	It(`Keeps large numbers exactly`, func() {
+		body := `{"id": "p1", "name": "CIS", "type": "predefined", "count": 9007199254740993}`
+		var profile *posturemanagementv2.ProfileItem
+		Expect(core.UnmarshalModel(rawModel(body), "", &profile, posturemanagementv2.UnmarshalProfileItem)).To(Succeed())
+
+		data, err := json.Marshal(profile)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"count":9007199254740993`))
+	})
+	It(`Only writes the additional properties when a pointer is marshalled`, func() {
+		profile := &posturemanagementv2.ProfileItem{ID: core.StringPtr("p1"), Name: core.StringPtr("CIS"), Type: core.StringPtr("predefined")}
+		profile.SetAdditionalProperty("new_field", true)
+
+		data, err := json.Marshal(profile)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{"id": "p1", "name": "CIS", "type": "predefined", "new_field": true}`))
+
+		data, err = json.Marshal(*profile)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{"id": "p1", "name": "CIS", "type": "predefined"}`))
+	})
+	It(`Returns no additional properties from a nil model`, func() {
+		var profile *posturemanagementv2.ProfileItem
+		Expect(profile.GetAdditionalProperty("new_field")).To(BeNil())
+		Expect(profile.GetAdditionalProperties()).To(BeNil())
+	})
+	It(`Sends the additional properties of a fetched profile back with an update`, func() {
+		testServer := fixtureserver.New(map[string]string{
+			"GET /posture/v2/profiles/p1":   `{"id": "p1", "name": "CIS", "type": "custom", "new_field": {"nested": true}}`,
+			"PATCH /posture/v2/profiles/p1": `{"id": "p1", "name": "CIS v2", "type": "custom", "new_field": {"nested": true}}`,
+		})
+		defer testServer.Close()
+		postureManagementService, err := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		profile, _, err := postureManagementService.GetProfile(postureManagementService.NewGetProfileOptions("p1", "custom"))
+		Expect(err).To(BeNil())
+		updateProfilesOptions := postureManagementService.NewUpdateProfilesOptions("p1").
+			SetName("CIS v2").
+			SetAdditionalProperties(profile.GetAdditionalProperties()).
+			SetAdditionalProperty("name", "ignored")
+		_, _, err = postureManagementService.UpdateProfiles(updateProfilesOptions)
+		Expect(err).To(BeNil())
+
+		requests := testServer.RequestsTo("PATCH /posture/v2/profiles/p1")
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Body).To(MatchJSON(`{"name": "CIS v2", "new_field": {"nested": true}}`))
+	})
+})
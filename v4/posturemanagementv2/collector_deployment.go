@@ -0,0 +1,346 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CollectorDeploymentFormat : The format of a collector deployment artifact.
+type CollectorDeploymentFormat string
+
+// Constants associated with CollectorDeploymentFormat.
+const (
+	CollectorDeploymentFormatShellConst         CollectorDeploymentFormat = "shell"
+	CollectorDeploymentFormatEnvFileConst       CollectorDeploymentFormat = "env_file"
+	CollectorDeploymentFormatDockerComposeConst CollectorDeploymentFormat = "docker_compose"
+	CollectorDeploymentFormatKubernetesConst    CollectorDeploymentFormat = "kubernetes"
+)
+
+// DefaultCollectorNamespace is the Kubernetes namespace that the collector is deployed to when none is given.
+const DefaultCollectorNamespace = "scc-collector"
+
+// CollectorEnvironmentNames : The names of the environment variables that the collector image reads its settings
+// from. The service does not publish them, so they must be taken from the documentation of the image. A setting whose
+// name is empty is not passed.
+type CollectorEnvironmentNames struct {
+	CollectorID      string
+	RegistrationCode string
+	Passphrase       string
+	Endpoint         string
+}
+
+// CollectorDeploymentOptions : The settings of the collector deployment artifacts that the service does not return.
+type CollectorDeploymentOptions struct {
+	// The passphrase that was given when the collector was created.
+	Passphrase string
+
+	// The collector image. Required unless the collector uses a UBI image and UbiImage is set.
+	Image string
+
+	// The collector image to use when the collector uses a UBI image. When empty Image is used.
+	UbiImage string
+
+	// The endpoint that the collector registers with. Used when the collector does not use a private endpoint.
+	PublicEndpoint string
+
+	// The endpoint that the collector registers with when it uses a private endpoint.
+	PrivateEndpoint string
+
+	// The Kubernetes namespace. When empty DefaultCollectorNamespace is used.
+	Namespace string
+
+	// The environment variables that the settings are passed in. Required by the built-in templates.
+	EnvironmentNames *CollectorEnvironmentNames
+
+	// Templates that replace the built-in template of a format. They are executed with a *CollectorDeploymentData.
+	Templates map[CollectorDeploymentFormat]*template.Template
+}
+
+// CollectorEnvironmentVariable : A setting of the collector as an environment variable.
+type CollectorEnvironmentVariable struct {
+	Name  string
+	Value string
+
+	// Secret settings are only written to env files and Kubernetes Secrets, never to command lines.
+	Secret bool
+}
+
+// CollectorDeploymentData : The values that the deployment templates are executed with.
+type CollectorDeploymentData struct {
+	CollectorID        string
+	Name               string
+	DisplayName        string
+	RegistrationCode   string
+	Passphrase         string
+	Image              string
+	Endpoint           string
+	UsePrivateEndpoint bool
+	IsUbiImage         bool
+	Namespace          string
+
+	// A name derived from the collector name that is valid for containers and Kubernetes resources.
+	ResourceName string
+
+	// The settings as environment variables, as named by CollectorDeploymentOptions.EnvironmentNames. Empty when no
+	// names are given.
+	Environment []CollectorEnvironmentVariable
+}
+
+// EnvFileName is the name of the env file of the collector that the built-in shell and docker-compose artifacts use.
+func (data *CollectorDeploymentData) EnvFileName() string {
+	return data.ResourceName + ".env"
+}
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// collectorResourceName turns a collector name into a DNS-1123 label such as "scc-collector-my-collector".
+func collectorResourceName(name string) string {
+	name = strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	name = "scc-collector-" + name
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// NewCollectorDeploymentData : Instantiate CollectorDeploymentData from a collector as returned by CreateCollector
+// or GetCollector. Collectors that are managed by IBM are not deployed by the customer and are rejected.
+func NewCollectorDeploymentData(collector *Collector, collectorDeploymentOptions *CollectorDeploymentOptions) (*CollectorDeploymentData, error) {
+	if collector == nil {
+		return nil, fmt.Errorf("collector cannot be nil")
+	}
+	if collectorDeploymentOptions == nil {
+		collectorDeploymentOptions = new(CollectorDeploymentOptions)
+	}
+	if core.StringNilMapper(collector.ManagedBy) == CollectorManagedByIBMConst {
+		return nil, fmt.Errorf("collector %s is managed by IBM and is not deployed by the customer", core.StringNilMapper(collector.Name))
+	}
+	if core.StringNilMapper(collector.RegistrationCode) == "" {
+		return nil, fmt.Errorf("collector %s has no registration code", core.StringNilMapper(collector.Name))
+	}
+
+	data := &CollectorDeploymentData{
+		CollectorID:        core.StringNilMapper(collector.ID),
+		Name:               core.StringNilMapper(collector.Name),
+		DisplayName:        core.StringNilMapper(collector.DisplayName),
+		RegistrationCode:   *collector.RegistrationCode,
+		Passphrase:         collectorDeploymentOptions.Passphrase,
+		Image:              collectorDeploymentOptions.Image,
+		Endpoint:           collectorDeploymentOptions.PublicEndpoint,
+		UsePrivateEndpoint: collector.UsePrivateEndpoint != nil && *collector.UsePrivateEndpoint,
+		IsUbiImage:         collector.IsUbiImage != nil && *collector.IsUbiImage,
+		Namespace:          collectorDeploymentOptions.Namespace,
+		ResourceName:       collectorResourceName(core.StringNilMapper(collector.Name)),
+	}
+	if data.IsUbiImage && collectorDeploymentOptions.UbiImage != "" {
+		data.Image = collectorDeploymentOptions.UbiImage
+	}
+	if data.UsePrivateEndpoint {
+		data.Endpoint = collectorDeploymentOptions.PrivateEndpoint
+	}
+	if data.Namespace == "" {
+		data.Namespace = DefaultCollectorNamespace
+	}
+	if names := collectorDeploymentOptions.EnvironmentNames; names != nil {
+		for _, variable := range []CollectorEnvironmentVariable{
+			{Name: names.CollectorID, Value: data.CollectorID},
+			{Name: names.RegistrationCode, Value: data.RegistrationCode, Secret: true},
+			{Name: names.Passphrase, Value: data.Passphrase, Secret: true},
+			{Name: names.Endpoint, Value: data.Endpoint},
+		} {
+			if variable.Name != "" {
+				data.Environment = append(data.Environment, variable)
+			}
+		}
+	}
+
+	var missing []string
+	if data.Passphrase == "" {
+		missing = append(missing, "Passphrase")
+	}
+	if data.Image == "" {
+		missing = append(missing, "Image")
+	}
+	if data.Endpoint == "" && data.UsePrivateEndpoint {
+		missing = append(missing, "PrivateEndpoint")
+	} else if data.Endpoint == "" {
+		missing = append(missing, "PublicEndpoint")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("collectorDeploymentOptions is missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return data, nil
+}
+
+// collectorDeploymentFuncs quote values for the shell, for YAML and for env files and keep values in comments on one
+// line.
+var collectorDeploymentFuncs = template.FuncMap{
+	"comment": func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	},
+	"shell": func(s string) string {
+		return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+	},
+	"yaml": strconv.Quote,
+	// Docker reads env file values literally up to the end of the line, so they cannot be quoted.
+	"env": func(s string) (string, error) {
+		if strings.ContainsAny(s, "\r\n") {
+			return "", fmt.Errorf("an env file value cannot contain a line break")
+		}
+		return s, nil
+	},
+}
+
+var collectorDeploymentTemplates = map[CollectorDeploymentFormat]*template.Template{
+	CollectorDeploymentFormatEnvFileConst: template.Must(template.New("env_file").Funcs(collectorDeploymentFuncs).Parse(`# Security and Compliance Center collector {{comment .DisplayName}} ({{comment .CollectorID}}).
+# Contains secrets; keep the file readable by its owner only.
+{{range .Environment}}{{.Name}}={{env .Value}}
+{{end}}`)),
+	CollectorDeploymentFormatShellConst: template.Must(template.New("shell").Funcs(collectorDeploymentFuncs).Parse(`#!/bin/sh
+# Installs the Security and Compliance Center collector {{comment .DisplayName}} ({{comment .CollectorID}}).
+# The settings are passed in an env file so that the secrets do not appear on command lines.
+set -eu
+
+umask 077
+env_file=$(mktemp)
+trap 'rm -f "$env_file"' EXIT
+cat > "$env_file" <<'SCC_COLLECTOR_ENV'
+{{range .Environment}}{{.Name}}={{env .Value}}
+{{end}}SCC_COLLECTOR_ENV
+
+docker pull {{shell .Image}}
+docker rm -f {{shell .ResourceName}} >/dev/null 2>&1 || true
+docker run -d --restart unless-stopped --name {{shell .ResourceName}} --env-file "$env_file" {{shell .Image}}
+`)),
+	CollectorDeploymentFormatDockerComposeConst: template.Must(template.New("docker_compose").Funcs(collectorDeploymentFuncs).Parse(`# Security and Compliance Center collector {{comment .DisplayName}} ({{comment .CollectorID}}).
+# The settings are read from {{.EnvFileName}}, which is the env_file artifact.
+version: "3.8"
+services:
+  {{.ResourceName}}:
+    image: {{yaml .Image}}
+    container_name: {{yaml .ResourceName}}
+    restart: unless-stopped
+    env_file:
+      - {{yaml .EnvFileName}}
+`)),
+	CollectorDeploymentFormatKubernetesConst: template.Must(template.New("kubernetes").Funcs(collectorDeploymentFuncs).Parse(`# Security and Compliance Center collector {{comment .DisplayName}} ({{comment .CollectorID}}).
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{.ResourceName}}
+  namespace: {{yaml .Namespace}}
+type: Opaque
+stringData:
+{{- range .Environment}}{{if .Secret}}
+  {{.Name}}: {{yaml .Value}}
+{{- end}}{{end}}
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.ResourceName}}
+  namespace: {{yaml .Namespace}}
+  labels:
+    app: {{.ResourceName}}
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: {{.ResourceName}}
+  template:
+    metadata:
+      labels:
+        app: {{.ResourceName}}
+    spec:
+      containers:
+        - name: collector
+          image: {{yaml .Image}}
+          env:
+{{- range .Environment}}{{if not .Secret}}
+            - name: {{.Name}}
+              value: {{yaml .Value}}
+{{- end}}{{end}}
+          envFrom:
+            - secretRef:
+                name: {{.ResourceName}}
+`)),
+}
+
+// WriteCollectorDeployment : Write a deployment artifact of a collector in the given format
+// The built-in templates pass the settings in the environment variables named by
+// CollectorDeploymentOptions.EnvironmentNames and keep the registration code and passphrase out of command lines.
+func WriteCollectorDeployment(w io.Writer, format CollectorDeploymentFormat, collector *Collector, collectorDeploymentOptions *CollectorDeploymentOptions) error {
+	data, err := NewCollectorDeploymentData(collector, collectorDeploymentOptions)
+	if err != nil {
+		return err
+	}
+	if collectorDeploymentOptions.Templates[format] != nil {
+		return collectorDeploymentOptions.Templates[format].Execute(w, data)
+	}
+	tmpl := collectorDeploymentTemplates[format]
+	if tmpl == nil {
+		return fmt.Errorf("unknown collector deployment format %q", format)
+	}
+	if len(data.Environment) == 0 {
+		return fmt.Errorf("collectorDeploymentOptions.EnvironmentNames is required by the built-in %s template", format)
+	}
+	return tmpl.Execute(w, data)
+}
+
+// CollectorDeploymentArtifacts : The deployment artifacts of a collector in every format.
+type CollectorDeploymentArtifacts struct {
+	// A shell script that runs the collector with Docker.
+	Shell string
+
+	// An env file with the settings, to be saved as CollectorDeploymentData.EnvFileName next to the docker-compose
+	// file. It contains the secrets.
+	EnvFile string
+
+	// A docker-compose file.
+	DockerCompose string
+
+	// A Kubernetes Secret and Deployment.
+	Kubernetes string
+}
+
+// GenerateCollectorDeployment : Generate the deployment artifacts of a collector in every format
+func GenerateCollectorDeployment(collector *Collector, collectorDeploymentOptions *CollectorDeploymentOptions) (*CollectorDeploymentArtifacts, error) {
+	artifacts := new(CollectorDeploymentArtifacts)
+	targets := map[CollectorDeploymentFormat]*string{
+		CollectorDeploymentFormatShellConst:         &artifacts.Shell,
+		CollectorDeploymentFormatEnvFileConst:       &artifacts.EnvFile,
+		CollectorDeploymentFormatDockerComposeConst: &artifacts.DockerCompose,
+		CollectorDeploymentFormatKubernetesConst:    &artifacts.Kubernetes,
+	}
+	for format, target := range targets {
+		var buf bytes.Buffer
+		if err := WriteCollectorDeployment(&buf, format, collector, collectorDeploymentOptions); err != nil {
+			return nil, err
+		}
+		*target = buf.String()
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,117 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 collector deployment`, func() {
+	newCollector := func() *posturemanagementv2.Collector {
+		return &posturemanagementv2.Collector{
+			ID:                 core.StringPtr("c1"),
+			Name:               core.StringPtr("My Collector_01"),
+			DisplayName:        core.StringPtr("My collector"),
+			RegistrationCode:   core.StringPtr("reg-code"),
+			ManagedBy:          core.StringPtr(posturemanagementv2.CollectorManagedByCustomerConst),
+			UsePrivateEndpoint: core.BoolPtr(false),
+			IsUbiImage:         core.BoolPtr(true),
+		}
+	}
+	newOptions := func() *posturemanagementv2.CollectorDeploymentOptions {
+		return &posturemanagementv2.CollectorDeploymentOptions{
+			Passphrase:      "it's secret",
+			Image:           "registry.example.com/collector:1.0",
+			UbiImage:        "registry.example.com/collector:1.0-ubi",
+			PublicEndpoint:  "https://public.example.com",
+			PrivateEndpoint: "https://private.example.com",
+			EnvironmentNames: &posturemanagementv2.CollectorEnvironmentNames{
+				RegistrationCode: "REGISTRATION_CODE",
+				Passphrase:       "PASSPHRASE",
+				Endpoint:         "ENDPOINT",
+			},
+		}
+	}
+
+	It(`Generates every artifact`, func() {
+		artifacts, err := posturemanagementv2.GenerateCollectorDeployment(newCollector(), newOptions())
+		Expect(err).To(BeNil())
+
+		Expect(artifacts.Shell).To(HavePrefix("#!/bin/sh\n"))
+		Expect(artifacts.Shell).To(ContainSubstring("REGISTRATION_CODE=reg-code\nPASSPHRASE=it's secret\nENDPOINT=https://public.example.com\nSCC_COLLECTOR_ENV\n"))
+		Expect(artifacts.Shell).To(ContainSubstring(`docker pull 'registry.example.com/collector:1.0-ubi'`))
+		Expect(artifacts.Shell).To(ContainSubstring(`docker run -d --restart unless-stopped --name 'scc-collector-my-collector-01' --env-file "$env_file" 'registry.example.com/collector:1.0-ubi'`))
+		Expect(artifacts.Shell).ToNot(ContainSubstring(" -e "))
+
+		Expect(artifacts.EnvFile).To(HaveSuffix("\nREGISTRATION_CODE=reg-code\nPASSPHRASE=it's secret\nENDPOINT=https://public.example.com\n"))
+
+		Expect(artifacts.DockerCompose).To(ContainSubstring("  scc-collector-my-collector-01:\n    image: \"registry.example.com/collector:1.0-ubi\"\n"))
+		Expect(artifacts.DockerCompose).To(ContainSubstring("    env_file:\n      - \"scc-collector-my-collector-01.env\"\n"))
+		Expect(artifacts.DockerCompose).ToNot(ContainSubstring("reg-code"))
+
+		Expect(artifacts.Kubernetes).To(ContainSubstring("kind: Secret\nmetadata:\n  name: scc-collector-my-collector-01\n  namespace: \"scc-collector\"\n"))
+		Expect(artifacts.Kubernetes).To(ContainSubstring("stringData:\n  REGISTRATION_CODE: \"reg-code\"\n  PASSPHRASE: \"it's secret\"\n---\n"))
+		Expect(artifacts.Kubernetes).To(ContainSubstring("          env:\n            - name: ENDPOINT\n              value: \"https://public.example.com\"\n          envFrom:\n"))
+	})
+	It(`Requires the environment variable names for the built-in templates`, func() {
+		options := newOptions()
+		options.EnvironmentNames = nil
+		_, err := posturemanagementv2.GenerateCollectorDeployment(newCollector(), options)
+		Expect(err).ToNot(BeNil())
+
+		options = newOptions()
+		options.Passphrase = "line\nbreak"
+		var buf bytes.Buffer
+		Expect(posturemanagementv2.WriteCollectorDeployment(&buf, posturemanagementv2.CollectorDeploymentFormatEnvFileConst, newCollector(), options)).ToNot(Succeed())
+	})
+	It(`Uses the private endpoint and the standard image`, func() {
+		collector := newCollector()
+		collector.UsePrivateEndpoint = core.BoolPtr(true)
+		collector.IsUbiImage = core.BoolPtr(false)
+		data, err := posturemanagementv2.NewCollectorDeploymentData(collector, newOptions())
+		Expect(err).To(BeNil())
+		Expect(data.Endpoint).To(Equal("https://private.example.com"))
+		Expect(data.Image).To(Equal("registry.example.com/collector:1.0"))
+	})
+	It(`Rejects IBM-managed collectors and missing settings`, func() {
+		collector := newCollector()
+		collector.ManagedBy = core.StringPtr(posturemanagementv2.CollectorManagedByIBMConst)
+		_, err := posturemanagementv2.NewCollectorDeploymentData(collector, newOptions())
+		Expect(err).ToNot(BeNil())
+
+		_, err = posturemanagementv2.NewCollectorDeploymentData(newCollector(), &posturemanagementv2.CollectorDeploymentOptions{})
+		Expect(err).To(MatchError("collectorDeploymentOptions is missing required fields: Passphrase, Image, PublicEndpoint"))
+	})
+	It(`Accepts a replacement template`, func() {
+		options := newOptions()
+		options.EnvironmentNames = nil
+		options.Templates = map[posturemanagementv2.CollectorDeploymentFormat]*template.Template{
+			posturemanagementv2.CollectorDeploymentFormatShellConst: template.Must(template.New("custom").Parse("install {{.CollectorID}} {{.RegistrationCode}}\n")),
+		}
+		var buf bytes.Buffer
+		Expect(posturemanagementv2.WriteCollectorDeployment(&buf, posturemanagementv2.CollectorDeploymentFormatShellConst, newCollector(), options)).To(Succeed())
+		Expect(buf.String()).To(Equal("install c1 reg-code\n"))
+
+		Expect(posturemanagementv2.WriteCollectorDeployment(&buf, "helm", newCollector(), options)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,361 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// CollectorHealth : The health of a collector, derived from its status, heartbeat and gateway IPs.
+type CollectorHealth string
+
+// Constants associated with CollectorHealth, from best to worst.
+const (
+	CollectorHealthHealthyConst       CollectorHealth = "healthy"
+	CollectorHealthStaleConst         CollectorHealth = "stale"
+	CollectorHealthMisconfiguredConst CollectorHealth = "misconfigured"
+	CollectorHealthFailingConst       CollectorHealth = "failing"
+)
+
+var collectorHealthRank = map[CollectorHealth]int{
+	CollectorHealthHealthyConst:       0,
+	CollectorHealthStaleConst:         1,
+	CollectorHealthMisconfiguredConst: 2,
+	CollectorHealthFailingConst:       3,
+}
+
+// Default thresholds used by ClassifyCollector.
+const (
+	DefaultCollectorStaleAfter      = 15 * time.Minute
+	DefaultCollectorMaxFailureCount = 3
+)
+
+// CollectorHealthRules : The thresholds that are used to classify collectors.
+type CollectorHealthRules struct {
+	// A running collector whose last heartbeat is older than this is stale.
+	StaleAfter time.Duration
+
+	// A collector that failed more often than this is failing.
+	MaxFailureCount int64
+}
+
+// CollectorHealthReport : The health of one collector at a point in time.
+type CollectorHealthReport struct {
+	// The ID of the collector.
+	CollectorID string
+
+	// The name of the collector.
+	Name string
+
+	// The status of the collector.
+	Status string
+
+	// The health of the collector.
+	Health CollectorHealth
+
+	// Human-readable explanations of every problem that was found.
+	Reasons []string
+
+	// The heartbeat time of the collector.
+	LastHeartbeat *strfmt.DateTime
+
+	// The number of times that the collector failed.
+	FailureCount int64
+
+	// The time that the collector was classified.
+	CheckedAt time.Time
+}
+
+// collectorFailingStatuses are statuses in which a collector cannot run tasks.
+var collectorFailingStatuses = map[string]bool{
+	CollectorStatusInstallationFailedConst: true,
+	CollectorStatusUnableToConnectConst:    true,
+	CollectorStatusSuspendedConst:          true,
+}
+
+// collectorMisconfiguredStatuses are statuses that need action from the user.
+var collectorMisconfiguredStatuses = map[string]bool{
+	CollectorStatusApprovalRequiredConst:             true,
+	CollectorStatusInstalledCredentialsRequiredConst: true,
+}
+
+// collectorRunningStatuses are statuses in which a collector is expected to send heartbeats.
+var collectorRunningStatuses = map[string]bool{
+	CollectorStatusActiveConst:                        true,
+	CollectorStatusInstalledConst:                     true,
+	CollectorStatusInstalledAssigningCredentialsConst: true,
+	CollectorStatusInstalledCredentialsRequiredConst:  true,
+}
+
+// ClassifyCollector returns the health of a collector as of now. Default rules are used when rules is nil.
+func ClassifyCollector(collector *Collector, now time.Time, rules *CollectorHealthRules) *CollectorHealthReport {
+	staleAfter := DefaultCollectorStaleAfter
+	maxFailureCount := int64(DefaultCollectorMaxFailureCount)
+	if rules != nil {
+		if rules.StaleAfter > 0 {
+			staleAfter = rules.StaleAfter
+		}
+		if rules.MaxFailureCount > 0 {
+			maxFailureCount = rules.MaxFailureCount
+		}
+	}
+
+	report := &CollectorHealthReport{
+		CollectorID:   core.StringNilMapper(collector.ID),
+		Name:          core.StringNilMapper(collector.Name),
+		Status:        core.StringNilMapper(collector.Status),
+		Health:        CollectorHealthHealthyConst,
+		LastHeartbeat: collector.LastHeartbeat,
+		CheckedAt:     now,
+	}
+	if collector.FailureCount != nil {
+		report.FailureCount = *collector.FailureCount
+	}
+	flag := func(health CollectorHealth, format string, args ...interface{}) {
+		if collectorHealthRank[health] > collectorHealthRank[report.Health] {
+			report.Health = health
+		}
+		report.Reasons = append(report.Reasons, fmt.Sprintf(format, args...))
+	}
+
+	if collectorFailingStatuses[report.Status] {
+		flag(CollectorHealthFailingConst, "collector status is %s", report.Status)
+	}
+	if report.FailureCount > maxFailureCount {
+		flag(CollectorHealthFailingConst, "collector failed %d times, more than the allowed %d", report.FailureCount, maxFailureCount)
+	}
+	if collectorMisconfiguredStatuses[report.Status] {
+		flag(CollectorHealthMisconfiguredConst, "collector status is %s", report.Status)
+	}
+	if approved, failed := core.StringNilMapper(collector.ApprovedLocalGatewayIP), core.StringNilMapper(collector.LastFailedLocalGatewayIP); failed != "" && failed != approved {
+		flag(CollectorHealthMisconfiguredConst, "local gateway IP mismatch: approved %q, last failed %q", approved, failed)
+	}
+	if approved, failed := core.StringNilMapper(collector.ApprovedInternetGatewayIP), core.StringNilMapper(collector.LastFailedInternetGatewayIP); failed != "" && failed != approved {
+		flag(CollectorHealthMisconfiguredConst, "internet gateway IP mismatch: approved %q, last failed %q", approved, failed)
+	}
+	if collectorRunningStatuses[report.Status] {
+		if collector.LastHeartbeat == nil {
+			flag(CollectorHealthStaleConst, "collector is %s but has never sent a heartbeat", report.Status)
+		} else if age := now.Sub(time.Time(*collector.LastHeartbeat)); age > staleAfter {
+			flag(CollectorHealthStaleConst, "last heartbeat was %s ago, more than the allowed %s", age.Round(time.Second), staleAfter)
+		}
+	}
+	if report.Health != CollectorHealthHealthyConst && collector.ResetReason != nil && *collector.ResetReason != "" {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("collector was reset: %s", *collector.ResetReason))
+	}
+	return report
+}
+
+// CollectorHealthEvent : A change in the health of a collector.
+type CollectorHealthEvent struct {
+	// The previous report. Nil when the collector was not seen before.
+	Previous *CollectorHealthReport
+
+	// The current report. Nil when the collector no longer exists.
+	Current *CollectorHealthReport
+}
+
+// CollectorMonitorOptions : The options of a CollectorMonitor.
+type CollectorMonitorOptions struct {
+	// The time between two checks. Defaults to one minute.
+	Interval time.Duration
+
+	// The thresholds that are used to classify collectors.
+	Rules *CollectorHealthRules
+
+	// Receives an event whenever the health or status of a collector changes, a collector appears or a collector is
+	// deleted. Sends block, so the channel must be drained or buffered.
+	Events chan<- CollectorHealthEvent
+
+	// Called by Run with the error of every check that fails while Run keeps running. Failed checks are also counted
+	// in the scc_posture_collector_check_errors_total metric.
+	OnError func(err error)
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// CollectorMonitor : Periodically lists collectors and tracks their health.
+type CollectorMonitor struct {
+	service *PostureManagementV2
+	options CollectorMonitorOptions
+
+	mutex       sync.Mutex
+	reports     map[string]*CollectorHealthReport
+	checkErrors int64
+}
+
+// NewCollectorMonitor : Instantiate CollectorMonitor
+func (postureManagement *PostureManagementV2) NewCollectorMonitor(options *CollectorMonitorOptions) *CollectorMonitor {
+	monitor := &CollectorMonitor{
+		service: postureManagement,
+		reports: make(map[string]*CollectorHealthReport),
+	}
+	if options != nil {
+		monitor.options = *options
+	}
+	if monitor.options.Interval <= 0 {
+		monitor.options.Interval = time.Minute
+	}
+	return monitor
+}
+
+// Run checks the collectors every interval until the context is done. Errors from individual checks are returned
+// only when stopOnError is true; otherwise they are passed to OnError and the next check is attempted.
+func (monitor *CollectorMonitor) Run(ctx context.Context, stopOnError bool) error {
+	ticker := time.NewTicker(monitor.options.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := monitor.Check(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if stopOnError {
+				return err
+			}
+			if monitor.options.OnError != nil {
+				monitor.options.OnError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check lists the collectors once, updates the tracked state and sends an event for every change. The events are sent
+// and the reports are returned sorted by collector ID.
+func (monitor *CollectorMonitor) Check(ctx context.Context) ([]CollectorHealthReport, error) {
+	collectors, err := monitor.service.listAllCollectors(ctx, monitor.options.AccountID)
+	if err != nil {
+		monitor.mutex.Lock()
+		monitor.checkErrors++
+		monitor.mutex.Unlock()
+		return nil, err
+	}
+
+	now := time.Now()
+	current := make(map[string]*CollectorHealthReport, len(collectors))
+	for i := range collectors {
+		report := ClassifyCollector(&collectors[i], now, monitor.options.Rules)
+		current[report.CollectorID] = report
+	}
+
+	monitor.mutex.Lock()
+	previous := monitor.reports
+	monitor.reports = current
+	monitor.mutex.Unlock()
+
+	var events []CollectorHealthEvent
+	for id, report := range current {
+		old := previous[id]
+		if old == nil || old.Health != report.Health || old.Status != report.Status {
+			events = append(events, CollectorHealthEvent{Previous: old, Current: report})
+		}
+	}
+	for id, old := range previous {
+		if current[id] == nil {
+			events = append(events, CollectorHealthEvent{Previous: old})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].collectorID() < events[j].collectorID()
+	})
+	if monitor.options.Events != nil {
+		for _, event := range events {
+			select {
+			case monitor.options.Events <- event:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return monitor.Snapshot(), nil
+}
+
+// collectorID returns the ID of the collector that the event is about.
+func (event *CollectorHealthEvent) collectorID() string {
+	if event.Current != nil {
+		return event.Current.CollectorID
+	}
+	return event.Previous.CollectorID
+}
+
+// Snapshot returns the reports of the last check, sorted by collector ID.
+func (monitor *CollectorMonitor) Snapshot() []CollectorHealthReport {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	reports := make([]CollectorHealthReport, 0, len(monitor.reports))
+	for _, report := range monitor.reports {
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].CollectorID < reports[j].CollectorID
+	})
+	return reports
+}
+
+// WriteMetrics writes the reports of the last check to w in the Prometheus text exposition format.
+func (monitor *CollectorMonitor) WriteMetrics(w io.Writer) error {
+	reports := monitor.Snapshot()
+	monitor.mutex.Lock()
+	checkErrors := monitor.checkErrors
+	monitor.mutex.Unlock()
+
+	var b strings.Builder
+	common.WriteMetricFamily(&b, "scc_posture_collector_health", "gauge", "Health of a posture management collector, 1 for the current health.")
+	for _, report := range reports {
+		for _, health := range []CollectorHealth{CollectorHealthHealthyConst, CollectorHealthStaleConst, CollectorHealthMisconfiguredConst, CollectorHealthFailingConst} {
+			value := 0
+			if report.Health == health {
+				value = 1
+			}
+			common.WriteMetricSample(&b, "scc_posture_collector_health", common.FormatMetricLabels(
+				"collector_id", report.CollectorID, "name", report.Name, "status", report.Status, "health", string(health)), value)
+		}
+	}
+	common.WriteMetricFamily(&b, "scc_posture_collector_failure_count", "gauge", "Number of times a posture management collector failed.")
+	for _, report := range reports {
+		common.WriteMetricSample(&b, "scc_posture_collector_failure_count", common.FormatMetricLabels(
+			"collector_id", report.CollectorID, "name", report.Name), report.FailureCount)
+	}
+	common.WriteMetricFamily(&b, "scc_posture_collector_heartbeat_age_seconds", "gauge", "Seconds since the last heartbeat of a posture management collector.")
+	for _, report := range reports {
+		if report.LastHeartbeat == nil {
+			continue
+		}
+		age := report.CheckedAt.Sub(time.Time(*report.LastHeartbeat)).Seconds()
+		common.WriteMetricSample(&b, "scc_posture_collector_heartbeat_age_seconds", common.FormatMetricLabels(
+			"collector_id", report.CollectorID, "name", report.Name), age)
+	}
+	common.WriteMetricFamily(&b, "scc_posture_collector_check_errors_total", "counter", "Number of checks of the posture management collectors that failed.")
+	common.WriteMetricSample(&b, "scc_posture_collector_check_errors_total", "", checkErrors)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 collector monitor`, func() {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	heartbeat := func(age time.Duration) *strfmt.DateTime {
+		t := strfmt.DateTime(now.Add(-age))
+		return &t
+	}
+
+	Context(`ClassifyCollector`, func() {
+		It(`Reports a running collector with a recent heartbeat as healthy`, func() {
+			report := posturemanagementv2.ClassifyCollector(&posturemanagementv2.Collector{
+				ID:            core.StringPtr("c1"),
+				Status:        core.StringPtr(posturemanagementv2.CollectorStatusActiveConst),
+				LastHeartbeat: heartbeat(time.Minute),
+				FailureCount:  core.Int64Ptr(0),
+			}, now, nil)
+			Expect(report.Health).To(Equal(posturemanagementv2.CollectorHealthHealthyConst))
+			Expect(report.Reasons).To(BeEmpty())
+		})
+		It(`Reports an old heartbeat as stale`, func() {
+			report := posturemanagementv2.ClassifyCollector(&posturemanagementv2.Collector{
+				Status:        core.StringPtr(posturemanagementv2.CollectorStatusInstalledAssigningCredentialsConst),
+				LastHeartbeat: heartbeat(time.Hour),
+			}, now, &posturemanagementv2.CollectorHealthRules{StaleAfter: 30 * time.Minute})
+			Expect(report.Health).To(Equal(posturemanagementv2.CollectorHealthStaleConst))
+		})
+		It(`Explains a gateway IP mismatch`, func() {
+			report := posturemanagementv2.ClassifyCollector(&posturemanagementv2.Collector{
+				Status:                   core.StringPtr(posturemanagementv2.CollectorStatusActiveConst),
+				LastHeartbeat:            heartbeat(time.Minute),
+				ApprovedLocalGatewayIP:   core.StringPtr("10.0.0.1"),
+				LastFailedLocalGatewayIP: core.StringPtr("10.0.0.2"),
+			}, now, nil)
+			Expect(report.Health).To(Equal(posturemanagementv2.CollectorHealthMisconfiguredConst))
+			Expect(report.Reasons).To(ConsistOf(ContainSubstring("local gateway IP mismatch")))
+		})
+		It(`Keeps the worst health and every reason`, func() {
+			report := posturemanagementv2.ClassifyCollector(&posturemanagementv2.Collector{
+				Status:        core.StringPtr(posturemanagementv2.CollectorStatusUnableToConnectConst),
+				FailureCount:  core.Int64Ptr(7),
+				ResetReason:   core.StringPtr("moved host"),
+				LastHeartbeat: heartbeat(time.Minute),
+			}, now, nil)
+			Expect(report.Health).To(Equal(posturemanagementv2.CollectorHealthFailingConst))
+			Expect(report.Reasons).To(HaveLen(3))
+		})
+	})
+
+	Context(`CollectorMonitor`, func() {
+		var testServer *httptest.Server
+		var status, name string
+		var ids []string
+		var failing bool
+		BeforeEach(func() {
+			status = "active"
+			name = "collector"
+			ids = []string{"c1"}
+			failing = false
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/posture/v2/collectors"))
+				res.Header().Set("Content-type", "application/json")
+				if failing {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"message": "unavailable"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				collectors := make([]string, len(ids))
+				for i, id := range ids {
+					collectors[i] = fmt.Sprintf(`{"id": "%s", "name": "%s", "status": "%s", "failure_count": 0, "last_heartbeat": "%s"}`, id, name, status, time.Now().UTC().Format("2006-01-02T15:04:05.000Z"))
+				}
+				fmt.Fprintf(res, `{"offset": 0, "limit": 10, "total_count": %d, "first": {"href": "first"}, "last": {"href": "last"}, "collectors": [%s]}`, len(ids), strings.Join(collectors, ", "))
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Emits events only when the health changes and exports metrics`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			events := make(chan posturemanagementv2.CollectorHealthEvent, 10)
+			monitor := postureManagementService.NewCollectorMonitor(&posturemanagementv2.CollectorMonitorOptions{
+				Events: events,
+			})
+
+			reports, err := monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+			Expect(reports).To(HaveLen(1))
+			Expect(events).To(HaveLen(1))
+			first := <-events
+			Expect(first.Previous).To(BeNil())
+			Expect(first.Current.Health).To(Equal(posturemanagementv2.CollectorHealthHealthyConst))
+
+			_, err = monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(0))
+
+			status = "unable_to_connect"
+			_, err = monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(1))
+			second := <-events
+			Expect(second.Previous.Health).To(Equal(posturemanagementv2.CollectorHealthHealthyConst))
+			Expect(second.Current.Health).To(Equal(posturemanagementv2.CollectorHealthFailingConst))
+
+			var metrics bytes.Buffer
+			Expect(monitor.WriteMetrics(&metrics)).To(Succeed())
+			Expect(metrics.String()).To(ContainSubstring(`scc_posture_collector_health{collector_id="c1",name="collector",status="unable_to_connect",health="failing"} 1`))
+			Expect(metrics.String()).To(ContainSubstring(`scc_posture_collector_failure_count{collector_id="c1",name="collector"} 0`))
+		})
+		It(`Escapes label values in the metrics`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			name = `east \"edge\"\tC:\\collector`
+			monitor := postureManagementService.NewCollectorMonitor(&posturemanagementv2.CollectorMonitorOptions{})
+			_, err := monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+
+			var metrics bytes.Buffer
+			Expect(monitor.WriteMetrics(&metrics)).To(Succeed())
+			Expect(metrics.String()).To(ContainSubstring("scc_posture_collector_failure_count{collector_id=\"c1\",name=\"east \\\"edge\\\"\tC:\\\\collector\"} 0\n"))
+		})
+		It(`Sends the events sorted by collector ID`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			events := make(chan posturemanagementv2.CollectorHealthEvent, 10)
+			monitor := postureManagementService.NewCollectorMonitor(&posturemanagementv2.CollectorMonitorOptions{
+				Events: events,
+			})
+			ids = []string{"c3", "c1", "c4", "c2"}
+			_, err := monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+			ids = []string{"c5", "c3", "c1"}
+			status = "unable_to_connect"
+			_, err = monitor.Check(context.Background())
+			Expect(err).To(BeNil())
+			close(events)
+
+			var order []string
+			for event := range events {
+				if event.Current != nil {
+					order = append(order, event.Current.CollectorID)
+				} else {
+					order = append(order, "-"+event.Previous.CollectorID)
+				}
+			}
+			Expect(order).To(Equal([]string{"c1", "c2", "c3", "c4", "c1", "-c2", "c3", "-c4", "c5"}))
+		})
+		It(`Reports failed checks while it keeps running`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			postureManagementService.DisableRetries()
+			var checkErrors []error
+			monitor := postureManagementService.NewCollectorMonitor(&posturemanagementv2.CollectorMonitorOptions{
+				Interval: 5 * time.Millisecond,
+				OnError: func(err error) {
+					checkErrors = append(checkErrors, err)
+				},
+			})
+			failing = true
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+			defer cancel()
+			Expect(monitor.Run(ctx, false)).To(Equal(context.DeadlineExceeded))
+			Expect(checkErrors).ToNot(BeEmpty())
+			Expect(checkErrors[0]).To(MatchError("unavailable"))
+
+			var metrics bytes.Buffer
+			Expect(monitor.WriteMetrics(&metrics)).To(Succeed())
+			Expect(metrics.String()).To(ContainSubstring("# TYPE scc_posture_collector_check_errors_total counter\n"))
+			Expect(metrics.String()).To(MatchRegexp(`scc_posture_collector_check_errors_total [1-9][0-9]*\n`))
+		})
+		It(`Stops running when the context is done`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			monitor := postureManagementService.NewCollectorMonitor(&posturemanagementv2.CollectorMonitorOptions{
+				Interval: 5 * time.Millisecond,
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+			defer cancel()
+			err := monitor.Run(ctx, true)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(monitor.Snapshot()).To(HaveLen(1))
+		})
+	})
+})
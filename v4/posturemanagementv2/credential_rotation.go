@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// ScopeRotationOutcome : The outcome of revalidating one scope after a credential rotation.
+type ScopeRotationOutcome string
+
+// Constants associated with ScopeRotationOutcome.
+const (
+	ScopeRotationOutcomeSucceededConst        ScopeRotationOutcome = "succeeded"
+	ScopeRotationOutcomeDiscoveryFailedConst  ScopeRotationOutcome = "discovery_failed"
+	ScopeRotationOutcomeValidationFailedConst ScopeRotationOutcome = "validation_failed"
+)
+
+// DefaultCredentialRollbackTimeout is the longest time that restoring the previous secret may take when
+// RotateCredentialOptions does not set RollbackTimeout.
+const DefaultCredentialRollbackTimeout = time.Minute
+
+// ScopeRotationReport : The result of revalidating one scope that uses a rotated credential.
+type ScopeRotationReport struct {
+	// The ID of the scope.
+	ScopeID string
+
+	// The name of the scope.
+	ScopeName string
+
+	// The outcome of the revalidation.
+	Outcome ScopeRotationOutcome
+
+	// The last status of the discovery task.
+	Discovery *ScopeTaskStatus
+
+	// The last status of the validation task. Nil when no profile was given or discovery failed.
+	Validation *ScopeTaskStatus
+
+	// The error that caused the scope to fail, if any.
+	Err error
+}
+
+// RotateCredentialResult : The outcome of a RotateCredential workflow.
+type RotateCredentialResult struct {
+	// The credential as returned by the update with the new secret.
+	Credential *Credential
+
+	// One report for every scope that uses the credential.
+	Scopes []ScopeRotationReport
+
+	// Whether the previous secret was restored because discovery failed.
+	RolledBack bool
+}
+
+// CredentialRotationError : Returned by RotateCredential when discovery failed for at least one scope.
+type CredentialRotationError struct {
+	// The ID of the rotated credential.
+	CredentialID string
+
+	// The IDs of the scopes whose discovery failed.
+	FailedScopes []string
+
+	// Whether the previous secret was restored.
+	RolledBack bool
+
+	// The error returned while restoring the previous secret, if any.
+	RollbackErr error
+}
+
+func (e *CredentialRotationError) Error() string {
+	msg := fmt.Sprintf("discovery failed after rotating credential %s for scopes: %s", e.CredentialID, strings.Join(e.FailedScopes, ", "))
+	switch {
+	case e.RollbackErr != nil:
+		msg += fmt.Sprintf("; rollback failed: %s", e.RollbackErr.Error())
+	case e.RolledBack:
+		msg += "; the previous secret was restored"
+	default:
+		msg += "; no previous secret was given, so the new secret was kept"
+	}
+	return msg
+}
+
+// Unwrap returns the rollback error.
+func (e *CredentialRotationError) Unwrap() error {
+	return e.RollbackErr
+}
+
+// RotateCredentialOptions : The RotateCredential options.
+type RotateCredentialOptions struct {
+	// The ID of the credential to rotate.
+	ID *string `validate:"required,ne="`
+
+	// The new secret.
+	Spec CredentialSpec `validate:"required"`
+
+	// The secret that is restored when discovery fails. When nil the new secret is kept.
+	Previous CredentialSpec
+
+	// The ID of the profile to validate every scope against after discovery. When nil only discovery is run.
+	ProfileID *string
+
+	// The ID of the profile group to validate every scope against.
+	GroupProfileID *string
+
+	// Controls how discovery and validation are polled. CorrelationID, ScopeID, Since and TaskType are set by the
+	// workflow.
+	Wait *WaitForScopeTaskOptions
+
+	// The longest time that restoring the previous secret may take. The rollback does not use the context of the
+	// call, which has often expired while discovery was waited for. Defaults to DefaultCredentialRollbackTimeout.
+	RollbackTimeout time.Duration
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewRotateCredentialOptions : Instantiate RotateCredentialOptions
+func (*PostureManagementV2) NewRotateCredentialOptions(id string, spec CredentialSpec, previous CredentialSpec) *RotateCredentialOptions {
+	return &RotateCredentialOptions{
+		ID:       core.StringPtr(id),
+		Spec:     spec,
+		Previous: previous,
+	}
+}
+
+// SetProfileID : Allow user to set ProfileID
+func (_options *RotateCredentialOptions) SetProfileID(profileID string) *RotateCredentialOptions {
+	_options.ProfileID = core.StringPtr(profileID)
+	return _options
+}
+
+// SetGroupProfileID : Allow user to set GroupProfileID
+func (_options *RotateCredentialOptions) SetGroupProfileID(groupProfileID string) *RotateCredentialOptions {
+	_options.GroupProfileID = core.StringPtr(groupProfileID)
+	return _options
+}
+
+// SetWait : Allow user to set Wait
+func (_options *RotateCredentialOptions) SetWait(wait *WaitForScopeTaskOptions) *RotateCredentialOptions {
+	_options.Wait = wait
+	return _options
+}
+
+// SetRollbackTimeout : Allow user to set RollbackTimeout
+func (_options *RotateCredentialOptions) SetRollbackTimeout(rollbackTimeout time.Duration) *RotateCredentialOptions {
+	_options.RollbackTimeout = rollbackTimeout
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *RotateCredentialOptions) SetAccountID(accountID string) *RotateCredentialOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// RotateCredential : Rotate a credential and revalidate the scopes that use it
+// Update the secret of a credential, reassign it to every scope that uses it so that discovery runs again, wait for
+// discovery (and validation when a profile is given) and restore the previous secret if discovery fails for any scope.
+// A *CredentialRotationError is returned together with the result when discovery fails.
+func (postureManagement *PostureManagementV2) RotateCredential(rotateCredentialOptions *RotateCredentialOptions) (result *RotateCredentialResult, err error) {
+	return postureManagement.RotateCredentialWithContext(context.Background(), rotateCredentialOptions)
+}
+
+// RotateCredentialWithContext is an alternate form of the RotateCredential method which supports a Context parameter
+func (postureManagement *PostureManagementV2) RotateCredentialWithContext(ctx context.Context, rotateCredentialOptions *RotateCredentialOptions) (result *RotateCredentialResult, err error) {
+	err = core.ValidateNotNil(rotateCredentialOptions, "rotateCredentialOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(rotateCredentialOptions, "rotateCredentialOptions")
+	if err != nil {
+		return
+	}
+	if err = rotateCredentialOptions.Spec.Validate(); err != nil {
+		return
+	}
+	if rotateCredentialOptions.Previous != nil {
+		if err = rotateCredentialOptions.Previous.Validate(); err != nil {
+			return
+		}
+	}
+
+	// Look the scopes up before anything changes so that a failed lookup leaves the credential untouched.
+	scopes, err := postureManagement.scopesUsingCredential(ctx, *rotateCredentialOptions.ID, rotateCredentialOptions.AccountID)
+	if err != nil {
+		return
+	}
+
+	result = new(RotateCredentialResult)
+	result.Credential, err = postureManagement.updateCredentialSecret(ctx, rotateCredentialOptions, rotateCredentialOptions.Spec)
+	if err != nil {
+		result = nil
+		return
+	}
+
+	var failed []string
+	for _, scope := range scopes {
+		report := postureManagement.revalidateScope(ctx, rotateCredentialOptions, scope)
+		if report.Outcome == ScopeRotationOutcomeDiscoveryFailedConst {
+			failed = append(failed, report.ScopeID)
+		}
+		result.Scopes = append(result.Scopes, report)
+	}
+	if len(failed) == 0 {
+		return
+	}
+
+	rotationErr := &CredentialRotationError{
+		CredentialID: *rotateCredentialOptions.ID,
+		FailedScopes: failed,
+	}
+	if rotateCredentialOptions.Previous != nil {
+		timeout := rotateCredentialOptions.RollbackTimeout
+		if timeout <= 0 {
+			timeout = DefaultCredentialRollbackTimeout
+		}
+		rollbackCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, rotationErr.RollbackErr = postureManagement.updateCredentialSecret(rollbackCtx, rotateCredentialOptions, rotateCredentialOptions.Previous)
+		cancel()
+		rotationErr.RolledBack = rotationErr.RollbackErr == nil
+		result.RolledBack = rotationErr.RolledBack
+	}
+	err = rotationErr
+	return
+}
+
+// scopeUsingCredential is a scope together with the attribute under which it holds the credential.
+type scopeUsingCredential struct {
+	scope     ScopeItem
+	attribute *string
+}
+
+// scopesUsingCredential returns every scope whose credential listing references credentialID.
+func (postureManagement *PostureManagementV2) scopesUsingCredential(ctx context.Context, credentialID string, accountID *string) (scopes []scopeUsingCredential, err error) {
+	all, err := postureManagement.listAllScopes(ctx, accountID)
+	if err != nil {
+		return
+	}
+	for _, scope := range all {
+		if scope.ID == nil {
+			continue
+		}
+		getScopeDetailsCredentialsOptions := postureManagement.NewGetScopeDetailsCredentialsOptions(*scope.ID)
+		getScopeDetailsCredentialsOptions.AccountID = accountID
+		var scopeCredential *ScopeCredential
+		scopeCredential, _, err = postureManagement.GetScopeDetailsCredentialsWithContext(ctx, getScopeDetailsCredentialsOptions)
+		if err != nil {
+			return
+		}
+		if scopeCredential != nil && core.StringNilMapper(scopeCredential.CredentialID) == credentialID {
+			scopes = append(scopes, scopeUsingCredential{scope: scope, attribute: scopeCredential.CredentialAttribute})
+		}
+	}
+	return
+}
+
+func (postureManagement *PostureManagementV2) updateCredentialSecret(ctx context.Context, rotateCredentialOptions *RotateCredentialOptions, spec CredentialSpec) (*Credential, error) {
+	displayFields := UpdateCredentialDisplayFields(*spec.NewDisplayFields())
+	updateCredentialOptions := postureManagement.NewUpdateCredentialOptions(*rotateCredentialOptions.ID)
+	updateCredentialOptions.SetType(spec.CredentialType())
+	updateCredentialOptions.SetDisplayFields(&displayFields)
+	updateCredentialOptions.AccountID = rotateCredentialOptions.AccountID
+	credential, _, err := postureManagement.UpdateCredentialWithContext(ctx, updateCredentialOptions)
+	return credential, err
+}
+
+// revalidateScope reassigns the credential to the scope, which triggers a fresh discovery, and waits for discovery
+// and, when a profile is given, validation to finish.
+func (postureManagement *PostureManagementV2) revalidateScope(ctx context.Context, rotateCredentialOptions *RotateCredentialOptions, scope scopeUsingCredential) (report ScopeRotationReport) {
+	report.ScopeID = *scope.scope.ID
+	report.ScopeName = core.StringNilMapper(scope.scope.Name)
+
+	replaceScopeDetailsCredentialsOptions := postureManagement.NewReplaceScopeDetailsCredentialsOptions(report.ScopeID, *rotateCredentialOptions.ID)
+	replaceScopeDetailsCredentialsOptions.CredentialAttribute = scope.attribute
+	replaceScopeDetailsCredentialsOptions.AccountID = rotateCredentialOptions.AccountID
+	localDiscoveryStartedAt := time.Now()
+	_, response, err := postureManagement.ReplaceScopeDetailsCredentialsWithContext(ctx, replaceScopeDetailsCredentialsOptions)
+	report.Err = err
+	if report.Err == nil {
+		discoveryStartedAt := requestStartedAt(localDiscoveryStartedAt, response)
+		report.Discovery, report.Err = postureManagement.waitForRotationTask(ctx, rotateCredentialOptions, report.ScopeID, discoveryStartedAt, EventItemTaskTypeDiscoveryConst)
+	}
+	if report.Err != nil {
+		report.Outcome = ScopeRotationOutcomeDiscoveryFailedConst
+		return
+	}
+
+	report.Outcome = ScopeRotationOutcomeSucceededConst
+	if rotateCredentialOptions.ProfileID == nil {
+		return
+	}
+	createValidationOptions := postureManagement.NewCreateValidationOptions(report.ScopeID, *rotateCredentialOptions.ProfileID)
+	createValidationOptions.GroupProfileID = rotateCredentialOptions.GroupProfileID
+	createValidationOptions.AccountID = rotateCredentialOptions.AccountID
+	localValidationStartedAt := time.Now()
+	validation, response, err := postureManagement.CreateValidationWithContext(ctx, createValidationOptions)
+	if err == nil && validation != nil && validation.Result != nil && !*validation.Result {
+		err = fmt.Errorf("validation of scope %s was not started: %s", report.ScopeID, core.StringNilMapper(validation.Message))
+	}
+	if err == nil {
+		validationStartedAt := requestStartedAt(localValidationStartedAt, response)
+		report.Validation, err = postureManagement.waitForRotationTask(ctx, rotateCredentialOptions, report.ScopeID, validationStartedAt, EventItemTaskTypeFactCollectionConst)
+	}
+	if err != nil {
+		report.Outcome = ScopeRotationOutcomeValidationFailedConst
+		report.Err = err
+	}
+	return
+}
+
+func (postureManagement *PostureManagementV2) waitForRotationTask(ctx context.Context, rotateCredentialOptions *RotateCredentialOptions, scopeID string, since strfmt.DateTime, taskType string) (*ScopeTaskStatus, error) {
+	waitOptions := new(WaitForScopeTaskOptions)
+	if rotateCredentialOptions.Wait != nil {
+		*waitOptions = *rotateCredentialOptions.Wait
+	}
+	waitOptions.CorrelationID = nil
+	waitOptions.SetScopeID(scopeID)
+	waitOptions.SetSince(&since)
+	waitOptions.SetTaskType(taskType)
+	waitOptions.AccountID = rotateCredentialOptions.AccountID
+	status, err := postureManagement.WaitForScopeTaskWithContext(ctx, waitOptions)
+	if status == nil {
+		// Keep the last known status of a failed or timed out task for the report.
+		var failedErr *ScopeTaskFailedError
+		var timeoutErr *ScopeTaskTimeoutError
+		if errors.As(err, &failedErr) {
+			status = failedErr.Status
+		} else if errors.As(err, &timeoutErr) {
+			status = timeoutErr.LastStatus
+		}
+	}
+	return status, err
+}
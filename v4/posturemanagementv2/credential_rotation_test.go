@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 credential rotation`, func() {
+	var testServer *fixtureserver.Server
+	var events func(discoveryStatus string) string
+
+	BeforeEach(func() {
+		later := time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+		events = func(discoveryStatus string) string {
+			return fmt.Sprintf(`{"events": [
+				{"id": "e1", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "discovery", "status": "%[2]s", "data_available": true, "status_message": "discovery done"},
+				{"id": "e2", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "fact_collection", "status": "validation_result_posted_no_error", "data_available": true, "status_message": "done"}
+			]}`, later, discoveryStatus)
+		}
+		testServer = fixtureserver.New(map[string]string{
+			"GET /posture/v2/scopes":                       `{"offset": 0, "limit": 10, "total_count": 3, "first": {"href": "first"}, "last": {"href": "last"}, "scopes": [{"id": "scope-1", "name": "one"}, {"id": "scope-2", "name": "two"}, {"id": "scope-3", "name": "three"}]}`,
+			"GET /posture/v2/scopes/scope-1/credentials":   `{"credential_id": "cred-1"}`,
+			"GET /posture/v2/scopes/scope-2/credentials":   `{"credential_id": "cred-1", "credential_attribute": "attr"}`,
+			"GET /posture/v2/scopes/scope-3/credentials":   `{"credential_id": "cred-other"}`,
+			"PATCH /posture/v2/credentials/cred-1":         `{"id": "cred-1", "name": "rotated"}`,
+			"PATCH /posture/v2/scopes/scope-1/credentials": `{"credential_id": "cred-1"}`,
+			"PATCH /posture/v2/scopes/scope-2/credentials": `{"credential_id": "cred-1"}`,
+			"GET /posture/v2/scopes/scope-1/events":        events("discovery_result_posted_no_error"),
+			"GET /posture/v2/scopes/scope-2/events":        events("discovery_result_posted_no_error"),
+			"POST /posture/v2/scans/validations":           `{"result": true, "message": "Successfully started validation"}`,
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newRotateCredentialOptions := func(service *posturemanagementv2.PostureManagementV2) *posturemanagementv2.RotateCredentialOptions {
+		return service.NewRotateCredentialOptions("cred-1",
+			&posturemanagementv2.IBMCredential{APIKey: "new-key"},
+			&posturemanagementv2.IBMCredential{APIKey: "old-key"},
+		).SetWait(service.NewWaitForScopeTaskOptions().SetPollInterval(time.Millisecond, time.Millisecond).SetTimeout(time.Second))
+	}
+
+	It(`Revalidates every scope that uses the credential`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		result, err := postureManagementService.RotateCredential(newRotateCredentialOptions(postureManagementService).SetProfileID("profile-1"))
+		Expect(err).To(BeNil())
+		Expect(*result.Credential.ID).To(Equal("cred-1"))
+		Expect(result.RolledBack).To(BeFalse())
+		Expect(result.Scopes).To(HaveLen(2))
+		for _, report := range result.Scopes {
+			Expect(report.Outcome).To(Equal(posturemanagementv2.ScopeRotationOutcomeSucceededConst))
+			Expect(*report.Discovery.Status).To(Equal("discovery_result_posted_no_error"))
+			Expect(*report.Validation.Status).To(Equal("validation_result_posted_no_error"))
+		}
+		credentialRequests := testServer.RequestsTo("PATCH /posture/v2/credentials/cred-1")
+		Expect(credentialRequests).To(HaveLen(1))
+		Expect(credentialRequests[0].Body).To(ContainSubstring(`"ibm_api_key":"new-key"`))
+		Expect(testServer.Requests()).ToNot(ContainElement("PATCH /posture/v2/scopes/scope-3/credentials"))
+	})
+	It(`Finds the new tasks when the local clock is ahead of the server`, func() {
+		serverNow := time.Now().Add(-time.Hour)
+		skewedServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Date", serverNow.UTC().Format(http.TimeFormat))
+			testServer.Config.Handler.ServeHTTP(res, req)
+		}))
+		defer skewedServer.Close()
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           skewedServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		createdAt := serverNow.Add(time.Minute).UTC().Format("2006-01-02T15:04:05.000Z")
+		skewedEvents := fmt.Sprintf(`{"events": [
+			{"id": "e1", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "discovery", "status": "discovery_result_posted_no_error", "data_available": true, "status_message": "discovery done"},
+			{"id": "e2", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "fact_collection", "status": "validation_result_posted_no_error", "data_available": true, "status_message": "done"}
+		]}`, createdAt)
+		testServer.SetResponse("GET /posture/v2/scopes/scope-1/events", skewedEvents)
+		testServer.SetResponse("GET /posture/v2/scopes/scope-2/events", skewedEvents)
+		result, err := postureManagementService.RotateCredential(newRotateCredentialOptions(postureManagementService).SetProfileID("profile-1"))
+		Expect(err).To(BeNil())
+		Expect(result.RolledBack).To(BeFalse())
+		for _, report := range result.Scopes {
+			Expect(report.Outcome).To(Equal(posturemanagementv2.ScopeRotationOutcomeSucceededConst))
+		}
+	})
+	It(`Restores the previous secret when discovery fails`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scopes/scope-2/events", events("discovery_result_posted_with_error"))
+		result, err := postureManagementService.RotateCredential(newRotateCredentialOptions(postureManagementService))
+		var rotationErr *posturemanagementv2.CredentialRotationError
+		Expect(errors.As(err, &rotationErr)).To(BeTrue())
+		Expect(rotationErr.FailedScopes).To(Equal([]string{"scope-2"}))
+		Expect(err.Error()).To(HaveSuffix("the previous secret was restored"))
+		Expect(result.RolledBack).To(BeTrue())
+		Expect(result.Scopes[0].Outcome).To(Equal(posturemanagementv2.ScopeRotationOutcomeSucceededConst))
+		Expect(result.Scopes[0].Validation).To(BeNil())
+		Expect(result.Scopes[1].Outcome).To(Equal(posturemanagementv2.ScopeRotationOutcomeDiscoveryFailedConst))
+		Expect(*result.Scopes[1].Discovery.Status).To(Equal("discovery_result_posted_with_error"))
+		credentialRequests := testServer.RequestsTo("PATCH /posture/v2/credentials/cred-1")
+		Expect(credentialRequests).To(HaveLen(2))
+		Expect(credentialRequests[1].Body).To(ContainSubstring(`"ibm_api_key":"old-key"`))
+	})
+	It(`Restores the previous secret when the context expires during discovery`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scopes/scope-2/events", events("discovery_in_progress"))
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		result, err := postureManagementService.RotateCredentialWithContext(ctx, newRotateCredentialOptions(postureManagementService))
+		var rotationErr *posturemanagementv2.CredentialRotationError
+		Expect(errors.As(err, &rotationErr)).To(BeTrue())
+		Expect(rotationErr.FailedScopes).To(Equal([]string{"scope-2"}))
+		Expect(rotationErr.RollbackErr).To(BeNil())
+		Expect(result.RolledBack).To(BeTrue())
+		Expect(ctx.Err()).ToNot(BeNil())
+		credentialRequests := testServer.RequestsTo("PATCH /posture/v2/credentials/cred-1")
+		Expect(credentialRequests).To(HaveLen(2))
+		Expect(credentialRequests[1].Body).To(ContainSubstring(`"ibm_api_key":"old-key"`))
+	})
+	It(`Rejects an incomplete secret before sending any request`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		result, err := postureManagementService.RotateCredential(postureManagementService.NewRotateCredentialOptions("cred-1", &posturemanagementv2.IBMCredential{}, nil))
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(testServer.Requests()).To(BeEmpty())
+	})
+})
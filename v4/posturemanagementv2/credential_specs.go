@@ -0,0 +1,445 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CredentialSpec : The type-specific details of a credential. Each implementation maps to one of the
+// CreateCredentialOptionsType*Const values and knows which display fields that type requires.
+type CredentialSpec interface {
+	// CredentialType returns the credential type that the spec describes.
+	CredentialType() string
+
+	// Validate returns an error when a field that is mandatory for the credential type is missing or malformed.
+	Validate() error
+
+	// NewDisplayFields returns the spec as the display fields that are sent when a credential is created.
+	NewDisplayFields() *NewCredentialDisplayFields
+}
+
+// IBMCredential : The details of an IBM Cloud credential.
+type IBMCredential struct {
+	// The IBM Cloud API key.
+	APIKey string
+}
+
+// AWSCredential : The details of an Amazon Web Services credential.
+type AWSCredential struct {
+	// The Amazon Web Services client ID.
+	ClientID string
+
+	// The Amazon Web Services client secret.
+	ClientSecret string
+
+	// The Amazon Web Services region.
+	Region string
+
+	// The Amazon Web Services arn value. Optional.
+	Arn string
+}
+
+// AzureCredential : The details of a Microsoft Azure credential.
+type AzureCredential struct {
+	// The Microsoft Azure client ID.
+	ClientID string
+
+	// The Microsoft Azure client secret.
+	ClientSecret string
+
+	// The Microsoft Azure subscription ID.
+	SubscriptionID string
+
+	// The Microsoft Azure resource group. Optional.
+	ResourceGroup string
+}
+
+// MS365Credential : The details of a Microsoft 365 credential.
+type MS365Credential struct {
+	// The Microsoft 365 client ID.
+	ClientID string
+
+	// The Microsoft 365 client secret.
+	ClientSecret string
+
+	// The Microsoft 365 tenant ID.
+	TenantID string
+}
+
+// OpenStackCredential : The details of an OpenStack cloud credential.
+type OpenStackCredential struct {
+	// The username of the user.
+	Username string
+
+	// The password of the user.
+	Password string
+
+	// The auth url of the OpenStack cloud.
+	AuthURL string
+
+	// The project name of the OpenStack cloud.
+	ProjectName string
+
+	// The user domain name of the OpenStack cloud.
+	UserDomainName string
+
+	// The project domain name of the OpenStack cloud.
+	ProjectDomainName string
+}
+
+// UsernamePasswordCredential : The details of a username and password credential.
+type UsernamePasswordCredential struct {
+	// The username of the user.
+	Username string
+
+	// The password of the user.
+	Password string
+}
+
+// DatabaseCredential : The details of a database credential.
+type DatabaseCredential struct {
+	// The username of the user.
+	Username string
+
+	// The password of the user.
+	Password string
+
+	// The database name.
+	DatabaseName string
+}
+
+// KerberosWindowsCredential : The details of a Kerberos Windows (WinRM) credential.
+type KerberosWindowsCredential struct {
+	// The username of the user.
+	Username string
+
+	// The password of the user.
+	Password string
+
+	// The WinRM authentication type.
+	AuthType string
+
+	// Whether WinRM uses SSL.
+	UseSSL bool
+
+	// The WinRM port.
+	Port int
+}
+
+// UserNamePemCredential : The details of a username and PEM key credential.
+type UserNamePemCredential struct {
+	// The username of the user.
+	Username string
+
+	// The user pem file name. Optional.
+	PemFileName string
+
+	// The base64 encoded form of pem.
+	PemData string
+}
+
+// CredentialType returns CreateCredentialOptionsTypeIBMCloudConst.
+func (*IBMCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeIBMCloudConst
+}
+
+// Validate checks that the API key is set.
+func (spec *IBMCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"ibm_api_key": spec.APIKey,
+	})
+}
+
+// NewDisplayFields returns the display fields of an IBM Cloud credential.
+func (spec *IBMCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		IBMAPIKey: core.StringPtr(spec.APIKey),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeAwsCloudConst.
+func (*AWSCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeAwsCloudConst
+}
+
+// Validate checks that the client ID, client secret and region are set.
+func (spec *AWSCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"aws_client_id":     spec.ClientID,
+		"aws_client_secret": spec.ClientSecret,
+		"aws_region":        spec.Region,
+	})
+}
+
+// NewDisplayFields returns the display fields of an AWS credential.
+func (spec *AWSCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		AwsClientID:     core.StringPtr(spec.ClientID),
+		AwsClientSecret: core.StringPtr(spec.ClientSecret),
+		AwsRegion:       core.StringPtr(spec.Region),
+		AwsArn:          optionalString(spec.Arn),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeAzureCloudConst.
+func (*AzureCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeAzureCloudConst
+}
+
+// Validate checks that the client ID, client secret and subscription ID are set.
+func (spec *AzureCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"azure_client_id":       spec.ClientID,
+		"azure_client_secret":   spec.ClientSecret,
+		"azure_subscription_id": spec.SubscriptionID,
+	})
+}
+
+// NewDisplayFields returns the display fields of an Azure credential.
+func (spec *AzureCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		AzureClientID:       core.StringPtr(spec.ClientID),
+		AzureClientSecret:   core.StringPtr(spec.ClientSecret),
+		AzureSubscriptionID: core.StringPtr(spec.SubscriptionID),
+		AzureResourceGroup:  optionalString(spec.ResourceGroup),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeMs365Const.
+func (*MS365Credential) CredentialType() string {
+	return CreateCredentialOptionsTypeMs365Const
+}
+
+// Validate checks that the client ID, client secret and tenant ID are set.
+func (spec *MS365Credential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"ms_365_client_id":     spec.ClientID,
+		"ms_365_client_secret": spec.ClientSecret,
+		"ms_365_tenant_id":     spec.TenantID,
+	})
+}
+
+// NewDisplayFields returns the display fields of a Microsoft 365 credential.
+func (spec *MS365Credential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Ms365ClientID:     core.StringPtr(spec.ClientID),
+		Ms365ClientSecret: core.StringPtr(spec.ClientSecret),
+		Ms365TenantID:     core.StringPtr(spec.TenantID),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeOpenstackCloudConst.
+func (*OpenStackCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeOpenstackCloudConst
+}
+
+// Validate checks that the user, auth url, project and domain names are set.
+func (spec *OpenStackCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"username":            spec.Username,
+		"password":            spec.Password,
+		"auth_url":            spec.AuthURL,
+		"project_name":        spec.ProjectName,
+		"user_domain_name":    spec.UserDomainName,
+		"project_domain_name": spec.ProjectDomainName,
+	})
+}
+
+// NewDisplayFields returns the display fields of an OpenStack credential.
+func (spec *OpenStackCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Username:          core.StringPtr(spec.Username),
+		Password:          core.StringPtr(spec.Password),
+		AuthURL:           core.StringPtr(spec.AuthURL),
+		ProjectName:       core.StringPtr(spec.ProjectName),
+		UserDomainName:    core.StringPtr(spec.UserDomainName),
+		ProjectDomainName: core.StringPtr(spec.ProjectDomainName),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeUsernamePasswordConst.
+func (*UsernamePasswordCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeUsernamePasswordConst
+}
+
+// Validate checks that the username and password are set.
+func (spec *UsernamePasswordCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"username": spec.Username,
+		"password": spec.Password,
+	})
+}
+
+// NewDisplayFields returns the display fields of a username and password credential.
+func (spec *UsernamePasswordCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Username: core.StringPtr(spec.Username),
+		Password: core.StringPtr(spec.Password),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeDatabaseConst.
+func (*DatabaseCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeDatabaseConst
+}
+
+// Validate checks that the username, password and database name are set.
+func (spec *DatabaseCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"username":      spec.Username,
+		"password":      spec.Password,
+		"database_name": spec.DatabaseName,
+	})
+}
+
+// NewDisplayFields returns the display fields of a database credential.
+func (spec *DatabaseCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Username:     core.StringPtr(spec.Username),
+		Password:     core.StringPtr(spec.Password),
+		DatabaseName: core.StringPtr(spec.DatabaseName),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeKerberosWindowsConst.
+func (*KerberosWindowsCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeKerberosWindowsConst
+}
+
+// Validate checks that the username, password and authentication type are set and that the port is valid.
+func (spec *KerberosWindowsCredential) Validate() error {
+	err := requireCredentialFields(spec.CredentialType(), map[string]string{
+		"username":       spec.Username,
+		"password":       spec.Password,
+		"winrm_authtype": spec.AuthType,
+	})
+	if err != nil {
+		return err
+	}
+	if spec.Port < 1 || spec.Port > 65535 {
+		return fmt.Errorf("%s credential has an invalid winrm_port: %d", spec.CredentialType(), spec.Port)
+	}
+	return nil
+}
+
+// NewDisplayFields returns the display fields of a Kerberos Windows credential.
+func (spec *KerberosWindowsCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Username:      core.StringPtr(spec.Username),
+		Password:      core.StringPtr(spec.Password),
+		WinrmAuthtype: core.StringPtr(spec.AuthType),
+		WinrmUsessl:   core.StringPtr(strconv.FormatBool(spec.UseSSL)),
+		WinrmPort:     core.StringPtr(strconv.Itoa(spec.Port)),
+	}
+}
+
+// CredentialType returns CreateCredentialOptionsTypeUserNamePemConst.
+func (*UserNamePemCredential) CredentialType() string {
+	return CreateCredentialOptionsTypeUserNamePemConst
+}
+
+// Validate checks that the username and pem data are set.
+func (spec *UserNamePemCredential) Validate() error {
+	return requireCredentialFields(spec.CredentialType(), map[string]string{
+		"username": spec.Username,
+		"pem_data": spec.PemData,
+	})
+}
+
+// NewDisplayFields returns the display fields of a username and PEM key credential.
+func (spec *UserNamePemCredential) NewDisplayFields() *NewCredentialDisplayFields {
+	return &NewCredentialDisplayFields{
+		Username:    core.StringPtr(spec.Username),
+		PemFileName: optionalString(spec.PemFileName),
+		PemData:     core.StringPtr(spec.PemData),
+	}
+}
+
+// credentialPurposes lists the values accepted by CreateCredentialOptions.Purpose.
+var credentialPurposes = []string{
+	CreateCredentialOptionsPurposeDiscoveryCollectionConst,
+	CreateCredentialOptionsPurposeDiscoveryCollectionRemediationConst,
+	CreateCredentialOptionsPurposeDiscoveryFactCollectionConst,
+	CreateCredentialOptionsPurposeDiscoveryFactCollectionRemediationConst,
+	CreateCredentialOptionsPurposeRemediationConst,
+}
+
+// ValidateCredentialPurpose returns an error when purpose is not one of the CreateCredentialOptionsPurpose*Const
+// values.
+func ValidateCredentialPurpose(purpose string) error {
+	for _, p := range credentialPurposes {
+		if p == purpose {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid credential purpose %q, expected one of: %s", purpose, strings.Join(credentialPurposes, ", "))
+}
+
+// NewCreateCredentialOptionsFromSpec : Instantiate CreateCredentialOptions from a typed credential spec.
+// The spec and purpose are validated so that incomplete credentials are rejected before a request is sent.
+// The returned options are enabled; use SetEnabled to change that.
+func (*PostureManagementV2) NewCreateCredentialOptionsFromSpec(name string, description string, purpose string, spec CredentialSpec) (*CreateCredentialOptions, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("spec cannot be nil")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+	if err := ValidateCredentialPurpose(purpose); err != nil {
+		return nil, err
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &CreateCredentialOptions{
+		Enabled:       core.BoolPtr(true),
+		Type:          core.StringPtr(spec.CredentialType()),
+		Name:          core.StringPtr(name),
+		Description:   core.StringPtr(description),
+		DisplayFields: spec.NewDisplayFields(),
+		Purpose:       core.StringPtr(purpose),
+	}, nil
+}
+
+// requireCredentialFields returns an error naming every field in fields whose value is empty.
+func requireCredentialFields(credentialType string, fields map[string]string) error {
+	var missing []string
+	for name, value := range fields {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("%s credential is missing required fields: %s", credentialType, strings.Join(missing, ", "))
+}
+
+// optionalString returns nil for an empty string so that the field is omitted from the request body.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return core.StringPtr(s)
+}
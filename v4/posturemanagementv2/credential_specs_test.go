@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 credential specs`, func() {
+	postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+		URL:           "http://posturemanagementv2modelgenerator.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Context(`NewCreateCredentialOptionsFromSpec`, func() {
+		It(`Builds options for an IBM credential`, func() {
+			options, err := postureManagementService.NewCreateCredentialOptionsFromSpec("ibm", "desc", posturemanagementv2.CreateCredentialOptionsPurposeDiscoveryFactCollectionRemediationConst, &posturemanagementv2.IBMCredential{
+				APIKey: "sample_api_key",
+			})
+			Expect(err).To(BeNil())
+			Expect(options.Type).To(Equal(core.StringPtr(posturemanagementv2.CreateCredentialOptionsTypeIBMCloudConst)))
+			Expect(options.Enabled).To(Equal(core.BoolPtr(true)))
+			Expect(options.DisplayFields.IBMAPIKey).To(Equal(core.StringPtr("sample_api_key")))
+			Expect(options.DisplayFields.AwsClientID).To(BeNil())
+		})
+		It(`Omits optional fields that are empty`, func() {
+			options, err := postureManagementService.NewCreateCredentialOptionsFromSpec("aws", "desc", posturemanagementv2.CreateCredentialOptionsPurposeDiscoveryCollectionConst, &posturemanagementv2.AWSCredential{
+				ClientID:     "id",
+				ClientSecret: "secret",
+				Region:       "us-east-1",
+			})
+			Expect(err).To(BeNil())
+			Expect(options.DisplayFields.AwsRegion).To(Equal(core.StringPtr("us-east-1")))
+			Expect(options.DisplayFields.AwsArn).To(BeNil())
+		})
+		It(`Formats WinRM settings as strings`, func() {
+			options, err := postureManagementService.NewCreateCredentialOptionsFromSpec("winrm", "desc", posturemanagementv2.CreateCredentialOptionsPurposeRemediationConst, &posturemanagementv2.KerberosWindowsCredential{
+				Username: "user",
+				Password: "pass",
+				AuthType: "kerberos",
+				UseSSL:   true,
+				Port:     5986,
+			})
+			Expect(err).To(BeNil())
+			Expect(options.DisplayFields.WinrmUsessl).To(Equal(core.StringPtr("true")))
+			Expect(options.DisplayFields.WinrmPort).To(Equal(core.StringPtr("5986")))
+		})
+		It(`Reports every missing required field`, func() {
+			options, err := postureManagementService.NewCreateCredentialOptionsFromSpec("azure", "desc", posturemanagementv2.CreateCredentialOptionsPurposeDiscoveryCollectionConst, &posturemanagementv2.AzureCredential{
+				ClientID: "id",
+			})
+			Expect(options).To(BeNil())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("azure_cloud credential is missing required fields: azure_client_secret, azure_subscription_id"))
+		})
+		It(`Rejects an invalid WinRM port`, func() {
+			_, err := postureManagementService.NewCreateCredentialOptionsFromSpec("winrm", "desc", posturemanagementv2.CreateCredentialOptionsPurposeRemediationConst, &posturemanagementv2.KerberosWindowsCredential{
+				Username: "user",
+				Password: "pass",
+				AuthType: "kerberos",
+			})
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Rejects an unknown purpose`, func() {
+			_, err := postureManagementService.NewCreateCredentialOptionsFromSpec("ibm", "desc", "bogus", &posturemanagementv2.IBMCredential{
+				APIKey: "key",
+			})
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Rejects a nil spec or empty name`, func() {
+			_, err := postureManagementService.NewCreateCredentialOptionsFromSpec("ibm", "desc", posturemanagementv2.CreateCredentialOptionsPurposeRemediationConst, nil)
+			Expect(err).ToNot(BeNil())
+			_, err = postureManagementService.NewCreateCredentialOptionsFromSpec("", "desc", posturemanagementv2.CreateCredentialOptionsPurposeRemediationConst, &posturemanagementv2.IBMCredential{
+				APIKey: "key",
+			})
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Validates the remaining credential types`, func() {
+			specs := []posturemanagementv2.CredentialSpec{
+				&posturemanagementv2.MS365Credential{ClientID: "id", ClientSecret: "secret", TenantID: "tenant"},
+				&posturemanagementv2.OpenStackCredential{Username: "u", Password: "p", AuthURL: "https://auth", ProjectName: "p", UserDomainName: "d", ProjectDomainName: "d"},
+				&posturemanagementv2.UsernamePasswordCredential{Username: "u", Password: "p"},
+				&posturemanagementv2.DatabaseCredential{Username: "u", Password: "p", DatabaseName: "db"},
+				&posturemanagementv2.UserNamePemCredential{Username: "u", PemData: "cGVt"},
+			}
+			for _, spec := range specs {
+				options, err := postureManagementService.NewCreateCredentialOptionsFromSpec("name", "desc", posturemanagementv2.CreateCredentialOptionsPurposeDiscoveryFactCollectionConst, spec)
+				Expect(err).To(BeNil())
+				Expect(*options.Type).To(Equal(spec.CredentialType()))
+			}
+		})
+	})
+})
@@ -0,0 +1,507 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// InventoryNodeKind : The kind of resource that an inventory node represents.
+type InventoryNodeKind string
+
+// Constants associated with InventoryNodeKind.
+const (
+	InventoryNodeKindCredentialConst InventoryNodeKind = "credential"
+	InventoryNodeKindCollectorConst  InventoryNodeKind = "collector"
+	InventoryNodeKindScopeConst      InventoryNodeKind = "scope"
+	InventoryNodeKindProfileConst    InventoryNodeKind = "profile"
+	InventoryNodeKindScanConst       InventoryNodeKind = "scan"
+)
+
+// Constants associated with InventoryEdge.Relation.
+const (
+	InventoryRelationUsesCredentialConst   = "uses_credential"
+	InventoryRelationUsesCollectorConst    = "uses_collector"
+	InventoryRelationScansScopeConst       = "scans_scope"
+	InventoryRelationValidatesProfileConst = "validates_profile"
+	InventoryRelationBasedOnConst          = "based_on"
+)
+
+// InventoryNode : A posture management resource.
+type InventoryNode struct {
+	// The kind of resource.
+	Kind InventoryNodeKind `json:"kind"`
+
+	// The ID of the resource.
+	ID string `json:"id"`
+
+	// The name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
+// Key returns the unique key of the node within a graph.
+func (node InventoryNode) Key() string {
+	return string(node.Kind) + ":" + node.ID
+}
+
+// InventoryEdge : A dependency between two resources. The From resource depends on the To resource.
+type InventoryEdge struct {
+	// The key of the dependent resource.
+	From string `json:"from"`
+
+	// The key of the resource that is depended on.
+	To string `json:"to"`
+
+	// How From depends on To.
+	Relation string `json:"relation"`
+}
+
+// InventoryGraph : The dependencies between credentials, collectors, scopes, profiles and scans.
+type InventoryGraph struct {
+	nodes map[string]InventoryNode
+	edges map[InventoryEdge]bool
+}
+
+// NewInventoryGraph : Instantiate an empty InventoryGraph
+func NewInventoryGraph() *InventoryGraph {
+	return &InventoryGraph{
+		nodes: make(map[string]InventoryNode),
+		edges: make(map[InventoryEdge]bool),
+	}
+}
+
+// AddNode adds a node, keeping the existing name when the new node has none.
+func (graph *InventoryGraph) AddNode(node InventoryNode) {
+	if existing, ok := graph.nodes[node.Key()]; ok && node.Name == "" {
+		node.Name = existing.Name
+	}
+	graph.nodes[node.Key()] = node
+}
+
+// AddEdge records that from depends on to. Nodes that are not in the graph yet are added without a name.
+func (graph *InventoryGraph) AddEdge(from InventoryNode, to InventoryNode, relation string) {
+	if from.ID == "" || to.ID == "" {
+		return
+	}
+	if _, ok := graph.nodes[from.Key()]; !ok {
+		graph.AddNode(from)
+	}
+	if _, ok := graph.nodes[to.Key()]; !ok {
+		graph.AddNode(to)
+	}
+	graph.edges[InventoryEdge{From: from.Key(), To: to.Key(), Relation: relation}] = true
+}
+
+// Node returns the node with the specified kind and ID.
+func (graph *InventoryGraph) Node(kind InventoryNodeKind, id string) (InventoryNode, bool) {
+	node, ok := graph.nodes[InventoryNode{Kind: kind, ID: id}.Key()]
+	return node, ok
+}
+
+// Nodes returns every node, sorted by key.
+func (graph *InventoryGraph) Nodes() []InventoryNode {
+	nodes := make([]InventoryNode, 0, len(graph.nodes))
+	for _, node := range graph.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Key() < nodes[j].Key()
+	})
+	return nodes
+}
+
+// Edges returns every edge, sorted by from, to and relation.
+func (graph *InventoryGraph) Edges() []InventoryEdge {
+	edges := make([]InventoryEdge, 0, len(graph.edges))
+	for edge := range graph.edges {
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+		return edges[i].Relation < edges[j].Relation
+	})
+	return edges
+}
+
+// Dependents returns the nodes that directly depend on the specified node, sorted by key.
+func (graph *InventoryGraph) Dependents(kind InventoryNodeKind, id string) []InventoryNode {
+	key := InventoryNode{Kind: kind, ID: id}.Key()
+	seen := make(map[string]bool)
+	var dependents []InventoryNode
+	for _, edge := range graph.Edges() {
+		if edge.To == key && !seen[edge.From] {
+			seen[edge.From] = true
+			dependents = append(dependents, graph.nodes[edge.From])
+		}
+	}
+	return dependents
+}
+
+// RemoveNode removes a node and every edge that touches it.
+func (graph *InventoryGraph) RemoveNode(kind InventoryNodeKind, id string) {
+	key := InventoryNode{Kind: kind, ID: id}.Key()
+	delete(graph.nodes, key)
+	for edge := range graph.edges {
+		if edge.From == key || edge.To == key {
+			delete(graph.edges, edge)
+		}
+	}
+}
+
+// MarshalJSON returns the graph as an object with sorted "nodes" and "edges" arrays.
+func (graph *InventoryGraph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Nodes []InventoryNode `json:"nodes"`
+		Edges []InventoryEdge `json:"edges"`
+	}{
+		Nodes: graph.Nodes(),
+		Edges: graph.Edges(),
+	})
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT language.
+func (graph *InventoryGraph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph posture_inventory {\n")
+	for _, node := range graph.Nodes() {
+		label := string(node.Kind) + "\n" + node.ID
+		if node.Name != "" {
+			label = string(node.Kind) + "\n" + node.Name
+		}
+		fmt.Fprintf(&b, "  %q [label=%q];\n", node.Key(), label)
+	}
+	for _, edge := range graph.Edges() {
+		fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, edge.Relation)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// BuildInventoryGraphOptions : The BuildInventoryGraph options.
+type BuildInventoryGraphOptions struct {
+	// Whether to include profiles and the base profiles that they are derived from.
+	IncludeProfiles bool
+
+	// Whether to include the latest scans and the scopes and profiles that they reference.
+	IncludeScans bool
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// BuildInventoryGraph : Build the dependency graph of posture management resources
+// List credentials, collectors and scopes, and optionally profiles and the latest scans, and link every scope to the
+// credential and collectors that it uses.
+func (postureManagement *PostureManagementV2) BuildInventoryGraph(buildInventoryGraphOptions *BuildInventoryGraphOptions) (result *InventoryGraph, err error) {
+	return postureManagement.BuildInventoryGraphWithContext(context.Background(), buildInventoryGraphOptions)
+}
+
+// BuildInventoryGraphWithContext is an alternate form of the BuildInventoryGraph method which supports a Context parameter
+func (postureManagement *PostureManagementV2) BuildInventoryGraphWithContext(ctx context.Context, buildInventoryGraphOptions *BuildInventoryGraphOptions) (result *InventoryGraph, err error) {
+	options := buildInventoryGraphOptions
+	if options == nil {
+		options = new(BuildInventoryGraphOptions)
+	}
+	graph := NewInventoryGraph()
+
+	credentials, err := postureManagement.listAllCredentials(ctx, options.AccountID)
+	if err != nil {
+		return
+	}
+	for _, credential := range credentials {
+		graph.AddNode(InventoryNode{Kind: InventoryNodeKindCredentialConst, ID: core.StringNilMapper(credential.ID), Name: core.StringNilMapper(credential.Name)})
+	}
+
+	collectors, err := postureManagement.listAllCollectors(ctx, options.AccountID)
+	if err != nil {
+		return
+	}
+	for _, collector := range collectors {
+		graph.AddNode(collectorNode(&collector))
+	}
+
+	scopes, err := postureManagement.listAllScopes(ctx, options.AccountID)
+	if err != nil {
+		return
+	}
+	for _, scope := range scopes {
+		scopeNode := InventoryNode{Kind: InventoryNodeKindScopeConst, ID: core.StringNilMapper(scope.ID), Name: core.StringNilMapper(scope.Name)}
+		graph.AddNode(scopeNode)
+		for _, collector := range scope.Collectors {
+			graph.AddEdge(scopeNode, collectorNode(&collector), InventoryRelationUsesCollectorConst)
+		}
+
+		getScopeDetailsCredentialsOptions := postureManagement.NewGetScopeDetailsCredentialsOptions(scopeNode.ID)
+		getScopeDetailsCredentialsOptions.AccountID = options.AccountID
+		var scopeCredential *ScopeCredential
+		scopeCredential, _, err = postureManagement.GetScopeDetailsCredentialsWithContext(ctx, getScopeDetailsCredentialsOptions)
+		if err != nil {
+			return
+		}
+		if scopeCredential != nil {
+			graph.AddEdge(scopeNode, InventoryNode{Kind: InventoryNodeKindCredentialConst, ID: core.StringNilMapper(scopeCredential.CredentialID)}, InventoryRelationUsesCredentialConst)
+		}
+
+		getScopeDetailsCollectorOptions := postureManagement.NewGetScopeDetailsCollectorOptions(scopeNode.ID)
+		getScopeDetailsCollectorOptions.AccountID = options.AccountID
+		var scopeCollector *ScopeCollector
+		scopeCollector, _, err = postureManagement.GetScopeDetailsCollectorWithContext(ctx, getScopeDetailsCollectorOptions)
+		if err != nil {
+			return
+		}
+		if scopeCollector != nil {
+			for _, collectorID := range scopeCollector.CollectorIds {
+				graph.AddEdge(scopeNode, InventoryNode{Kind: InventoryNodeKindCollectorConst, ID: collectorID}, InventoryRelationUsesCollectorConst)
+			}
+		}
+	}
+
+	if options.IncludeProfiles {
+		var profiles []Profile
+		profiles, err = postureManagement.listAllProfiles(ctx, options.AccountID)
+		if err != nil {
+			return
+		}
+		for _, profile := range profiles {
+			profileNode := InventoryNode{Kind: InventoryNodeKindProfileConst, ID: core.StringNilMapper(profile.ID), Name: core.StringNilMapper(profile.Name)}
+			graph.AddNode(profileNode)
+			if base := core.StringNilMapper(profile.BaseProfile); base != "" && base != profileNode.ID {
+				graph.AddEdge(profileNode, InventoryNode{Kind: InventoryNodeKindProfileConst, ID: base}, InventoryRelationBasedOnConst)
+			}
+		}
+	}
+
+	if options.IncludeScans {
+		var scans []ScanItem
+		scans, err = postureManagement.listAllLatestScans(ctx, options.AccountID)
+		if err != nil {
+			return
+		}
+		for _, scan := range scans {
+			scanNode := InventoryNode{Kind: InventoryNodeKindScanConst, ID: core.StringNilMapper(scan.ScanID), Name: core.StringNilMapper(scan.ScanName)}
+			graph.AddNode(scanNode)
+			graph.AddEdge(scanNode, InventoryNode{Kind: InventoryNodeKindScopeConst, ID: core.StringNilMapper(scan.ScopeID), Name: core.StringNilMapper(scan.ScopeName)}, InventoryRelationScansScopeConst)
+			for _, profile := range scan.Profiles {
+				graph.AddEdge(scanNode, InventoryNode{Kind: InventoryNodeKindProfileConst, ID: core.StringNilMapper(profile.ID), Name: core.StringNilMapper(profile.Name)}, InventoryRelationValidatesProfileConst)
+			}
+			graph.AddEdge(scanNode, InventoryNode{Kind: InventoryNodeKindProfileConst, ID: core.StringNilMapper(scan.GroupProfileID), Name: core.StringNilMapper(scan.GroupProfileName)}, InventoryRelationValidatesProfileConst)
+		}
+	}
+
+	result = graph
+	return
+}
+
+func collectorNode(collector *Collector) InventoryNode {
+	name := core.StringNilMapper(collector.DisplayName)
+	if name == "" {
+		name = core.StringNilMapper(collector.Name)
+	}
+	return InventoryNode{Kind: InventoryNodeKindCollectorConst, ID: core.StringNilMapper(collector.ID), Name: name}
+}
+
+// DependentsExistError is returned by the SafeDelete methods when a resource is still in use and Cascade is not set.
+type DependentsExistError struct {
+	// The resource that was not deleted.
+	Node InventoryNode
+
+	// The resources that depend on it.
+	Dependents []InventoryNode
+}
+
+func (e *DependentsExistError) Error() string {
+	keys := make([]string, len(e.Dependents))
+	for i, dependent := range e.Dependents {
+		keys[i] = dependent.Key()
+	}
+	return fmt.Sprintf("%s is still used by %s", e.Node.Key(), strings.Join(keys, ", "))
+}
+
+// SafeDeleteOptions : The options of the SafeDelete methods.
+type SafeDeleteOptions struct {
+	// The ID of the resource to delete.
+	ID *string `validate:"required,ne="`
+
+	// When true, dependent scopes and profiles are deleted first. When false, the delete is refused if they exist.
+	Cascade *bool
+
+	// A graph to check dependents against. When nil, a graph is built. The graph is updated as resources are
+	// deleted.
+	Graph *InventoryGraph
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewSafeDeleteOptions : Instantiate SafeDeleteOptions
+func (*PostureManagementV2) NewSafeDeleteOptions(id string) *SafeDeleteOptions {
+	return &SafeDeleteOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetCascade : Allow user to set Cascade
+func (_options *SafeDeleteOptions) SetCascade(cascade bool) *SafeDeleteOptions {
+	_options.Cascade = core.BoolPtr(cascade)
+	return _options
+}
+
+// SetGraph : Allow user to set Graph
+func (_options *SafeDeleteOptions) SetGraph(graph *InventoryGraph) *SafeDeleteOptions {
+	_options.Graph = graph
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *SafeDeleteOptions) SetAccountID(accountID string) *SafeDeleteOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// SafeDeleteCredential : Delete a credential that no scope uses
+// Returns a *DependentsExistError when scopes still use the credential, unless Cascade is set. The deleted resources
+// are returned in the order that they were deleted.
+func (postureManagement *PostureManagementV2) SafeDeleteCredential(safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.SafeDeleteCredentialWithContext(context.Background(), safeDeleteOptions)
+}
+
+// SafeDeleteCredentialWithContext is an alternate form of the SafeDeleteCredential method which supports a Context parameter
+func (postureManagement *PostureManagementV2) SafeDeleteCredentialWithContext(ctx context.Context, safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.safeDelete(ctx, InventoryNodeKindCredentialConst, safeDeleteOptions)
+}
+
+// SafeDeleteCollector : Delete a collector that no scope uses
+// Returns a *DependentsExistError when scopes still use the collector, unless Cascade is set. The deleted resources
+// are returned in the order that they were deleted.
+func (postureManagement *PostureManagementV2) SafeDeleteCollector(safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.SafeDeleteCollectorWithContext(context.Background(), safeDeleteOptions)
+}
+
+// SafeDeleteCollectorWithContext is an alternate form of the SafeDeleteCollector method which supports a Context parameter
+func (postureManagement *PostureManagementV2) SafeDeleteCollectorWithContext(ctx context.Context, safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.safeDelete(ctx, InventoryNodeKindCollectorConst, safeDeleteOptions)
+}
+
+// SafeDeleteScope : Delete a scope
+// Scans of the scope are history and do not prevent the scope from being deleted.
+func (postureManagement *PostureManagementV2) SafeDeleteScope(safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.SafeDeleteScopeWithContext(context.Background(), safeDeleteOptions)
+}
+
+// SafeDeleteScopeWithContext is an alternate form of the SafeDeleteScope method which supports a Context parameter
+func (postureManagement *PostureManagementV2) SafeDeleteScopeWithContext(ctx context.Context, safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.safeDelete(ctx, InventoryNodeKindScopeConst, safeDeleteOptions)
+}
+
+// SafeDeleteProfile : Delete a profile that no other profile is based on
+// Returns a *DependentsExistError when profiles are still based on the profile, unless Cascade is set.
+func (postureManagement *PostureManagementV2) SafeDeleteProfile(safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.SafeDeleteProfileWithContext(context.Background(), safeDeleteOptions)
+}
+
+// SafeDeleteProfileWithContext is an alternate form of the SafeDeleteProfile method which supports a Context parameter
+func (postureManagement *PostureManagementV2) SafeDeleteProfileWithContext(ctx context.Context, safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	return postureManagement.safeDelete(ctx, InventoryNodeKindProfileConst, safeDeleteOptions)
+}
+
+func (postureManagement *PostureManagementV2) safeDelete(ctx context.Context, kind InventoryNodeKind, safeDeleteOptions *SafeDeleteOptions) (result []InventoryNode, err error) {
+	err = core.ValidateNotNil(safeDeleteOptions, "safeDeleteOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(safeDeleteOptions, "safeDeleteOptions")
+	if err != nil {
+		return
+	}
+	graph := safeDeleteOptions.Graph
+	if graph == nil {
+		graph, err = postureManagement.BuildInventoryGraphWithContext(ctx, &BuildInventoryGraphOptions{
+			IncludeProfiles: kind == InventoryNodeKindProfileConst,
+			AccountID:       safeDeleteOptions.AccountID,
+		})
+		if err != nil {
+			return
+		}
+	}
+	cascade := safeDeleteOptions.Cascade != nil && *safeDeleteOptions.Cascade
+	err = postureManagement.deleteNode(ctx, graph, InventoryNode{Kind: kind, ID: *safeDeleteOptions.ID}, cascade, safeDeleteOptions.AccountID, &result)
+	return
+}
+
+// deleteNode deletes the dependents of a node when cascade is set, then the node itself, appending every deleted
+// node to deleted.
+func (postureManagement *PostureManagementV2) deleteNode(ctx context.Context, graph *InventoryGraph, node InventoryNode, cascade bool, accountID *string, deleted *[]InventoryNode) error {
+	if known, ok := graph.Node(node.Kind, node.ID); ok {
+		node = known
+	}
+	var blocking []InventoryNode
+	for _, dependent := range graph.Dependents(node.Kind, node.ID) {
+		// Scans cannot be deleted and do not hold on to the resources that they reference.
+		if dependent.Kind != InventoryNodeKindScanConst {
+			blocking = append(blocking, dependent)
+		}
+	}
+	if len(blocking) > 0 && !cascade {
+		return &DependentsExistError{Node: node, Dependents: blocking}
+	}
+	for _, dependent := range blocking {
+		if err := postureManagement.deleteNode(ctx, graph, dependent, cascade, accountID, deleted); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	switch node.Kind {
+	case InventoryNodeKindCredentialConst:
+		deleteCredentialOptions := postureManagement.NewDeleteCredentialOptions(node.ID)
+		deleteCredentialOptions.AccountID = accountID
+		_, err = postureManagement.DeleteCredentialWithContext(ctx, deleteCredentialOptions)
+	case InventoryNodeKindCollectorConst:
+		deleteCollectorOptions := postureManagement.NewDeleteCollectorOptions(node.ID)
+		deleteCollectorOptions.AccountID = accountID
+		_, err = postureManagement.DeleteCollectorWithContext(ctx, deleteCollectorOptions)
+	case InventoryNodeKindScopeConst:
+		deleteScopeOptions := postureManagement.NewDeleteScopeOptions(node.ID)
+		deleteScopeOptions.AccountID = accountID
+		_, err = postureManagement.DeleteScopeWithContext(ctx, deleteScopeOptions)
+	case InventoryNodeKindProfileConst:
+		deleteProfileOptions := postureManagement.NewDeleteProfileOptions(node.ID)
+		deleteProfileOptions.AccountID = accountID
+		_, err = postureManagement.DeleteProfileWithContext(ctx, deleteProfileOptions)
+	default:
+		err = fmt.Errorf("%s cannot be deleted", node.Key())
+	}
+	if err != nil {
+		return err
+	}
+	graph.RemoveNode(node.Kind, node.ID)
+	*deleted = append(*deleted, node)
+	return nil
+}
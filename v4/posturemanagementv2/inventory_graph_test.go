@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 inventory graph`, func() {
+	var testServer *fixtureserver.Server
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /posture/v2/credentials":                    `{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "credentials": [{"id": "cred-1", "name": "used"}, {"id": "cred-2", "name": "unused"}]}`,
+			"GET /posture/v2/collectors":                     `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "collectors": [{"id": "col-1", "name": "collector", "display_name": "My collector"}]}`,
+			"GET /posture/v2/scopes":                         `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "scopes": [{"id": "scope-1", "name": "scope", "collectors": []}]}`,
+			"GET /posture/v2/scopes/scope-1/credentials":     `{"credential_id": "cred-1"}`,
+			"GET /posture/v2/scopes/scope-1/collectors":      `{"collector_ids": ["col-1"]}`,
+			"GET /posture/v2/profiles":                       `{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "profiles": [{"id": "p-base", "name": "base", "base_profile": ""}, {"id": "p-custom", "name": "custom", "base_profile": "p-base"}]}`,
+			"GET /posture/v2/scans/validations/latest_scans": `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "latest_scans": [{"scan_id": "scan-1", "scan_name": "scan", "scope_id": "scope-1", "profiles": [{"id": "p-custom", "name": "custom", "type": "custom"}]}]}`,
+			"DELETE /posture/v2/credentials/cred-1":          ``,
+			"DELETE /posture/v2/credentials/cred-2":          ``,
+			"DELETE /posture/v2/scopes/scope-1":              ``,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Links scopes, credentials, collectors, profiles and scans`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		graph, err := postureManagementService.BuildInventoryGraph(&posturemanagementv2.BuildInventoryGraphOptions{
+			IncludeProfiles: true,
+			IncludeScans:    true,
+		})
+		Expect(err).To(BeNil())
+		Expect(graph.Dependents(posturemanagementv2.InventoryNodeKindCredentialConst, "cred-1")).To(ConsistOf(posturemanagementv2.InventoryNode{Kind: "scope", ID: "scope-1", Name: "scope"}))
+		Expect(graph.Dependents(posturemanagementv2.InventoryNodeKindCredentialConst, "cred-2")).To(BeEmpty())
+		Expect(graph.Dependents(posturemanagementv2.InventoryNodeKindCollectorConst, "col-1")).To(HaveLen(1))
+		Expect(graph.Dependents(posturemanagementv2.InventoryNodeKindProfileConst, "p-base")).To(ConsistOf(posturemanagementv2.InventoryNode{Kind: "profile", ID: "p-custom", Name: "custom"}))
+		Expect(graph.Dependents(posturemanagementv2.InventoryNodeKindScopeConst, "scope-1")).To(ConsistOf(posturemanagementv2.InventoryNode{Kind: "scan", ID: "scan-1", Name: "scan"}))
+
+		data, err := json.Marshal(graph)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`{"from":"scope:scope-1","to":"credential:cred-1","relation":"uses_credential"}`))
+
+		var dot bytes.Buffer
+		Expect(graph.WriteDOT(&dot)).To(Succeed())
+		Expect(dot.String()).To(HavePrefix("digraph posture_inventory {\n"))
+		Expect(dot.String()).To(ContainSubstring(`"scope:scope-1" -> "collector:col-1" [label="uses_collector"];`))
+	})
+	It(`Refuses to delete a credential that a scope uses`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		deleted, err := postureManagementService.SafeDeleteCredential(postureManagementService.NewSafeDeleteOptions("cred-1"))
+		Expect(deleted).To(BeEmpty())
+		var dependentsErr *posturemanagementv2.DependentsExistError
+		Expect(errors.As(err, &dependentsErr)).To(BeTrue())
+		Expect(dependentsErr.Dependents).To(HaveLen(1))
+		Expect(err.Error()).To(Equal("credential:cred-1 is still used by scope:scope-1"))
+		Expect(testServer.Requests()).ToNot(ContainElement("DELETE /posture/v2/credentials/cred-1"))
+	})
+	It(`Deletes an unused credential`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		deleted, err := postureManagementService.SafeDeleteCredential(postureManagementService.NewSafeDeleteOptions("cred-2"))
+		Expect(err).To(BeNil())
+		Expect(deleted).To(Equal([]posturemanagementv2.InventoryNode{{Kind: "credential", ID: "cred-2", Name: "unused"}}))
+	})
+	It(`Cascades to dependent scopes when asked`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		deleted, err := postureManagementService.SafeDeleteCredential(postureManagementService.NewSafeDeleteOptions("cred-1").SetCascade(true))
+		Expect(err).To(BeNil())
+		Expect(deleted).To(HaveLen(2))
+		Expect(deleted[0].Key()).To(Equal("scope:scope-1"))
+		Expect(deleted[1].Key()).To(Equal("credential:cred-1"))
+	})
+})
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+)
+
+// The helpers in this file follow the next page offsets of the paginated list operations and return every item.
+
+func (postureManagement *PostureManagementV2) listAllCredentials(ctx context.Context, accountID *string) (credentials []Credential, err error) {
+	listCredentialsOptions := postureManagement.NewListCredentialsOptions()
+	listCredentialsOptions.AccountID = accountID
+	for {
+		var result *CredentialList
+		result, _, err = postureManagement.ListCredentialsWithContext(ctx, listCredentialsOptions)
+		if err != nil || result == nil {
+			return
+		}
+		credentials = append(credentials, result.Credentials...)
+		var next *int64
+		next, err = result.GetNextOffset()
+		if err != nil || next == nil {
+			return
+		}
+		listCredentialsOptions.SetOffset(*next)
+	}
+}
+
+func (postureManagement *PostureManagementV2) listAllCollectors(ctx context.Context, accountID *string) (collectors []Collector, err error) {
+	listCollectorsOptions := postureManagement.NewListCollectorsOptions()
+	listCollectorsOptions.AccountID = accountID
+	result, _, err := postureManagement.ListCollectorsWithContext(ctx, listCollectorsOptions)
+	if err != nil || result == nil {
+		return
+	}
+	collectors = result.Collectors
+	return
+}
+
+func (postureManagement *PostureManagementV2) listAllScopes(ctx context.Context, accountID *string) (scopes []ScopeItem, err error) {
+	listScopesOptions := postureManagement.NewListScopesOptions()
+	listScopesOptions.AccountID = accountID
+	result, _, err := postureManagement.ListScopesWithContext(ctx, listScopesOptions)
+	if err != nil || result == nil {
+		return
+	}
+	scopes = result.Scopes
+	return
+}
+
+func (postureManagement *PostureManagementV2) listAllLatestScans(ctx context.Context, accountID *string) (scans []ScanItem, err error) {
+	listLatestScansOptions := postureManagement.NewListLatestScansOptions()
+	listLatestScansOptions.AccountID = accountID
+	for {
+		var result *ScanList
+		result, _, err = postureManagement.ListLatestScansWithContext(ctx, listLatestScansOptions)
+		if err != nil || result == nil {
+			return
+		}
+		scans = append(scans, result.LatestScans...)
+		var next *int64
+		next, err = result.GetNextOffset()
+		if err != nil || next == nil {
+			return
+		}
+		listLatestScansOptions.SetOffset(*next)
+	}
+}
+
+func (postureManagement *PostureManagementV2) listAllScanSummaries(ctx context.Context, reportSettingID string, accountID *string) (summaries []SummaryItem, err error) {
+	scanSummariesOptions := postureManagement.NewScanSummariesOptions(reportSettingID)
+	scanSummariesOptions.AccountID = accountID
+	for {
+		var result *SummaryList
+		result, _, err = postureManagement.ScanSummariesWithContext(ctx, scanSummariesOptions)
+		if err != nil || result == nil {
+			return
+		}
+		summaries = append(summaries, result.Summaries...)
+		var next *int64
+		next, err = result.GetNextOffset()
+		if err != nil || next == nil {
+			return
+		}
+		scanSummariesOptions.SetOffset(*next)
+	}
+}
+
+func (postureManagement *PostureManagementV2) listAllProfiles(ctx context.Context, accountID *string) (profiles []Profile, err error) {
+	listProfilesOptions := postureManagement.NewListProfilesOptions()
+	listProfilesOptions.AccountID = accountID
+	for {
+		var result *ProfileList
+		result, _, err = postureManagement.ListProfilesWithContext(ctx, listProfilesOptions)
+		if err != nil || result == nil {
+			return
+		}
+		profiles = append(profiles, result.Profiles...)
+		var next *int64
+		next, err = result.GetNextOffset()
+		if err != nil || next == nil {
+			return
+		}
+		listProfilesOptions.SetOffset(*next)
+	}
+}
+
+func (postureManagement *PostureManagementV2) listAllProfileControls(ctx context.Context, profileID string, accountID *string) (controls []ControlItem, err error) {
+	getProfileControlsOptions := postureManagement.NewGetProfileControlsOptions(profileID)
+	getProfileControlsOptions.AccountID = accountID
+	for {
+		var result *ControlList
+		result, _, err = postureManagement.GetProfileControlsWithContext(ctx, getProfileControlsOptions)
+		if err != nil || result == nil {
+			return
+		}
+		controls = append(controls, result.Controls...)
+		var next *int64
+		next, err = result.GetNextOffset()
+		if err != nil || next == nil {
+			return
+		}
+		getProfileControlsOptions.SetOffset(*next)
+	}
+}
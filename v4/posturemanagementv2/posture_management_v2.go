@@ -471,6 +471,7 @@ func (postureManagement *PostureManagementV2) UpdateCredentialWithContext(ctx co
 	if updateCredentialOptions.Purpose != nil {
 		body["purpose"] = updateCredentialOptions.Purpose
 	}
+	common.AddAdditionalProperties(body, updateCredentialOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1161,6 +1162,7 @@ func (postureManagement *PostureManagementV2) UpdateProfilesWithContext(ctx cont
 	if updateProfilesOptions.ControlIds != nil {
 		body["control_ids"] = updateProfilesOptions.ControlIds
 	}
+	common.AddAdditionalProperties(body, updateProfilesOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1659,6 +1661,7 @@ func (postureManagement *PostureManagementV2) UpdateScopeDetailsWithContext(ctx
 	if updateScopeDetailsOptions.Description != nil {
 		body["description"] = updateScopeDetailsOptions.Description
 	}
+	common.AddAdditionalProperties(body, updateScopeDetailsOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -1928,6 +1931,7 @@ func (postureManagement *PostureManagementV2) ReplaceScopeDetailsCredentialsWith
 	if replaceScopeDetailsCredentialsOptions.CredentialAttribute != nil {
 		body["credential_attribute"] = replaceScopeDetailsCredentialsOptions.CredentialAttribute
 	}
+	common.AddAdditionalProperties(body, replaceScopeDetailsCredentialsOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -2072,6 +2076,7 @@ func (postureManagement *PostureManagementV2) ReplaceScopeDetailsCollectorWithCo
 	if replaceScopeDetailsCollectorOptions.CollectorIds != nil {
 		body["collector_ids"] = replaceScopeDetailsCollectorOptions.CollectorIds
 	}
+	common.AddAdditionalProperties(body, replaceScopeDetailsCollectorOptions.AdditionalProperties)
 	_, err = builder.SetBodyContentJSON(body)
 	if err != nil {
 		return
@@ -2509,6 +2514,156 @@ type ApplicabilityCriteria struct {
 
 	// The resource that is scanned as part of your scope.
 	ResourceDescription map[string]string `json:"resource_description,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ApplicabilityCriteria
+func (o *ApplicabilityCriteria) MarshalJSON() (buffer []byte, err error) {
+	type model ApplicabilityCriteria
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEnvironment returns Environment, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironment() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Environment
+}
+
+// GetResource returns Resource, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResource() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Resource
+}
+
+// GetEnvironmentCategory returns EnvironmentCategory, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategory
+}
+
+// GetResourceCategory returns ResourceCategory, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategory
+}
+
+// GetResourceType returns ResourceType, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceType() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceType
+}
+
+// GetSoftwareDetails returns SoftwareDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetSoftwareDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.SoftwareDetails
+}
+
+// GetOsDetails returns OsDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetOsDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.OsDetails
+}
+
+// GetAdditionalDetails returns AdditionalDetails, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetAdditionalDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalDetails
+}
+
+// GetEnvironmentCategoryDescription returns EnvironmentCategoryDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategoryDescription
+}
+
+// GetEnvironmentDescription returns EnvironmentDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetEnvironmentDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentDescription
+}
+
+// GetResourceCategoryDescription returns ResourceCategoryDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategoryDescription
+}
+
+// GetResourceTypeDescription returns ResourceTypeDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceTypeDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTypeDescription
+}
+
+// GetResourceDescription returns ResourceDescription, or nil when the ApplicabilityCriteria is nil
+func (o *ApplicabilityCriteria) GetResourceDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceDescription
 }
 
 // UnmarshalApplicabilityCriteria unmarshals an instance of ApplicabilityCriteria from the specified map of raw messages.
@@ -2566,6 +2721,10 @@ func UnmarshalApplicabilityCriteria(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "environment", "resource", "environment_category", "resource_category", "resource_type", "software_details", "os_details", "additional_details", "environment_category_description", "environment_description", "resource_category_description", "resource_type_description", "resource_description")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2580,6 +2739,76 @@ type BasicResult struct {
 
 	// Id of created Profile.
 	ProfileID *string `json:"profile_id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of BasicResult
+func (o *BasicResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of BasicResult
+func (o *BasicResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of BasicResult
+func (o *BasicResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of BasicResult
+func (o *BasicResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of BasicResult
+func (o *BasicResult) MarshalJSON() (buffer []byte, err error) {
+	type model BasicResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetMessage returns the value of Message, or the zero value when Message or the BasicResult is nil
+func (o *BasicResult) GetMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Message)
+}
+
+// GetResult returns the value of Result, or the zero value when Result or the BasicResult is nil
+func (o *BasicResult) GetResult() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Result)
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the BasicResult is nil
+func (o *BasicResult) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
 }
 
 // UnmarshalBasicResult unmarshals an instance of BasicResult from the specified map of raw messages.
@@ -2597,6 +2826,10 @@ func UnmarshalBasicResult(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "message", "result", "profile_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2709,6 +2942,9 @@ type Collector struct {
 
 	// An indication of whether the collector has a UBI image.
 	IsUbiImage *bool `json:"is_ubi_image,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Collector.Status property.
@@ -2744,6 +2980,305 @@ const (
 	CollectorManagedByIBMConst      = "ibm"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Collector
+func (o *Collector) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Collector
+func (o *Collector) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Collector
+func (o *Collector) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Collector
+func (o *Collector) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Collector
+func (o *Collector) MarshalJSON() (buffer []byte, err error) {
+	type model Collector
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Collector is nil
+func (o *Collector) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value when DisplayName or the Collector is nil
+func (o *Collector) GetDisplayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DisplayName)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Collector is nil
+func (o *Collector) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetPublicKey returns the value of PublicKey, or the zero value when PublicKey or the Collector is nil
+func (o *Collector) GetPublicKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PublicKey)
+}
+
+// GetLastHeartbeat returns the value of LastHeartbeat, or the zero value when LastHeartbeat or the Collector is nil
+func (o *Collector) GetLastHeartbeat() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.LastHeartbeat)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Collector is nil
+func (o *Collector) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetCollectorVersion returns the value of CollectorVersion, or the zero value when CollectorVersion or the Collector is nil
+func (o *Collector) GetCollectorVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CollectorVersion)
+}
+
+// GetImageVersion returns the value of ImageVersion, or the zero value when ImageVersion or the Collector is nil
+func (o *Collector) GetImageVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ImageVersion)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Collector is nil
+func (o *Collector) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Collector is nil
+func (o *Collector) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Collector is nil
+func (o *Collector) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetUpdatedBy returns the value of UpdatedBy, or the zero value when UpdatedBy or the Collector is nil
+func (o *Collector) GetUpdatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedBy)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the Collector is nil
+func (o *Collector) GetUpdatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the Collector is nil
+func (o *Collector) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
+// GetRegistrationCode returns the value of RegistrationCode, or the zero value when RegistrationCode or the Collector is nil
+func (o *Collector) GetRegistrationCode() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RegistrationCode)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Collector is nil
+func (o *Collector) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetCredentialPublicKey returns the value of CredentialPublicKey, or the zero value when CredentialPublicKey or the Collector is nil
+func (o *Collector) GetCredentialPublicKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialPublicKey)
+}
+
+// GetFailureCount returns the value of FailureCount, or the zero value when FailureCount or the Collector is nil
+func (o *Collector) GetFailureCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.FailureCount)
+}
+
+// GetApprovedLocalGatewayIP returns the value of ApprovedLocalGatewayIP, or the zero value when ApprovedLocalGatewayIP or the Collector is nil
+func (o *Collector) GetApprovedLocalGatewayIP() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ApprovedLocalGatewayIP)
+}
+
+// GetApprovedInternetGatewayIP returns the value of ApprovedInternetGatewayIP, or the zero value when ApprovedInternetGatewayIP or the Collector is nil
+func (o *Collector) GetApprovedInternetGatewayIP() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ApprovedInternetGatewayIP)
+}
+
+// GetLastFailedLocalGatewayIP returns the value of LastFailedLocalGatewayIP, or the zero value when LastFailedLocalGatewayIP or the Collector is nil
+func (o *Collector) GetLastFailedLocalGatewayIP() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastFailedLocalGatewayIP)
+}
+
+// GetResetReason returns the value of ResetReason, or the zero value when ResetReason or the Collector is nil
+func (o *Collector) GetResetReason() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResetReason)
+}
+
+// GetHostname returns the value of Hostname, or the zero value when Hostname or the Collector is nil
+func (o *Collector) GetHostname() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Hostname)
+}
+
+// GetInstallPath returns the value of InstallPath, or the zero value when InstallPath or the Collector is nil
+func (o *Collector) GetInstallPath() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.InstallPath)
+}
+
+// GetUsePrivateEndpoint returns the value of UsePrivateEndpoint, or the zero value when UsePrivateEndpoint or the Collector is nil
+func (o *Collector) GetUsePrivateEndpoint() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.UsePrivateEndpoint)
+}
+
+// GetManagedBy returns the value of ManagedBy, or the zero value when ManagedBy or the Collector is nil
+func (o *Collector) GetManagedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ManagedBy)
+}
+
+// GetTrialExpiry returns the value of TrialExpiry, or the zero value when TrialExpiry or the Collector is nil
+func (o *Collector) GetTrialExpiry() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.TrialExpiry)
+}
+
+// GetLastFailedInternetGatewayIP returns the value of LastFailedInternetGatewayIP, or the zero value when LastFailedInternetGatewayIP or the Collector is nil
+func (o *Collector) GetLastFailedInternetGatewayIP() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastFailedInternetGatewayIP)
+}
+
+// GetStatusDescription returns the value of StatusDescription, or the zero value when StatusDescription or the Collector is nil
+func (o *Collector) GetStatusDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StatusDescription)
+}
+
+// GetResetTime returns the value of ResetTime, or the zero value when ResetTime or the Collector is nil
+func (o *Collector) GetResetTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.ResetTime)
+}
+
+// GetIsPublic returns the value of IsPublic, or the zero value when IsPublic or the Collector is nil
+func (o *Collector) GetIsPublic() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsPublic)
+}
+
+// GetIsUbiImage returns the value of IsUbiImage, or the zero value when IsUbiImage or the Collector is nil
+func (o *Collector) GetIsUbiImage() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsUbiImage)
+}
+
 // UnmarshalCollector unmarshals an instance of Collector from the specified map of raw messages.
 func UnmarshalCollector(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Collector)
@@ -2875,6 +3410,10 @@ func UnmarshalCollector(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "display_name", "name", "public_key", "last_heartbeat", "status", "collector_version", "image_version", "description", "created_by", "created_at", "updated_by", "updated_at", "enabled", "registration_code", "type", "credential_public_key", "failure_count", "approved_local_gateway_ip", "approved_internet_gateway_ip", "last_failed_local_gateway_ip", "reset_reason", "hostname", "install_path", "use_private_endpoint", "managed_by", "trial_expiry", "last_failed_internet_gateway_ip", "status_description", "reset_time", "is_public", "is_ubi_image")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2905,28 +3444,138 @@ type CollectorList struct {
 
 	// The array of items that are returned.
 	Collectors []Collector `json:"collectors" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalCollectorList unmarshals an instance of CollectorList from the specified map of raw messages.
-func UnmarshalCollectorList(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(CollectorList)
-	err = core.UnmarshalPrimitive(m, "offset", &obj.Offset)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CollectorList
+func (o *CollectorList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CollectorList
+func (o *CollectorList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
-	if err != nil {
-		return
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CollectorList
+func (o *CollectorList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalPageLink)
-	if err != nil {
-		return
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CollectorList
+func (o *CollectorList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalModel(m, "last", &obj.Last, UnmarshalPageLink)
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CollectorList
+func (o *CollectorList) MarshalJSON() (buffer []byte, err error) {
+	type model CollectorList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the CollectorList is nil
+func (o *CollectorList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the CollectorList is nil
+func (o *CollectorList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the CollectorList is nil
+func (o *CollectorList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the CollectorList is nil
+func (o *CollectorList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the CollectorList is nil
+func (o *CollectorList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetNext returns Next, or nil when the CollectorList is nil
+func (o *CollectorList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetPrevious returns Previous, or nil when the CollectorList is nil
+func (o *CollectorList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetCollectors returns Collectors, or nil when the CollectorList is nil
+func (o *CollectorList) GetCollectors() []Collector {
+	if o == nil {
+		return nil
+	}
+	return o.Collectors
+}
+
+// UnmarshalCollectorList unmarshals an instance of CollectorList from the specified map of raw messages.
+func UnmarshalCollectorList(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(CollectorList)
+	err = core.UnmarshalPrimitive(m, "offset", &obj.Offset)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalPageLink)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "last", &obj.Last, UnmarshalPageLink)
 	if err != nil {
 		return
 	}
@@ -2942,6 +3591,10 @@ func UnmarshalCollectorList(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "next", "previous", "collectors")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2953,6 +3606,68 @@ type CollectorUpdate struct {
 
 	// The description of the collector.
 	Description *string `json:"description,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CollectorUpdate
+func (o *CollectorUpdate) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CollectorUpdate
+func (o *CollectorUpdate) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CollectorUpdate
+func (o *CollectorUpdate) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CollectorUpdate
+func (o *CollectorUpdate) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CollectorUpdate
+func (o *CollectorUpdate) MarshalJSON() (buffer []byte, err error) {
+	type model CollectorUpdate
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value when DisplayName or the CollectorUpdate is nil
+func (o *CollectorUpdate) GetDisplayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DisplayName)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the CollectorUpdate is nil
+func (o *CollectorUpdate) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
 }
 
 // UnmarshalCollectorUpdate unmarshals an instance of CollectorUpdate from the specified map of raw messages.
@@ -2966,6 +3681,10 @@ func UnmarshalCollectorUpdate(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "display_name", "description")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2999,6 +3718,9 @@ type Control struct {
 
 	// A scan's summary controls.
 	ResourceStatistics *ResourceStatistics `json:"resource_statistics,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Control.Status property.
@@ -3008,6 +3730,97 @@ const (
 	ControlStatusUnableToPerformConst = "unable_to_perform"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Control
+func (o *Control) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Control
+func (o *Control) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Control
+func (o *Control) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Control
+func (o *Control) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Control
+func (o *Control) MarshalJSON() (buffer []byte, err error) {
+	type model Control
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Control is nil
+func (o *Control) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Control is nil
+func (o *Control) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetExternalControlID returns the value of ExternalControlID, or the zero value when ExternalControlID or the Control is nil
+func (o *Control) GetExternalControlID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ExternalControlID)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Control is nil
+func (o *Control) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetGoals returns Goals, or nil when the Control is nil
+func (o *Control) GetGoals() []Goal {
+	if o == nil {
+		return nil
+	}
+	return o.Goals
+}
+
+// GetResourceStatistics returns ResourceStatistics, or nil when the Control is nil
+func (o *Control) GetResourceStatistics() *ResourceStatistics {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceStatistics
+}
+
 // UnmarshalControl unmarshals an instance of Control from the specified map of raw messages.
 func UnmarshalControl(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Control)
@@ -3035,6 +3848,10 @@ func UnmarshalControl(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "status", "external_control_id", "description", "goals", "resource_statistics")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3052,6 +3869,84 @@ type ControlItem struct {
 
 	// The goals that are mapped against the control identifier.
 	Goals []GoalItem `json:"goals" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ControlItem
+func (o *ControlItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ControlItem
+func (o *ControlItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ControlItem
+func (o *ControlItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ControlItem
+func (o *ControlItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ControlItem
+func (o *ControlItem) MarshalJSON() (buffer []byte, err error) {
+	type model ControlItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ControlItem is nil
+func (o *ControlItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ControlItem is nil
+func (o *ControlItem) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetExternalControlID returns the value of ExternalControlID, or the zero value when ExternalControlID or the ControlItem is nil
+func (o *ControlItem) GetExternalControlID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ExternalControlID)
+}
+
+// GetGoals returns Goals, or nil when the ControlItem is nil
+func (o *ControlItem) GetGoals() []GoalItem {
+	if o == nil {
+		return nil
+	}
+	return o.Goals
 }
 
 // UnmarshalControlItem unmarshals an instance of ControlItem from the specified map of raw messages.
@@ -3073,6 +3968,10 @@ func UnmarshalControlItem(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "description", "external_control_id", "goals")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3102,6 +4001,116 @@ type ControlList struct {
 
 	// Profiles array.
 	Controls []ControlItem `json:"controls" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ControlList
+func (o *ControlList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ControlList
+func (o *ControlList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ControlList
+func (o *ControlList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ControlList
+func (o *ControlList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ControlList
+func (o *ControlList) MarshalJSON() (buffer []byte, err error) {
+	type model ControlList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ControlList is nil
+func (o *ControlList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ControlList is nil
+func (o *ControlList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ControlList is nil
+func (o *ControlList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ControlList is nil
+func (o *ControlList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ControlList is nil
+func (o *ControlList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ControlList is nil
+func (o *ControlList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ControlList is nil
+func (o *ControlList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetControls returns Controls, or nil when the ControlList is nil
+func (o *ControlList) GetControls() []ControlItem {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
 }
 
 // UnmarshalControlList unmarshals an instance of ControlList from the specified map of raw messages.
@@ -3139,6 +4148,10 @@ func UnmarshalControlList(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "controls")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3659,6 +4672,9 @@ type Credential struct {
 
 	// The purpose for which the credential is created.
 	Purpose *string `json:"purpose" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Credential.Type property.
@@ -3684,34 +4700,165 @@ const (
 	CredentialPurposeRemediationConst                        = "remediation"
 )
 
-// UnmarshalCredential unmarshals an instance of Credential from the specified map of raw messages.
-func UnmarshalCredential(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(Credential)
-	err = core.UnmarshalPrimitive(m, "enabled", &obj.Enabled)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Credential
+func (o *Credential) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Credential
+func (o *Credential) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "type", &obj.Type)
-	if err != nil {
-		return
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Credential
+func (o *Credential) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
-	if err != nil {
-		return
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Credential
+func (o *Credential) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalPrimitive(m, "description", &obj.Description)
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Credential
+func (o *Credential) MarshalJSON() (buffer []byte, err error) {
+	type model Credential
+	buffer, err = json.Marshal((*model)(o))
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalModel(m, "display_fields", &obj.DisplayFields, UnmarshalCredentialDisplayFields)
-	if err != nil {
-		return
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the Credential is nil
+func (o *Credential) GetEnabled() bool {
+	if o == nil {
+		return false
 	}
-	err = core.UnmarshalPrimitive(m, "created_by", &obj.CreatedBy)
+	return common.Deref(o.Enabled)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Credential is nil
+func (o *Credential) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Credential is nil
+func (o *Credential) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Credential is nil
+func (o *Credential) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Credential is nil
+func (o *Credential) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetDisplayFields returns DisplayFields, or nil when the Credential is nil
+func (o *Credential) GetDisplayFields() *CredentialDisplayFields {
+	if o == nil {
+		return nil
+	}
+	return o.DisplayFields
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Credential is nil
+func (o *Credential) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Credential is nil
+func (o *Credential) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the Credential is nil
+func (o *Credential) GetUpdatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetUpdatedBy returns the value of UpdatedBy, or the zero value when UpdatedBy or the Credential is nil
+func (o *Credential) GetUpdatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedBy)
+}
+
+// GetPurpose returns the value of Purpose, or the zero value when Purpose or the Credential is nil
+func (o *Credential) GetPurpose() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Purpose)
+}
+
+// UnmarshalCredential unmarshals an instance of Credential from the specified map of raw messages.
+func UnmarshalCredential(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Credential)
+	err = core.UnmarshalPrimitive(m, "enabled", &obj.Enabled)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "type", &obj.Type)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "description", &obj.Description)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "display_fields", &obj.DisplayFields, UnmarshalCredentialDisplayFields)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "created_by", &obj.CreatedBy)
 	if err != nil {
 		return
 	}
@@ -3732,6 +4879,10 @@ func UnmarshalCredential(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "enabled", "id", "type", "name", "description", "display_fields", "created_by", "created_at", "updated_at", "updated_by", "purpose")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3810,6 +4961,244 @@ type CredentialDisplayFields struct {
 
 	// The base64 encoded form of pem.Will be displayed a xxxxxx.
 	PemData *string `json:"pem_data,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CredentialDisplayFields
+func (o *CredentialDisplayFields) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CredentialDisplayFields
+func (o *CredentialDisplayFields) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CredentialDisplayFields
+func (o *CredentialDisplayFields) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CredentialDisplayFields
+func (o *CredentialDisplayFields) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CredentialDisplayFields
+func (o *CredentialDisplayFields) MarshalJSON() (buffer []byte, err error) {
+	type model CredentialDisplayFields
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetIBMAPIKey returns the value of IBMAPIKey, or the zero value when IBMAPIKey or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetIBMAPIKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.IBMAPIKey)
+}
+
+// GetAwsClientID returns the value of AwsClientID, or the zero value when AwsClientID or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAwsClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientID)
+}
+
+// GetAwsClientSecret returns the value of AwsClientSecret, or the zero value when AwsClientSecret or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAwsClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientSecret)
+}
+
+// GetAwsRegion returns the value of AwsRegion, or the zero value when AwsRegion or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAwsRegion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsRegion)
+}
+
+// GetAwsArn returns the value of AwsArn, or the zero value when AwsArn or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAwsArn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsArn)
+}
+
+// GetUsername returns the value of Username, or the zero value when Username or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetUsername() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Username)
+}
+
+// GetPassword returns the value of Password, or the zero value when Password or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetPassword() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Password)
+}
+
+// GetAzureClientID returns the value of AzureClientID, or the zero value when AzureClientID or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAzureClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientID)
+}
+
+// GetAzureClientSecret returns the value of AzureClientSecret, or the zero value when AzureClientSecret or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAzureClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientSecret)
+}
+
+// GetAzureSubscriptionID returns the value of AzureSubscriptionID, or the zero value when AzureSubscriptionID or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAzureSubscriptionID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureSubscriptionID)
+}
+
+// GetAzureResourceGroup returns the value of AzureResourceGroup, or the zero value when AzureResourceGroup or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAzureResourceGroup() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureResourceGroup)
+}
+
+// GetDatabaseName returns the value of DatabaseName, or the zero value when DatabaseName or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetDatabaseName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DatabaseName)
+}
+
+// GetWinrmAuthtype returns the value of WinrmAuthtype, or the zero value when WinrmAuthtype or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetWinrmAuthtype() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmAuthtype)
+}
+
+// GetWinrmUsessl returns the value of WinrmUsessl, or the zero value when WinrmUsessl or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetWinrmUsessl() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmUsessl)
+}
+
+// GetWinrmPort returns the value of WinrmPort, or the zero value when WinrmPort or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetWinrmPort() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmPort)
+}
+
+// GetMs365ClientID returns the value of Ms365ClientID, or the zero value when Ms365ClientID or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetMs365ClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientID)
+}
+
+// GetMs365ClientSecret returns the value of Ms365ClientSecret, or the zero value when Ms365ClientSecret or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetMs365ClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientSecret)
+}
+
+// GetMs365TenantID returns the value of Ms365TenantID, or the zero value when Ms365TenantID or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetMs365TenantID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365TenantID)
+}
+
+// GetAuthURL returns the value of AuthURL, or the zero value when AuthURL or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetAuthURL() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AuthURL)
+}
+
+// GetProjectName returns the value of ProjectName, or the zero value when ProjectName or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetProjectName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectName)
+}
+
+// GetUserDomainName returns the value of UserDomainName, or the zero value when UserDomainName or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetUserDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UserDomainName)
+}
+
+// GetProjectDomainName returns the value of ProjectDomainName, or the zero value when ProjectDomainName or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetProjectDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectDomainName)
+}
+
+// GetPemFileName returns the value of PemFileName, or the zero value when PemFileName or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetPemFileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemFileName)
+}
+
+// GetPemData returns the value of PemData, or the zero value when PemData or the CredentialDisplayFields is nil
+func (o *CredentialDisplayFields) GetPemData() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemData)
 }
 
 // UnmarshalCredentialDisplayFields unmarshals an instance of CredentialDisplayFields from the specified map of raw messages.
@@ -3911,6 +5300,10 @@ func UnmarshalCredentialDisplayFields(m map[string]json.RawMessage, result inter
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "ibm_api_key", "aws_client_id", "aws_client_secret", "aws_region", "aws_arn", "username", "password", "azure_client_id", "azure_client_secret", "azure_subscription_id", "azure_resource_group", "database_name", "winrm_authtype", "winrm_usessl", "winrm_port", "ms_365_client_id", "ms_365_client_secret", "ms_365_tenant_id", "auth_url", "project_name", "user_domain_name", "project_domain_name", "pem_file_name", "pem_data")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3941,6 +5334,116 @@ type CredentialList struct {
 
 	// The details of a credential.
 	Credentials []Credential `json:"credentials" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of CredentialList
+func (o *CredentialList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of CredentialList
+func (o *CredentialList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of CredentialList
+func (o *CredentialList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of CredentialList
+func (o *CredentialList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CredentialList
+func (o *CredentialList) MarshalJSON() (buffer []byte, err error) {
+	type model CredentialList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the CredentialList is nil
+func (o *CredentialList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the CredentialList is nil
+func (o *CredentialList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the CredentialList is nil
+func (o *CredentialList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the CredentialList is nil
+func (o *CredentialList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the CredentialList is nil
+func (o *CredentialList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the CredentialList is nil
+func (o *CredentialList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the CredentialList is nil
+func (o *CredentialList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetCredentials returns Credentials, or nil when the CredentialList is nil
+func (o *CredentialList) GetCredentials() []Credential {
+	if o == nil {
+		return nil
+	}
+	return o.Credentials
 }
 
 // UnmarshalCredentialList unmarshals an instance of CredentialList from the specified map of raw messages.
@@ -3978,6 +5481,10 @@ func UnmarshalCredentialList(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "credentials")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4209,6 +5716,9 @@ type EventItem struct {
 
 	// The status of the event.
 	StatusMessage *string `json:"status_message" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the EventItem.TaskType property.
@@ -4281,6 +5791,105 @@ const (
 	EventItemStatusWaitingForRefineConst                = "waiting_for_refine"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EventItem
+func (o *EventItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EventItem
+func (o *EventItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EventItem
+func (o *EventItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EventItem
+func (o *EventItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EventItem
+func (o *EventItem) MarshalJSON() (buffer []byte, err error) {
+	type model EventItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the EventItem is nil
+func (o *EventItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the EventItem is nil
+func (o *EventItem) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the EventItem is nil
+func (o *EventItem) GetUpdatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetTaskType returns the value of TaskType, or the zero value when TaskType or the EventItem is nil
+func (o *EventItem) GetTaskType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskType)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the EventItem is nil
+func (o *EventItem) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetDataAvailable returns the value of DataAvailable, or the zero value when DataAvailable or the EventItem is nil
+func (o *EventItem) GetDataAvailable() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.DataAvailable)
+}
+
+// GetStatusMessage returns the value of StatusMessage, or the zero value when StatusMessage or the EventItem is nil
+func (o *EventItem) GetStatusMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StatusMessage)
+}
+
 // UnmarshalEventItem unmarshals an instance of EventItem from the specified map of raw messages.
 func UnmarshalEventItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(EventItem)
@@ -4312,7 +5921,11 @@ func UnmarshalEventItem(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
-	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "created_at", "updated_at", "task_type", "status", "data_available", "status_message")
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
 
@@ -4320,6 +5933,60 @@ func UnmarshalEventItem(m map[string]json.RawMessage, result interface{}) (err e
 type EventList struct {
 	// The events for a given scope.
 	Events []EventItem `json:"events,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EventList
+func (o *EventList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EventList
+func (o *EventList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EventList
+func (o *EventList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EventList
+func (o *EventList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EventList
+func (o *EventList) MarshalJSON() (buffer []byte, err error) {
+	type model EventList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEvents returns Events, or nil when the EventList is nil
+func (o *EventList) GetEvents() []EventItem {
+	if o == nil {
+		return nil
+	}
+	return o.Events
 }
 
 // UnmarshalEventList unmarshals an instance of EventList from the specified map of raw messages.
@@ -4329,6 +5996,10 @@ func UnmarshalEventList(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "events")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4886,6 +6557,9 @@ type Goal struct {
 
 	// The criteria that defines how a profile applies.
 	ApplicabilityCriteria *GoalApplicabilityCriteria `json:"applicability_criteria,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Goal.Status property.
@@ -4895,6 +6569,113 @@ const (
 	GoalStatusPassConst = "pass"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Goal
+func (o *Goal) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Goal
+func (o *Goal) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Goal
+func (o *Goal) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Goal
+func (o *Goal) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Goal
+func (o *Goal) MarshalJSON() (buffer []byte, err error) {
+	type model Goal
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Goal is nil
+func (o *Goal) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Goal is nil
+func (o *Goal) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Goal is nil
+func (o *Goal) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetSeverity returns the value of Severity, or the zero value when Severity or the Goal is nil
+func (o *Goal) GetSeverity() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Severity)
+}
+
+// GetCompletedTime returns the value of CompletedTime, or the zero value when CompletedTime or the Goal is nil
+func (o *Goal) GetCompletedTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CompletedTime)
+}
+
+// GetError returns the value of Error, or the zero value when Error or the Goal is nil
+func (o *Goal) GetError() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Error)
+}
+
+// GetResourceResult returns ResourceResult, or nil when the Goal is nil
+func (o *Goal) GetResourceResult() []ResourceResult {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceResult
+}
+
+// GetApplicabilityCriteria returns ApplicabilityCriteria, or nil when the Goal is nil
+func (o *Goal) GetApplicabilityCriteria() *GoalApplicabilityCriteria {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicabilityCriteria
+}
+
 // UnmarshalGoal unmarshals an instance of Goal from the specified map of raw messages.
 func UnmarshalGoal(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Goal)
@@ -4930,6 +6711,10 @@ func UnmarshalGoal(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "id", "status", "severity", "completed_time", "error", "resource_result", "applicability_criteria")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4974,6 +6759,156 @@ type GoalApplicabilityCriteria struct {
 
 	// The resource that is scanned as part of your scope.
 	ResourceDescription map[string]string `json:"resource_description,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GoalApplicabilityCriteria
+func (o *GoalApplicabilityCriteria) MarshalJSON() (buffer []byte, err error) {
+	type model GoalApplicabilityCriteria
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetEnvironment returns Environment, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironment() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Environment
+}
+
+// GetResource returns Resource, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResource() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Resource
+}
+
+// GetEnvironmentCategory returns EnvironmentCategory, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategory
+}
+
+// GetResourceCategory returns ResourceCategory, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceCategory() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategory
+}
+
+// GetResourceType returns ResourceType, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceType() []string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceType
+}
+
+// GetSoftwareDetails returns SoftwareDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetSoftwareDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.SoftwareDetails
+}
+
+// GetOsDetails returns OsDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetOsDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.OsDetails
+}
+
+// GetAdditionalDetails returns AdditionalDetails, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetAdditionalDetails() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.AdditionalDetails
+}
+
+// GetEnvironmentCategoryDescription returns EnvironmentCategoryDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentCategoryDescription
+}
+
+// GetEnvironmentDescription returns EnvironmentDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetEnvironmentDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.EnvironmentDescription
+}
+
+// GetResourceCategoryDescription returns ResourceCategoryDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceCategoryDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceCategoryDescription
+}
+
+// GetResourceTypeDescription returns ResourceTypeDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceTypeDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceTypeDescription
+}
+
+// GetResourceDescription returns ResourceDescription, or nil when the GoalApplicabilityCriteria is nil
+func (o *GoalApplicabilityCriteria) GetResourceDescription() map[string]string {
+	if o == nil {
+		return nil
+	}
+	return o.ResourceDescription
 }
 
 // UnmarshalGoalApplicabilityCriteria unmarshals an instance of GoalApplicabilityCriteria from the specified map of raw messages.
@@ -5031,6 +6966,10 @@ func UnmarshalGoalApplicabilityCriteria(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "environment", "resource", "environment_category", "resource_category", "resource_type", "software_details", "os_details", "additional_details", "environment_category_description", "environment_description", "resource_category_description", "resource_type_description", "resource_description")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5060,6 +6999,116 @@ type GoalItem struct {
 
 	// The goal is autoremediable or not.
 	IsAutoRemediable *bool `json:"is_auto_remediable" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GoalItem
+func (o *GoalItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GoalItem
+func (o *GoalItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GoalItem
+func (o *GoalItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GoalItem
+func (o *GoalItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GoalItem
+func (o *GoalItem) MarshalJSON() (buffer []byte, err error) {
+	type model GoalItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the GoalItem is nil
+func (o *GoalItem) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the GoalItem is nil
+func (o *GoalItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetSeverity returns the value of Severity, or the zero value when Severity or the GoalItem is nil
+func (o *GoalItem) GetSeverity() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Severity)
+}
+
+// GetIsManual returns the value of IsManual, or the zero value when IsManual or the GoalItem is nil
+func (o *GoalItem) GetIsManual() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsManual)
+}
+
+// GetIsRemediable returns the value of IsRemediable, or the zero value when IsRemediable or the GoalItem is nil
+func (o *GoalItem) GetIsRemediable() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsRemediable)
+}
+
+// GetIsReversible returns the value of IsReversible, or the zero value when IsReversible or the GoalItem is nil
+func (o *GoalItem) GetIsReversible() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsReversible)
+}
+
+// GetIsAutomatable returns the value of IsAutomatable, or the zero value when IsAutomatable or the GoalItem is nil
+func (o *GoalItem) GetIsAutomatable() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsAutomatable)
+}
+
+// GetIsAutoRemediable returns the value of IsAutoRemediable, or the zero value when IsAutoRemediable or the GoalItem is nil
+func (o *GoalItem) GetIsAutoRemediable() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsAutoRemediable)
 }
 
 // UnmarshalGoalItem unmarshals an instance of GoalItem from the specified map of raw messages.
@@ -5097,6 +7146,10 @@ func UnmarshalGoalItem(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "id", "severity", "is_manual", "is_remediable", "is_reversible", "is_automatable", "is_auto_remediable")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5460,49 +7513,287 @@ type NewCredentialDisplayFields struct {
 
 	// The base64 encoded form of pem.
 	PemData *string `json:"pem_data,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalNewCredentialDisplayFields unmarshals an instance of NewCredentialDisplayFields from the specified map of raw messages.
-func UnmarshalNewCredentialDisplayFields(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(NewCredentialDisplayFields)
-	err = core.UnmarshalPrimitive(m, "ibm_api_key", &obj.IBMAPIKey)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of NewCredentialDisplayFields
+func (o *NewCredentialDisplayFields) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "aws_client_id", &obj.AwsClientID)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of NewCredentialDisplayFields
+func (o *NewCredentialDisplayFields) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "aws_client_secret", &obj.AwsClientSecret)
-	if err != nil {
-		return
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of NewCredentialDisplayFields
+func (o *NewCredentialDisplayFields) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalPrimitive(m, "aws_region", &obj.AwsRegion)
-	if err != nil {
-		return
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of NewCredentialDisplayFields
+func (o *NewCredentialDisplayFields) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
 	}
-	err = core.UnmarshalPrimitive(m, "aws_arn", &obj.AwsArn)
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of NewCredentialDisplayFields
+func (o *NewCredentialDisplayFields) MarshalJSON() (buffer []byte, err error) {
+	type model NewCredentialDisplayFields
+	buffer, err = json.Marshal((*model)(o))
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalPrimitive(m, "username", &obj.Username)
-	if err != nil {
-		return
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetIBMAPIKey returns the value of IBMAPIKey, or the zero value when IBMAPIKey or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetIBMAPIKey() string {
+	if o == nil {
+		return ""
 	}
-	err = core.UnmarshalPrimitive(m, "password", &obj.Password)
-	if err != nil {
-		return
+	return common.Deref(o.IBMAPIKey)
+}
+
+// GetAwsClientID returns the value of AwsClientID, or the zero value when AwsClientID or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAwsClientID() string {
+	if o == nil {
+		return ""
 	}
-	err = core.UnmarshalPrimitive(m, "azure_client_id", &obj.AzureClientID)
-	if err != nil {
-		return
+	return common.Deref(o.AwsClientID)
+}
+
+// GetAwsClientSecret returns the value of AwsClientSecret, or the zero value when AwsClientSecret or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAwsClientSecret() string {
+	if o == nil {
+		return ""
 	}
-	err = core.UnmarshalPrimitive(m, "azure_client_secret", &obj.AzureClientSecret)
-	if err != nil {
-		return
+	return common.Deref(o.AwsClientSecret)
+}
+
+// GetAwsRegion returns the value of AwsRegion, or the zero value when AwsRegion or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAwsRegion() string {
+	if o == nil {
+		return ""
 	}
-	err = core.UnmarshalPrimitive(m, "azure_subscription_id", &obj.AzureSubscriptionID)
-	if err != nil {
+	return common.Deref(o.AwsRegion)
+}
+
+// GetAwsArn returns the value of AwsArn, or the zero value when AwsArn or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAwsArn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsArn)
+}
+
+// GetUsername returns the value of Username, or the zero value when Username or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetUsername() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Username)
+}
+
+// GetPassword returns the value of Password, or the zero value when Password or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetPassword() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Password)
+}
+
+// GetAzureClientID returns the value of AzureClientID, or the zero value when AzureClientID or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAzureClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientID)
+}
+
+// GetAzureClientSecret returns the value of AzureClientSecret, or the zero value when AzureClientSecret or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAzureClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientSecret)
+}
+
+// GetAzureSubscriptionID returns the value of AzureSubscriptionID, or the zero value when AzureSubscriptionID or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAzureSubscriptionID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureSubscriptionID)
+}
+
+// GetAzureResourceGroup returns the value of AzureResourceGroup, or the zero value when AzureResourceGroup or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAzureResourceGroup() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureResourceGroup)
+}
+
+// GetDatabaseName returns the value of DatabaseName, or the zero value when DatabaseName or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetDatabaseName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DatabaseName)
+}
+
+// GetWinrmAuthtype returns the value of WinrmAuthtype, or the zero value when WinrmAuthtype or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetWinrmAuthtype() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmAuthtype)
+}
+
+// GetWinrmUsessl returns the value of WinrmUsessl, or the zero value when WinrmUsessl or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetWinrmUsessl() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmUsessl)
+}
+
+// GetWinrmPort returns the value of WinrmPort, or the zero value when WinrmPort or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetWinrmPort() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmPort)
+}
+
+// GetMs365ClientID returns the value of Ms365ClientID, or the zero value when Ms365ClientID or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetMs365ClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientID)
+}
+
+// GetMs365ClientSecret returns the value of Ms365ClientSecret, or the zero value when Ms365ClientSecret or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetMs365ClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientSecret)
+}
+
+// GetMs365TenantID returns the value of Ms365TenantID, or the zero value when Ms365TenantID or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetMs365TenantID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365TenantID)
+}
+
+// GetAuthURL returns the value of AuthURL, or the zero value when AuthURL or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetAuthURL() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AuthURL)
+}
+
+// GetProjectName returns the value of ProjectName, or the zero value when ProjectName or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetProjectName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectName)
+}
+
+// GetUserDomainName returns the value of UserDomainName, or the zero value when UserDomainName or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetUserDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UserDomainName)
+}
+
+// GetProjectDomainName returns the value of ProjectDomainName, or the zero value when ProjectDomainName or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetProjectDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectDomainName)
+}
+
+// GetPemFileName returns the value of PemFileName, or the zero value when PemFileName or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetPemFileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemFileName)
+}
+
+// GetPemData returns the value of PemData, or the zero value when PemData or the NewCredentialDisplayFields is nil
+func (o *NewCredentialDisplayFields) GetPemData() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemData)
+}
+
+// UnmarshalNewCredentialDisplayFields unmarshals an instance of NewCredentialDisplayFields from the specified map of raw messages.
+func UnmarshalNewCredentialDisplayFields(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(NewCredentialDisplayFields)
+	err = core.UnmarshalPrimitive(m, "ibm_api_key", &obj.IBMAPIKey)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "aws_client_id", &obj.AwsClientID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "aws_client_secret", &obj.AwsClientSecret)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "aws_region", &obj.AwsRegion)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "aws_arn", &obj.AwsArn)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "username", &obj.Username)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "password", &obj.Password)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "azure_client_id", &obj.AzureClientID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "azure_client_secret", &obj.AzureClientSecret)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "azure_subscription_id", &obj.AzureSubscriptionID)
+	if err != nil {
 		return
 	}
 	err = core.UnmarshalPrimitive(m, "azure_resource_group", &obj.AzureResourceGroup)
@@ -5561,6 +7852,10 @@ func UnmarshalNewCredentialDisplayFields(m map[string]json.RawMessage, result in
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "ibm_api_key", "aws_client_id", "aws_client_secret", "aws_region", "aws_arn", "username", "password", "azure_client_id", "azure_client_secret", "azure_subscription_id", "azure_resource_group", "database_name", "winrm_authtype", "winrm_usessl", "winrm_port", "ms_365_client_id", "ms_365_client_secret", "ms_365_tenant_id", "auth_url", "project_name", "user_domain_name", "project_domain_name", "pem_file_name", "pem_data")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5569,6 +7864,60 @@ func UnmarshalNewCredentialDisplayFields(m map[string]json.RawMessage, result in
 type PageLink struct {
 	// The URL of a page.
 	Href *string `json:"href" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of PageLink
+func (o *PageLink) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of PageLink
+func (o *PageLink) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of PageLink
+func (o *PageLink) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of PageLink
+func (o *PageLink) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of PageLink
+func (o *PageLink) MarshalJSON() (buffer []byte, err error) {
+	type model PageLink
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the PageLink is nil
+func (o *PageLink) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalPageLink unmarshals an instance of PageLink from the specified map of raw messages.
@@ -5578,6 +7927,10 @@ func UnmarshalPageLink(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5625,6 +7978,9 @@ type Profile struct {
 
 	// The profile status. If the profile is enabled, the value is true. If the profile is disabled, the value is false.
 	Enabled *bool `json:"enabled" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Profile.Type property.
@@ -5636,6 +7992,161 @@ const (
 	ProfileTypeTemplateGroupConst = "template_group"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Profile
+func (o *Profile) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Profile
+func (o *Profile) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Profile
+func (o *Profile) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Profile
+func (o *Profile) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Profile
+func (o *Profile) MarshalJSON() (buffer []byte, err error) {
+	type model Profile
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Profile is nil
+func (o *Profile) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Profile is nil
+func (o *Profile) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetVersion returns the value of Version, or the zero value when Version or the Profile is nil
+func (o *Profile) GetVersion() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Version)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Profile is nil
+func (o *Profile) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the Profile is nil
+func (o *Profile) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetReasonForDelete returns the value of ReasonForDelete, or the zero value when ReasonForDelete or the Profile is nil
+func (o *Profile) GetReasonForDelete() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReasonForDelete)
+}
+
+// GetApplicabilityCriteria returns ApplicabilityCriteria, or nil when the Profile is nil
+func (o *Profile) GetApplicabilityCriteria() *ApplicabilityCriteria {
+	if o == nil {
+		return nil
+	}
+	return o.ApplicabilityCriteria
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Profile is nil
+func (o *Profile) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetBaseProfile returns the value of BaseProfile, or the zero value when BaseProfile or the Profile is nil
+func (o *Profile) GetBaseProfile() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.BaseProfile)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Profile is nil
+func (o *Profile) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetNoOfControls returns the value of NoOfControls, or the zero value when NoOfControls or the Profile is nil
+func (o *Profile) GetNoOfControls() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NoOfControls)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Profile is nil
+func (o *Profile) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the Profile is nil
+func (o *Profile) GetUpdatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the Profile is nil
+func (o *Profile) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
 // UnmarshalProfile unmarshals an instance of Profile from the specified map of raw messages.
 func UnmarshalProfile(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Profile)
@@ -5695,6 +8206,10 @@ func UnmarshalProfile(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "description", "version", "created_by", "modified_by", "reason_for_delete", "applicability_criteria", "id", "base_profile", "type", "no_of_controls", "created_at", "updated_at", "enabled")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5709,6 +8224,9 @@ type ProfileItem struct {
 
 	// The type of profile.
 	Type *string `json:"type" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ProfileItem.Type property.
@@ -5719,6 +8237,73 @@ const (
 	ProfileItemTypeTemplateGroupConst = "template_group"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfileItem
+func (o *ProfileItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfileItem
+func (o *ProfileItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfileItem
+func (o *ProfileItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfileItem
+func (o *ProfileItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfileItem
+func (o *ProfileItem) MarshalJSON() (buffer []byte, err error) {
+	type model ProfileItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ProfileItem is nil
+func (o *ProfileItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ProfileItem is nil
+func (o *ProfileItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the ProfileItem is nil
+func (o *ProfileItem) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
 // UnmarshalProfileItem unmarshals an instance of ProfileItem from the specified map of raw messages.
 func UnmarshalProfileItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ProfileItem)
@@ -5734,6 +8319,10 @@ func UnmarshalProfileItem(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "id", "type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5763,6 +8352,116 @@ type ProfileList struct {
 
 	// Profiles.
 	Profiles []Profile `json:"profiles" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfileList
+func (o *ProfileList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfileList
+func (o *ProfileList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfileList
+func (o *ProfileList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfileList
+func (o *ProfileList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfileList
+func (o *ProfileList) MarshalJSON() (buffer []byte, err error) {
+	type model ProfileList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ProfileList is nil
+func (o *ProfileList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ProfileList is nil
+func (o *ProfileList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ProfileList is nil
+func (o *ProfileList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ProfileList is nil
+func (o *ProfileList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ProfileList is nil
+func (o *ProfileList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ProfileList is nil
+func (o *ProfileList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ProfileList is nil
+func (o *ProfileList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetProfiles returns Profiles, or nil when the ProfileList is nil
+func (o *ProfileList) GetProfiles() []Profile {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
 }
 
 // UnmarshalProfileList unmarshals an instance of ProfileList from the specified map of raw messages.
@@ -5800,6 +8499,10 @@ func UnmarshalProfileList(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5835,6 +8538,9 @@ type ProfileResult struct {
 
 	// The result of a scan. The controls values are not available if no scopes are available.
 	ValidationResult *ScanResult `json:"validation_result" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ProfileResult.Type property.
@@ -5850,6 +8556,81 @@ const (
 	ProfileResultTypeTemmplategroupConst      = "temmplategroup"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ProfileResult
+func (o *ProfileResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ProfileResult
+func (o *ProfileResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ProfileResult
+func (o *ProfileResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ProfileResult
+func (o *ProfileResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ProfileResult
+func (o *ProfileResult) MarshalJSON() (buffer []byte, err error) {
+	type model ProfileResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ProfileResult is nil
+func (o *ProfileResult) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ProfileResult is nil
+func (o *ProfileResult) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the ProfileResult is nil
+func (o *ProfileResult) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetValidationResult returns ValidationResult, or nil when the ProfileResult is nil
+func (o *ProfileResult) GetValidationResult() *ScanResult {
+	if o == nil {
+		return nil
+	}
+	return o.ValidationResult
+}
+
 // UnmarshalProfileResult unmarshals an instance of ProfileResult from the specified map of raw messages.
 func UnmarshalProfileResult(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ProfileResult)
@@ -5869,6 +8650,10 @@ func UnmarshalProfileResult(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "type", "validation_result")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -5888,6 +8673,10 @@ type ReplaceScopeDetailsCollectorOptions struct {
 	// sends a transaction ID as a response header of the request.
 	TransactionID *string `json:"-"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -5930,6 +8719,24 @@ func (options *ReplaceScopeDetailsCollectorOptions) SetHeaders(param map[string]
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *ReplaceScopeDetailsCollectorOptions) SetAdditionalProperty(key string, value interface{}) *ReplaceScopeDetailsCollectorOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *ReplaceScopeDetailsCollectorOptions) SetAdditionalProperties(param map[string]interface{}) *ReplaceScopeDetailsCollectorOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // ReplaceScopeDetailsCredentialsOptions : The ReplaceScopeDetailsCredentials options.
 type ReplaceScopeDetailsCredentialsOptions struct {
 	// The unique identifier that is used to trace an entire Scope request.
@@ -5948,6 +8755,10 @@ type ReplaceScopeDetailsCredentialsOptions struct {
 	// sends a transaction ID as a response header of the request.
 	TransactionID *string `json:"-"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -5996,6 +8807,24 @@ func (options *ReplaceScopeDetailsCredentialsOptions) SetHeaders(param map[strin
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *ReplaceScopeDetailsCredentialsOptions) SetAdditionalProperty(key string, value interface{}) *ReplaceScopeDetailsCredentialsOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *ReplaceScopeDetailsCredentialsOptions) SetAdditionalProperties(param map[string]interface{}) *ReplaceScopeDetailsCredentialsOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // ResourceResult : The resource results.
 type ResourceResult struct {
 	// The resource name.
@@ -6010,22 +8839,124 @@ type ResourceResult struct {
 	// The expected results of a resource.
 	DisplayExpectedValue *string `json:"display_expected_value,omitempty"`
 
-	// The actual results of a resource.
-	ActualValue *string `json:"actual_value,omitempty"`
+	// The actual results of a resource.
+	ActualValue *string `json:"actual_value,omitempty"`
+
+	// The results information.
+	ResultsInfo *string `json:"results_info,omitempty"`
+
+	// The reason why a goal is not applicable to a resource.
+	NotApplicableReason *string `json:"not_applicable_reason,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// Constants associated with the ResourceResult.Status property.
+// The result status of resource control.
+const (
+	ResourceResultStatusPassConst            = "pass"
+	ResourceResultStatusUnableToPerformConst = "unable_to_perform"
+)
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceResult
+func (o *ResourceResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceResult
+func (o *ResourceResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceResult
+func (o *ResourceResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceResult
+func (o *ResourceResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceResult
+func (o *ResourceResult) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ResourceResult is nil
+func (o *ResourceResult) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetTypes returns the value of Types, or the zero value when Types or the ResourceResult is nil
+func (o *ResourceResult) GetTypes() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Types)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ResourceResult is nil
+func (o *ResourceResult) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetDisplayExpectedValue returns the value of DisplayExpectedValue, or the zero value when DisplayExpectedValue or the ResourceResult is nil
+func (o *ResourceResult) GetDisplayExpectedValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DisplayExpectedValue)
+}
 
-	// The results information.
-	ResultsInfo *string `json:"results_info,omitempty"`
+// GetActualValue returns the value of ActualValue, or the zero value when ActualValue or the ResourceResult is nil
+func (o *ResourceResult) GetActualValue() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ActualValue)
+}
 
-	// The reason why a goal is not applicable to a resource.
-	NotApplicableReason *string `json:"not_applicable_reason,omitempty"`
+// GetResultsInfo returns the value of ResultsInfo, or the zero value when ResultsInfo or the ResourceResult is nil
+func (o *ResourceResult) GetResultsInfo() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResultsInfo)
 }
 
-// Constants associated with the ResourceResult.Status property.
-// The result status of resource control.
-const (
-	ResourceResultStatusPassConst            = "pass"
-	ResourceResultStatusUnableToPerformConst = "unable_to_perform"
-)
+// GetNotApplicableReason returns the value of NotApplicableReason, or the zero value when NotApplicableReason or the ResourceResult is nil
+func (o *ResourceResult) GetNotApplicableReason() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.NotApplicableReason)
+}
 
 // UnmarshalResourceResult unmarshals an instance of ResourceResult from the specified map of raw messages.
 func UnmarshalResourceResult(m map[string]json.RawMessage, result interface{}) (err error) {
@@ -6058,6 +8989,10 @@ func UnmarshalResourceResult(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "types", "status", "display_expected_value", "actual_value", "results_info", "not_applicable_reason")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6075,6 +9010,84 @@ type ResourceStatistics struct {
 
 	// The resource count of controls that are not applicable.
 	NotApplicableCount *int64 `json:"not_applicable_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceStatistics
+func (o *ResourceStatistics) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceStatistics
+func (o *ResourceStatistics) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceStatistics
+func (o *ResourceStatistics) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceStatistics
+func (o *ResourceStatistics) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceStatistics
+func (o *ResourceStatistics) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceStatistics
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetPassCount returns the value of PassCount, or the zero value when PassCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.PassCount)
+}
+
+// GetFailCount returns the value of FailCount, or the zero value when FailCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.FailCount)
+}
+
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
+
+// GetNotApplicableCount returns the value of NotApplicableCount, or the zero value when NotApplicableCount or the ResourceStatistics is nil
+func (o *ResourceStatistics) GetNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotApplicableCount)
 }
 
 // UnmarshalResourceStatistics unmarshals an instance of ResourceStatistics from the specified map of raw messages.
@@ -6096,6 +9109,10 @@ func UnmarshalResourceStatistics(m map[string]json.RawMessage, result interface{
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "pass_count", "fail_count", "unable_to_perform_count", "not_applicable_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6107,6 +9124,68 @@ type Result struct {
 
 	// A message is returned.
 	Message *string `json:"message" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Result
+func (o *Result) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Result
+func (o *Result) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Result
+func (o *Result) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Result
+func (o *Result) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Result
+func (o *Result) MarshalJSON() (buffer []byte, err error) {
+	type model Result
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetResult returns the value of Result, or the zero value when Result or the Result is nil
+func (o *Result) GetResult() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Result)
+}
+
+// GetMessage returns the value of Message, or the zero value when Message or the Result is nil
+func (o *Result) GetMessage() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Message)
 }
 
 // UnmarshalResult unmarshals an instance of Result from the specified map of raw messages.
@@ -6120,6 +9199,10 @@ func UnmarshalResult(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "result", "message")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6162,6 +9245,148 @@ type ScanItem struct {
 
 	// The result of a scan. The controls values are not available if no scopes are available.
 	Result *ScanResult `json:"result" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScanItem
+func (o *ScanItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScanItem
+func (o *ScanItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScanItem
+func (o *ScanItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScanItem
+func (o *ScanItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScanItem
+func (o *ScanItem) MarshalJSON() (buffer []byte, err error) {
+	type model ScanItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScanID returns the value of ScanID, or the zero value when ScanID or the ScanItem is nil
+func (o *ScanItem) GetScanID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanID)
+}
+
+// GetScanName returns the value of ScanName, or the zero value when ScanName or the ScanItem is nil
+func (o *ScanItem) GetScanName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanName)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the ScanItem is nil
+func (o *ScanItem) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeName returns the value of ScopeName, or the zero value when ScopeName or the ScanItem is nil
+func (o *ScanItem) GetScopeName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeName)
+}
+
+// GetProfiles returns Profiles, or nil when the ScanItem is nil
+func (o *ScanItem) GetProfiles() []ProfileItem {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
+}
+
+// GetGroupProfileID returns the value of GroupProfileID, or the zero value when GroupProfileID or the ScanItem is nil
+func (o *ScanItem) GetGroupProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileID)
+}
+
+// GetGroupProfileName returns the value of GroupProfileName, or the zero value when GroupProfileName or the ScanItem is nil
+func (o *ScanItem) GetGroupProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupProfileName)
+}
+
+// GetReportRunBy returns the value of ReportRunBy, or the zero value when ReportRunBy or the ScanItem is nil
+func (o *ScanItem) GetReportRunBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportRunBy)
+}
+
+// GetStartTime returns the value of StartTime, or the zero value when StartTime or the ScanItem is nil
+func (o *ScanItem) GetStartTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.StartTime)
+}
+
+// GetReportSettingID returns the value of ReportSettingID, or the zero value when ReportSettingID or the ScanItem is nil
+func (o *ScanItem) GetReportSettingID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportSettingID)
+}
+
+// GetEndTime returns the value of EndTime, or the zero value when EndTime or the ScanItem is nil
+func (o *ScanItem) GetEndTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.EndTime)
+}
+
+// GetResult returns Result, or nil when the ScanItem is nil
+func (o *ScanItem) GetResult() *ScanResult {
+	if o == nil {
+		return nil
+	}
+	return o.Result
 }
 
 // UnmarshalScanItem unmarshals an instance of ScanItem from the specified map of raw messages.
@@ -6215,6 +9440,10 @@ func UnmarshalScanItem(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scan_id", "scan_name", "scope_id", "scope_name", "profiles", "group_profile_id", "group_profile_name", "report_run_by", "start_time", "report_setting_id", "end_time", "result")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6245,6 +9474,116 @@ type ScanList struct {
 
 	// The details of a scan.
 	LatestScans []ScanItem `json:"latest_scans" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScanList
+func (o *ScanList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScanList
+func (o *ScanList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScanList
+func (o *ScanList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScanList
+func (o *ScanList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScanList
+func (o *ScanList) MarshalJSON() (buffer []byte, err error) {
+	type model ScanList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ScanList is nil
+func (o *ScanList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ScanList is nil
+func (o *ScanList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ScanList is nil
+func (o *ScanList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ScanList is nil
+func (o *ScanList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ScanList is nil
+func (o *ScanList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ScanList is nil
+func (o *ScanList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ScanList is nil
+func (o *ScanList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetLatestScans returns LatestScans, or nil when the ScanList is nil
+func (o *ScanList) GetLatestScans() []ScanItem {
+	if o == nil {
+		return nil
+	}
+	return o.LatestScans
 }
 
 // UnmarshalScanList unmarshals an instance of ScanList from the specified map of raw messages.
@@ -6282,6 +9621,10 @@ func UnmarshalScanList(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "latest_scans")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6319,25 +9662,151 @@ type ScanResult struct {
 	// The number of goals that failed the scan.
 	GoalsFailCount *int64 `json:"goals_fail_count" validate:"required"`
 
-	// The total number of goals that were included in the scan.
-	GoalsTotalCount *int64 `json:"goals_total_count" validate:"required"`
+	// The total number of goals that were included in the scan.
+	GoalsTotalCount *int64 `json:"goals_total_count" validate:"required"`
+
+	// The number of controls that passed the scan.
+	ControlsPassCount *int64 `json:"controls_pass_count" validate:"required"`
+
+	// The number of controls that failed the scan.
+	ControlsFailCount *int64 `json:"controls_fail_count" validate:"required"`
+
+	// The number of controls that are not relevant to the current scan. A scan is listed as 'Not applicable' when
+	// information about its associated resource can't be found.
+	ControlsNotApplicableCount *int64 `json:"controls_not_applicable_count" validate:"required"`
+
+	// The number of controls that can't be validated. A control is listed as 'Unable to perform' when information about
+	// its associated resource can't be collected.
+	ControlsUnableToPerformCount *int64 `json:"controls_unable_to_perform_count" validate:"required"`
+
+	// The total number of controls that are included in the scan.
+	ControlsTotalCount *int64 `json:"controls_total_count" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScanResult
+func (o *ScanResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScanResult
+func (o *ScanResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScanResult
+func (o *ScanResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScanResult
+func (o *ScanResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScanResult
+func (o *ScanResult) MarshalJSON() (buffer []byte, err error) {
+	type model ScanResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetGoalsPassCount returns the value of GoalsPassCount, or the zero value when GoalsPassCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsPassCount)
+}
+
+// GetGoalsUnableToPerformCount returns the value of GoalsUnableToPerformCount, or the zero value when GoalsUnableToPerformCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsUnableToPerformCount)
+}
+
+// GetGoalsNotApplicableCount returns the value of GoalsNotApplicableCount, or the zero value when GoalsNotApplicableCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsNotApplicableCount)
+}
+
+// GetGoalsFailCount returns the value of GoalsFailCount, or the zero value when GoalsFailCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsFailCount)
+}
+
+// GetGoalsTotalCount returns the value of GoalsTotalCount, or the zero value when GoalsTotalCount or the ScanResult is nil
+func (o *ScanResult) GetGoalsTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.GoalsTotalCount)
+}
 
-	// The number of controls that passed the scan.
-	ControlsPassCount *int64 `json:"controls_pass_count" validate:"required"`
+// GetControlsPassCount returns the value of ControlsPassCount, or the zero value when ControlsPassCount or the ScanResult is nil
+func (o *ScanResult) GetControlsPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsPassCount)
+}
 
-	// The number of controls that failed the scan.
-	ControlsFailCount *int64 `json:"controls_fail_count" validate:"required"`
+// GetControlsFailCount returns the value of ControlsFailCount, or the zero value when ControlsFailCount or the ScanResult is nil
+func (o *ScanResult) GetControlsFailCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsFailCount)
+}
 
-	// The number of controls that are not relevant to the current scan. A scan is listed as 'Not applicable' when
-	// information about its associated resource can't be found.
-	ControlsNotApplicableCount *int64 `json:"controls_not_applicable_count" validate:"required"`
+// GetControlsNotApplicableCount returns the value of ControlsNotApplicableCount, or the zero value when ControlsNotApplicableCount or the ScanResult is nil
+func (o *ScanResult) GetControlsNotApplicableCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsNotApplicableCount)
+}
 
-	// The number of controls that can't be validated. A control is listed as 'Unable to perform' when information about
-	// its associated resource can't be collected.
-	ControlsUnableToPerformCount *int64 `json:"controls_unable_to_perform_count" validate:"required"`
+// GetControlsUnableToPerformCount returns the value of ControlsUnableToPerformCount, or the zero value when ControlsUnableToPerformCount or the ScanResult is nil
+func (o *ScanResult) GetControlsUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsUnableToPerformCount)
+}
 
-	// The total number of controls that are included in the scan.
-	ControlsTotalCount *int64 `json:"controls_total_count" validate:"required"`
+// GetControlsTotalCount returns the value of ControlsTotalCount, or the zero value when ControlsTotalCount or the ScanResult is nil
+func (o *ScanResult) GetControlsTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ControlsTotalCount)
 }
 
 // UnmarshalScanResult unmarshals an instance of ScanResult from the specified map of raw messages.
@@ -6383,6 +9852,10 @@ func UnmarshalScanResult(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "goals_pass_count", "goals_unable_to_perform_count", "goals_not_applicable_count", "goals_fail_count", "goals_total_count", "controls_pass_count", "controls_fail_count", "controls_not_applicable_count", "controls_unable_to_perform_count", "controls_total_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6647,6 +10120,9 @@ type Scope struct {
 
 	// The credential attributes of the scope. The attributes are displayed only when the value exists.
 	CredentialAttributes *string `json:"credential_attributes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Scope.Status property.
@@ -6748,6 +10224,409 @@ const (
 	ScopeTypeValidationConst = "validation"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Scope
+func (o *Scope) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Scope
+func (o *Scope) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Scope
+func (o *Scope) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Scope
+func (o *Scope) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Scope
+func (o *Scope) MarshalJSON() (buffer []byte, err error) {
+	type model Scope
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Scope is nil
+func (o *Scope) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Scope is nil
+func (o *Scope) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetUUID returns the value of UUID, or the zero value when UUID or the Scope is nil
+func (o *Scope) GetUUID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UUID)
+}
+
+// GetPartnerUUID returns the value of PartnerUUID, or the zero value when PartnerUUID or the Scope is nil
+func (o *Scope) GetPartnerUUID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PartnerUUID)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Scope is nil
+func (o *Scope) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetOrgID returns the value of OrgID, or the zero value when OrgID or the Scope is nil
+func (o *Scope) GetOrgID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.OrgID)
+}
+
+// GetCloudTypeID returns the value of CloudTypeID, or the zero value when CloudTypeID or the Scope is nil
+func (o *Scope) GetCloudTypeID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CloudTypeID)
+}
+
+// GetTldCredentialID returns the value of TldCredentialID, or the zero value when TldCredentialID or the Scope is nil
+func (o *Scope) GetTldCredentialID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TldCredentialID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Scope is nil
+func (o *Scope) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetStatusMsg returns the value of StatusMsg, or the zero value when StatusMsg or the Scope is nil
+func (o *Scope) GetStatusMsg() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StatusMsg)
+}
+
+// GetSubsetSelected returns the value of SubsetSelected, or the zero value when SubsetSelected or the Scope is nil
+func (o *Scope) GetSubsetSelected() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.SubsetSelected)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the Scope is nil
+func (o *Scope) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
+// GetLastDiscoverStartTime returns the value of LastDiscoverStartTime, or the zero value when LastDiscoverStartTime or the Scope is nil
+func (o *Scope) GetLastDiscoverStartTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastDiscoverStartTime)
+}
+
+// GetLastDiscoverCompletedTime returns the value of LastDiscoverCompletedTime, or the zero value when LastDiscoverCompletedTime or the Scope is nil
+func (o *Scope) GetLastDiscoverCompletedTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastDiscoverCompletedTime)
+}
+
+// GetLastSuccessfulDiscoverStartTime returns the value of LastSuccessfulDiscoverStartTime, or the zero value when LastSuccessfulDiscoverStartTime or the Scope is nil
+func (o *Scope) GetLastSuccessfulDiscoverStartTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastSuccessfulDiscoverStartTime)
+}
+
+// GetLastSuccessfulDiscoverCompletedTime returns the value of LastSuccessfulDiscoverCompletedTime, or the zero value when LastSuccessfulDiscoverCompletedTime or the Scope is nil
+func (o *Scope) GetLastSuccessfulDiscoverCompletedTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.LastSuccessfulDiscoverCompletedTime)
+}
+
+// GetTaskType returns the value of TaskType, or the zero value when TaskType or the Scope is nil
+func (o *Scope) GetTaskType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskType)
+}
+
+// GetTasks returns Tasks, or nil when the Scope is nil
+func (o *Scope) GetTasks() []ScopeDetailsGatewayTask {
+	if o == nil {
+		return nil
+	}
+	return o.Tasks
+}
+
+// GetStatusUpdatedTime returns the value of StatusUpdatedTime, or the zero value when StatusUpdatedTime or the Scope is nil
+func (o *Scope) GetStatusUpdatedTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StatusUpdatedTime)
+}
+
+// GetCollectorsByType returns CollectorsByType, or nil when the Scope is nil
+func (o *Scope) GetCollectorsByType() map[string][]Collector {
+	if o == nil {
+		return nil
+	}
+	return o.CollectorsByType
+}
+
+// GetCredentialsByType returns CredentialsByType, or nil when the Scope is nil
+func (o *Scope) GetCredentialsByType() map[string][]ScopeDetailsCredential {
+	if o == nil {
+		return nil
+	}
+	return o.CredentialsByType
+}
+
+// GetCredentialsBySubCategeoryType returns CredentialsBySubCategeoryType, or nil when the Scope is nil
+func (o *Scope) GetCredentialsBySubCategeoryType() map[string][]ScopeDetailsCredential {
+	if o == nil {
+		return nil
+	}
+	return o.CredentialsBySubCategeoryType
+}
+
+// GetSubCategoriesByType returns SubCategoriesByType, or nil when the Scope is nil
+func (o *Scope) GetSubCategoriesByType() map[string][]string {
+	if o == nil {
+		return nil
+	}
+	return o.SubCategoriesByType
+}
+
+// GetResourceGroups returns the value of ResourceGroups, or the zero value when ResourceGroups or the Scope is nil
+func (o *Scope) GetResourceGroups() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceGroups)
+}
+
+// GetRegionNames returns the value of RegionNames, or the zero value when RegionNames or the Scope is nil
+func (o *Scope) GetRegionNames() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.RegionNames)
+}
+
+// GetCloudType returns the value of CloudType, or the zero value when CloudType or the Scope is nil
+func (o *Scope) GetCloudType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CloudType)
+}
+
+// GetEnvSubCategory returns the value of EnvSubCategory, or the zero value when EnvSubCategory or the Scope is nil
+func (o *Scope) GetEnvSubCategory() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.EnvSubCategory)
+}
+
+// GetTldCredentail returns TldCredentail, or nil when the Scope is nil
+func (o *Scope) GetTldCredentail() *ScopeDetailsCredential {
+	if o == nil {
+		return nil
+	}
+	return o.TldCredentail
+}
+
+// GetCollectors returns Collectors, or nil when the Scope is nil
+func (o *Scope) GetCollectors() []Collector {
+	if o == nil {
+		return nil
+	}
+	return o.Collectors
+}
+
+// GetFirstLevelScopedData returns FirstLevelScopedData, or nil when the Scope is nil
+func (o *Scope) GetFirstLevelScopedData() []ScopeDetailsAssetData {
+	if o == nil {
+		return nil
+	}
+	return o.FirstLevelScopedData
+}
+
+// GetDiscoveryMethods returns DiscoveryMethods, or nil when the Scope is nil
+func (o *Scope) GetDiscoveryMethods() []string {
+	if o == nil {
+		return nil
+	}
+	return o.DiscoveryMethods
+}
+
+// GetDiscoveryMethod returns the value of DiscoveryMethod, or the zero value when DiscoveryMethod or the Scope is nil
+func (o *Scope) GetDiscoveryMethod() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DiscoveryMethod)
+}
+
+// GetFileType returns the value of FileType, or the zero value when FileType or the Scope is nil
+func (o *Scope) GetFileType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.FileType)
+}
+
+// GetFileFormat returns the value of FileFormat, or the zero value when FileFormat or the Scope is nil
+func (o *Scope) GetFileFormat() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.FileFormat)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Scope is nil
+func (o *Scope) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Scope is nil
+func (o *Scope) GetCreatedAt() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the Scope is nil
+func (o *Scope) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetModifiedAt returns the value of ModifiedAt, or the zero value when ModifiedAt or the Scope is nil
+func (o *Scope) GetModifiedAt() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedAt)
+}
+
+// GetIsDiscoveryScheduled returns the value of IsDiscoveryScheduled, or the zero value when IsDiscoveryScheduled or the Scope is nil
+func (o *Scope) GetIsDiscoveryScheduled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsDiscoveryScheduled)
+}
+
+// GetInterval returns the value of Interval, or the zero value when Interval or the Scope is nil
+func (o *Scope) GetInterval() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Interval)
+}
+
+// GetDiscoverySettingID returns the value of DiscoverySettingID, or the zero value when DiscoverySettingID or the Scope is nil
+func (o *Scope) GetDiscoverySettingID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.DiscoverySettingID)
+}
+
+// GetIncludeNewEagerly returns the value of IncludeNewEagerly, or the zero value when IncludeNewEagerly or the Scope is nil
+func (o *Scope) GetIncludeNewEagerly() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IncludeNewEagerly)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Scope is nil
+func (o *Scope) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetCorrelationID returns the value of CorrelationID, or the zero value when CorrelationID or the Scope is nil
+func (o *Scope) GetCorrelationID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CorrelationID)
+}
+
+// GetCredentialAttributes returns the value of CredentialAttributes, or the zero value when CredentialAttributes or the Scope is nil
+func (o *Scope) GetCredentialAttributes() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialAttributes)
+}
+
 // UnmarshalScope unmarshals an instance of Scope from the specified map of raw messages.
 func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Scope)
@@ -6931,6 +10810,10 @@ func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "uuid", "partner_uuid", "description", "org_id", "cloud_type_id", "tld_credential_id", "status", "status_msg", "subset_selected", "enabled", "last_discover_start_time", "last_discover_completed_time", "last_successful_discover_start_time", "last_successful_discover_completed_time", "task_type", "tasks", "status_updated_time", "collectors_by_type", "credentials_by_type", "credentials_by_sub_categeory_type", "sub_categories_by_type", "resource_groups", "region_names", "cloud_type", "env_sub_category", "tld_credentail", "collectors", "first_level_scoped_data", "discovery_methods", "discovery_method", "file_type", "file_format", "created_by", "created_at", "modified_by", "modified_at", "is_discovery_scheduled", "interval", "discovery_setting_id", "include_new_eagerly", "type", "correlation_id", "credential_attributes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6939,6 +10822,9 @@ func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error
 type ScopeCollector struct {
 	// The collector IDs of the scope.
 	CollectorIds []string `json:"collector_ids" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewScopeCollector : Instantiate ScopeCollector (Generic Model Constructor)
@@ -6950,6 +10836,57 @@ func (*PostureManagementV2) NewScopeCollector(collectorIds []string) (_model *Sc
 	return
 }
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeCollector
+func (o *ScopeCollector) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeCollector
+func (o *ScopeCollector) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeCollector
+func (o *ScopeCollector) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeCollector
+func (o *ScopeCollector) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeCollector
+func (o *ScopeCollector) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeCollector
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCollectorIds returns CollectorIds, or nil when the ScopeCollector is nil
+func (o *ScopeCollector) GetCollectorIds() []string {
+	if o == nil {
+		return nil
+	}
+	return o.CollectorIds
+}
+
 // UnmarshalScopeCollector unmarshals an instance of ScopeCollector from the specified map of raw messages.
 func UnmarshalScopeCollector(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ScopeCollector)
@@ -6957,6 +10894,10 @@ func UnmarshalScopeCollector(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "collector_ids")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6968,15 +10909,77 @@ type ScopeCredential struct {
 
 	// The credential ID of the scope.
 	CredentialID *string `json:"credential_id" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// NewScopeCredential : Instantiate ScopeCredential (Generic Model Constructor)
+func (*PostureManagementV2) NewScopeCredential(credentialID string) (_model *ScopeCredential, err error) {
+	_model = &ScopeCredential{
+		CredentialID: core.StringPtr(credentialID),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	return
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeCredential
+func (o *ScopeCredential) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeCredential
+func (o *ScopeCredential) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeCredential
+func (o *ScopeCredential) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeCredential
+func (o *ScopeCredential) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeCredential
+func (o *ScopeCredential) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeCredential
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCredentialAttribute returns the value of CredentialAttribute, or the zero value when CredentialAttribute or the ScopeCredential is nil
+func (o *ScopeCredential) GetCredentialAttribute() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialAttribute)
 }
 
-// NewScopeCredential : Instantiate ScopeCredential (Generic Model Constructor)
-func (*PostureManagementV2) NewScopeCredential(credentialID string) (_model *ScopeCredential, err error) {
-	_model = &ScopeCredential{
-		CredentialID: core.StringPtr(credentialID),
+// GetCredentialID returns the value of CredentialID, or the zero value when CredentialID or the ScopeCredential is nil
+func (o *ScopeCredential) GetCredentialID() string {
+	if o == nil {
+		return ""
 	}
-	err = core.ValidateStruct(_model, "required parameters")
-	return
+	return common.Deref(o.CredentialID)
 }
 
 // UnmarshalScopeCredential unmarshals an instance of ScopeCredential from the specified map of raw messages.
@@ -6990,6 +10993,10 @@ func UnmarshalScopeCredential(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "credential_attribute", "credential_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7058,6 +11065,220 @@ type ScopeDetailsAssetData struct {
 
 	// The collector ID of the scope.
 	ScopeCollectorID *int64 `json:"scope_collector_id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeDetailsAssetData
+func (o *ScopeDetailsAssetData) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeDetailsAssetData
+func (o *ScopeDetailsAssetData) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeDetailsAssetData
+func (o *ScopeDetailsAssetData) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeDetailsAssetData
+func (o *ScopeDetailsAssetData) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeDetailsAssetData
+func (o *ScopeDetailsAssetData) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeDetailsAssetData
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetScopeObject returns the value of ScopeObject, or the zero value when ScopeObject or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeObject() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeObject)
+}
+
+// GetScopeInitScope returns the value of ScopeInitScope, or the zero value when ScopeInitScope or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeInitScope() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeInitScope)
+}
+
+// GetScope returns the value of Scope, or the zero value when Scope or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScope() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Scope)
+}
+
+// GetScopeChanged returns the value of ScopeChanged, or the zero value when ScopeChanged or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeChanged() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.ScopeChanged)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeProperties returns the value of ScopeProperties, or the zero value when ScopeProperties or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeProperties() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeProperties)
+}
+
+// GetScopeOverlay returns the value of ScopeOverlay, or the zero value when ScopeOverlay or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeOverlay() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeOverlay)
+}
+
+// GetScopeNewFound returns the value of ScopeNewFound, or the zero value when ScopeNewFound or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeNewFound() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.ScopeNewFound)
+}
+
+// GetScopeDiscoveryStatus returns ScopeDiscoveryStatus, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeDiscoveryStatus() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeDiscoveryStatus
+}
+
+// GetScopeFactStatus returns ScopeFactStatus, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeFactStatus() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeFactStatus
+}
+
+// GetScopeFacts returns the value of ScopeFacts, or the zero value when ScopeFacts or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeFacts() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeFacts)
+}
+
+// GetScopeListMembers returns ScopeListMembers, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeListMembers() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeListMembers
+}
+
+// GetScopeChildren returns ScopeChildren, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeChildren() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeChildren
+}
+
+// GetScopeResourceCategory returns the value of ScopeResourceCategory, or the zero value when ScopeResourceCategory or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeResourceCategory() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeResourceCategory)
+}
+
+// GetScopeResourceType returns the value of ScopeResourceType, or the zero value when ScopeResourceType or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeResourceType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeResourceType)
+}
+
+// GetScopeResource returns the value of ScopeResource, or the zero value when ScopeResource or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeResource() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeResource)
+}
+
+// GetScopeResourceAttributes returns ScopeResourceAttributes, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeResourceAttributes() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeResourceAttributes
+}
+
+// GetScopeDrift returns the value of ScopeDrift, or the zero value when ScopeDrift or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeDrift() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeDrift)
+}
+
+// GetScopeParseStatus returns the value of ScopeParseStatus, or the zero value when ScopeParseStatus or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeParseStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeParseStatus)
+}
+
+// GetScopeTransformedFacts returns ScopeTransformedFacts, or nil when the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeTransformedFacts() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ScopeTransformedFacts
+}
+
+// GetScopeCollectorID returns the value of ScopeCollectorID, or the zero value when ScopeCollectorID or the ScopeDetailsAssetData is nil
+func (o *ScopeDetailsAssetData) GetScopeCollectorID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ScopeCollectorID)
 }
 
 // UnmarshalScopeDetailsAssetData unmarshals an instance of ScopeDetailsAssetData from the specified map of raw messages.
@@ -7147,6 +11368,10 @@ func UnmarshalScopeDetailsAssetData(m map[string]json.RawMessage, result interfa
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "scope_object", "scope_init_scope", "scope", "scope_changed", "scope_id", "scope_properties", "scope_overlay", "scope_new_found", "scope_discovery_status", "scope_fact_status", "scope_facts", "scope_list_members", "scope_children", "scope_resource_category", "scope_resource_type", "scope_resource", "scope_resource_attributes", "scope_drift", "scope_parse_status", "scope_transformed_facts", "scope_collector_id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7185,6 +11410,140 @@ type ScopeDetailsCredential struct {
 
 	// The credential purpose of the scope.
 	Purpose *string `json:"purpose,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeDetailsCredential
+func (o *ScopeDetailsCredential) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeDetailsCredential
+func (o *ScopeDetailsCredential) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeDetailsCredential
+func (o *ScopeDetailsCredential) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeDetailsCredential
+func (o *ScopeDetailsCredential) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeDetailsCredential
+func (o *ScopeDetailsCredential) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeDetailsCredential
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetUUID returns the value of UUID, or the zero value when UUID or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetUUID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UUID)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetData returns Data, or nil when the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetData() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.Data
+}
+
+// GetDisplayFields returns DisplayFields, or nil when the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetDisplayFields() *ScopeDetailsCredentialDisplayFields {
+	if o == nil {
+		return nil
+	}
+	return o.DisplayFields
+}
+
+// GetVersionTimestamp returns VersionTimestamp, or nil when the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetVersionTimestamp() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.VersionTimestamp
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetIsEnabled returns the value of IsEnabled, or the zero value when IsEnabled or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetIsEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.IsEnabled)
+}
+
+// GetGatewayKey returns the value of GatewayKey, or the zero value when GatewayKey or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetGatewayKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GatewayKey)
+}
+
+// GetPurpose returns the value of Purpose, or the zero value when Purpose or the ScopeDetailsCredential is nil
+func (o *ScopeDetailsCredential) GetPurpose() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Purpose)
 }
 
 // UnmarshalScopeDetailsCredential unmarshals an instance of ScopeDetailsCredential from the specified map of raw messages.
@@ -7234,6 +11593,10 @@ func UnmarshalScopeDetailsCredential(m map[string]json.RawMessage, result interf
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "uuid", "type", "data", "display_fields", "version_timestamp", "description", "is_enabled", "gateway_key", "purpose")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7310,6 +11673,228 @@ type ScopeDetailsCredentialDisplayFields struct {
 	// The project domain name of the OpenStack cloud. The project domain name is mandatory when OpenStack is the
 	// credential type.
 	ProjectDomainName *string `json:"project_domain_name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeDetailsCredentialDisplayFields
+func (o *ScopeDetailsCredentialDisplayFields) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeDetailsCredentialDisplayFields
+func (o *ScopeDetailsCredentialDisplayFields) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeDetailsCredentialDisplayFields
+func (o *ScopeDetailsCredentialDisplayFields) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeDetailsCredentialDisplayFields
+func (o *ScopeDetailsCredentialDisplayFields) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeDetailsCredentialDisplayFields
+func (o *ScopeDetailsCredentialDisplayFields) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeDetailsCredentialDisplayFields
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetIBMAPIKey returns the value of IBMAPIKey, or the zero value when IBMAPIKey or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetIBMAPIKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.IBMAPIKey)
+}
+
+// GetAwsClientID returns the value of AwsClientID, or the zero value when AwsClientID or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAwsClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientID)
+}
+
+// GetAwsClientSecret returns the value of AwsClientSecret, or the zero value when AwsClientSecret or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAwsClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientSecret)
+}
+
+// GetAwsRegion returns the value of AwsRegion, or the zero value when AwsRegion or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAwsRegion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsRegion)
+}
+
+// GetAwsArn returns the value of AwsArn, or the zero value when AwsArn or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAwsArn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsArn)
+}
+
+// GetUsername returns the value of Username, or the zero value when Username or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetUsername() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Username)
+}
+
+// GetPassword returns the value of Password, or the zero value when Password or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetPassword() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Password)
+}
+
+// GetAzureClientID returns the value of AzureClientID, or the zero value when AzureClientID or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAzureClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientID)
+}
+
+// GetAzureClientSecret returns the value of AzureClientSecret, or the zero value when AzureClientSecret or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAzureClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientSecret)
+}
+
+// GetAzureSubscriptionID returns the value of AzureSubscriptionID, or the zero value when AzureSubscriptionID or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAzureSubscriptionID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureSubscriptionID)
+}
+
+// GetAzureResourceGroup returns the value of AzureResourceGroup, or the zero value when AzureResourceGroup or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAzureResourceGroup() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureResourceGroup)
+}
+
+// GetDatabaseName returns the value of DatabaseName, or the zero value when DatabaseName or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetDatabaseName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DatabaseName)
+}
+
+// GetWinrmAuthtype returns the value of WinrmAuthtype, or the zero value when WinrmAuthtype or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetWinrmAuthtype() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmAuthtype)
+}
+
+// GetWinrmUsessl returns the value of WinrmUsessl, or the zero value when WinrmUsessl or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetWinrmUsessl() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmUsessl)
+}
+
+// GetWinrmPort returns the value of WinrmPort, or the zero value when WinrmPort or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetWinrmPort() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmPort)
+}
+
+// GetMs365ClientID returns the value of Ms365ClientID, or the zero value when Ms365ClientID or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetMs365ClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientID)
+}
+
+// GetMs365ClientSecret returns the value of Ms365ClientSecret, or the zero value when Ms365ClientSecret or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetMs365ClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientSecret)
+}
+
+// GetMs365TenantID returns the value of Ms365TenantID, or the zero value when Ms365TenantID or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetMs365TenantID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365TenantID)
+}
+
+// GetAuthURL returns the value of AuthURL, or the zero value when AuthURL or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetAuthURL() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AuthURL)
+}
+
+// GetProjectName returns the value of ProjectName, or the zero value when ProjectName or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetProjectName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectName)
+}
+
+// GetUserDomainName returns the value of UserDomainName, or the zero value when UserDomainName or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetUserDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UserDomainName)
+}
+
+// GetProjectDomainName returns the value of ProjectDomainName, or the zero value when ProjectDomainName or the ScopeDetailsCredentialDisplayFields is nil
+func (o *ScopeDetailsCredentialDisplayFields) GetProjectDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectDomainName)
 }
 
 // UnmarshalScopeDetailsCredentialDisplayFields unmarshals an instance of ScopeDetailsCredentialDisplayFields from the specified map of raw messages.
@@ -7403,6 +11988,10 @@ func UnmarshalScopeDetailsCredentialDisplayFields(m map[string]json.RawMessage,
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "ibm_api_key", "aws_client_id", "aws_client_secret", "aws_region", "aws_arn", "username", "password", "azure_client_id", "azure_client_secret", "azure_subscription_id", "azure_resource_group", "database_name", "winrm_authtype", "winrm_usessl", "winrm_port", "ms_365_client_id", "ms_365_client_secret", "ms_365_tenant_id", "auth_url", "project_name", "user_domain_name", "project_domain_name")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7450,6 +12039,9 @@ type ScopeDetailsGatewayTask struct {
 
 	// The user who created the task that is associated with scope.
 	TaskCreatedBy *string `json:"task_created_by,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ScopeDetailsGatewayTask.TaskTaskType property.
@@ -7602,6 +12194,161 @@ const (
 	ScopeDetailsGatewayTaskTaskDerivedStatusWaitingForRefineConst                = "waiting_for_refine"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeDetailsGatewayTask
+func (o *ScopeDetailsGatewayTask) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeDetailsGatewayTask
+func (o *ScopeDetailsGatewayTask) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeDetailsGatewayTask
+func (o *ScopeDetailsGatewayTask) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeDetailsGatewayTask
+func (o *ScopeDetailsGatewayTask) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeDetailsGatewayTask
+func (o *ScopeDetailsGatewayTask) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeDetailsGatewayTask
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetTaskLogs returns TaskLogs, or nil when the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskLogs() []TaskLogs {
+	if o == nil {
+		return nil
+	}
+	return o.TaskLogs
+}
+
+// GetTaskID returns the value of TaskID, or the zero value when TaskID or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskID)
+}
+
+// GetTaskGatewayID returns the value of TaskGatewayID, or the zero value when TaskGatewayID or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskGatewayID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskGatewayID)
+}
+
+// GetTaskGatewayName returns the value of TaskGatewayName, or the zero value when TaskGatewayName or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskGatewayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskGatewayName)
+}
+
+// GetTaskTaskType returns the value of TaskTaskType, or the zero value when TaskTaskType or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskTaskType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskTaskType)
+}
+
+// GetTaskGatewaySchemaID returns the value of TaskGatewaySchemaID, or the zero value when TaskGatewaySchemaID or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskGatewaySchemaID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskGatewaySchemaID)
+}
+
+// GetTaskSchemaName returns the value of TaskSchemaName, or the zero value when TaskSchemaName or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskSchemaName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskSchemaName)
+}
+
+// GetTaskDiscoverID returns the value of TaskDiscoverID, or the zero value when TaskDiscoverID or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskDiscoverID() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskDiscoverID)
+}
+
+// GetTaskStatus returns the value of TaskStatus, or the zero value when TaskStatus or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskStatus)
+}
+
+// GetTaskStatusMsg returns the value of TaskStatusMsg, or the zero value when TaskStatusMsg or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskStatusMsg() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskStatusMsg)
+}
+
+// GetTaskStartTime returns the value of TaskStartTime, or the zero value when TaskStartTime or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskStartTime() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskStartTime)
+}
+
+// GetTaskUpdatedTime returns the value of TaskUpdatedTime, or the zero value when TaskUpdatedTime or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskUpdatedTime() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TaskUpdatedTime)
+}
+
+// GetTaskDerivedStatus returns the value of TaskDerivedStatus, or the zero value when TaskDerivedStatus or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskDerivedStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskDerivedStatus)
+}
+
+// GetTaskCreatedBy returns the value of TaskCreatedBy, or the zero value when TaskCreatedBy or the ScopeDetailsGatewayTask is nil
+func (o *ScopeDetailsGatewayTask) GetTaskCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.TaskCreatedBy)
+}
+
 // UnmarshalScopeDetailsGatewayTask unmarshals an instance of ScopeDetailsGatewayTask from the specified map of raw messages.
 func UnmarshalScopeDetailsGatewayTask(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ScopeDetailsGatewayTask)
@@ -7661,6 +12408,10 @@ func UnmarshalScopeDetailsGatewayTask(m map[string]json.RawMessage, result inter
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "task_logs", "task_id", "task_gateway_id", "task_gateway_name", "task_task_type", "task_gateway_schema_id", "task_schema_name", "task_discover_id", "task_status", "task_status_msg", "task_start_time", "task_updated_time", "task_derived_status", "task_created_by")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7699,6 +12450,9 @@ type ScopeItem struct {
 
 	// The collectors of the scope. The collectors are displayed only when the values exist.
 	Collectors []Collector `json:"collectors" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ScopeItem.CredentialType property.
@@ -7714,6 +12468,137 @@ const (
 	ScopeItemCredentialTypeServicesConst  = "services"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeItem
+func (o *ScopeItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeItem
+func (o *ScopeItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeItem
+func (o *ScopeItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeItem
+func (o *ScopeItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeItem
+func (o *ScopeItem) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ScopeItem is nil
+func (o *ScopeItem) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the ScopeItem is nil
+func (o *ScopeItem) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetModifiedBy returns the value of ModifiedBy, or the zero value when ModifiedBy or the ScopeItem is nil
+func (o *ScopeItem) GetModifiedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ModifiedBy)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ScopeItem is nil
+func (o *ScopeItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetUUID returns the value of UUID, or the zero value when UUID or the ScopeItem is nil
+func (o *ScopeItem) GetUUID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UUID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ScopeItem is nil
+func (o *ScopeItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetEnabled returns the value of Enabled, or the zero value when Enabled or the ScopeItem is nil
+func (o *ScopeItem) GetEnabled() bool {
+	if o == nil {
+		return false
+	}
+	return common.Deref(o.Enabled)
+}
+
+// GetCredentialType returns the value of CredentialType, or the zero value when CredentialType or the ScopeItem is nil
+func (o *ScopeItem) GetCredentialType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CredentialType)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the ScopeItem is nil
+func (o *ScopeItem) GetCreatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the ScopeItem is nil
+func (o *ScopeItem) GetUpdatedAt() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetCollectors returns Collectors, or nil when the ScopeItem is nil
+func (o *ScopeItem) GetCollectors() []Collector {
+	if o == nil {
+		return nil
+	}
+	return o.Collectors
+}
+
 // UnmarshalScopeItem unmarshals an instance of ScopeItem from the specified map of raw messages.
 func UnmarshalScopeItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ScopeItem)
@@ -7761,6 +12646,10 @@ func UnmarshalScopeItem(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "description", "created_by", "modified_by", "id", "uuid", "name", "enabled", "credential_type", "created_at", "updated_at", "collectors")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7791,6 +12680,116 @@ type ScopeList struct {
 
 	// Scopes.
 	Scopes []ScopeItem `json:"scopes" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeList
+func (o *ScopeList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeList
+func (o *ScopeList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeList
+func (o *ScopeList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeList
+func (o *ScopeList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeList
+func (o *ScopeList) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the ScopeList is nil
+func (o *ScopeList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ScopeList is nil
+func (o *ScopeList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ScopeList is nil
+func (o *ScopeList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the ScopeList is nil
+func (o *ScopeList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the ScopeList is nil
+func (o *ScopeList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the ScopeList is nil
+func (o *ScopeList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the ScopeList is nil
+func (o *ScopeList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetScopes returns Scopes, or nil when the ScopeList is nil
+func (o *ScopeList) GetScopes() []ScopeItem {
+	if o == nil {
+		return nil
+	}
+	return o.Scopes
 }
 
 // UnmarshalScopeList unmarshals an instance of ScopeList from the specified map of raw messages.
@@ -7828,6 +12827,10 @@ func UnmarshalScopeList(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "scopes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7845,6 +12848,84 @@ type ScopeTaskStatus struct {
 
 	// The time that the scope was last updated. This value exists when a collector is installed and running.
 	LastHeartbeat *strfmt.DateTime `json:"last_heartbeat" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ScopeTaskStatus
+func (o *ScopeTaskStatus) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ScopeTaskStatus
+func (o *ScopeTaskStatus) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ScopeTaskStatus
+func (o *ScopeTaskStatus) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ScopeTaskStatus
+func (o *ScopeTaskStatus) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ScopeTaskStatus
+func (o *ScopeTaskStatus) MarshalJSON() (buffer []byte, err error) {
+	type model ScopeTaskStatus
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetCorrelationID returns the value of CorrelationID, or the zero value when CorrelationID or the ScopeTaskStatus is nil
+func (o *ScopeTaskStatus) GetCorrelationID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CorrelationID)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ScopeTaskStatus is nil
+func (o *ScopeTaskStatus) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetStartTime returns the value of StartTime, or the zero value when StartTime or the ScopeTaskStatus is nil
+func (o *ScopeTaskStatus) GetStartTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.StartTime)
+}
+
+// GetLastHeartbeat returns the value of LastHeartbeat, or the zero value when LastHeartbeat or the ScopeTaskStatus is nil
+func (o *ScopeTaskStatus) GetLastHeartbeat() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.LastHeartbeat)
 }
 
 // UnmarshalScopeTaskStatus unmarshals an instance of ScopeTaskStatus from the specified map of raw messages.
@@ -7866,6 +12947,10 @@ func UnmarshalScopeTaskStatus(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "correlation_id", "status", "start_time", "last_heartbeat")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -7889,6 +12974,100 @@ type Summary struct {
 
 	// The list of controls that are on the scan summary.
 	Controls []Control `json:"controls" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Summary
+func (o *Summary) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Summary
+func (o *Summary) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Summary
+func (o *Summary) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Summary
+func (o *Summary) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Summary
+func (o *Summary) MarshalJSON() (buffer []byte, err error) {
+	type model Summary
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Summary is nil
+func (o *Summary) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetDiscoverID returns the value of DiscoverID, or the zero value when DiscoverID or the Summary is nil
+func (o *Summary) GetDiscoverID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DiscoverID)
+}
+
+// GetProfileID returns the value of ProfileID, or the zero value when ProfileID or the Summary is nil
+func (o *Summary) GetProfileID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileID)
+}
+
+// GetProfileName returns the value of ProfileName, or the zero value when ProfileName or the Summary is nil
+func (o *Summary) GetProfileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProfileName)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the Summary is nil
+func (o *Summary) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetControls returns Controls, or nil when the Summary is nil
+func (o *Summary) GetControls() []Control {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
 }
 
 // UnmarshalSummary unmarshals an instance of Summary from the specified map of raw messages.
@@ -7914,7 +13093,11 @@ func UnmarshalSummary(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
-	err = core.UnmarshalModel(m, "controls", &obj.Controls, UnmarshalControl)
+	err = core.UnmarshalModel(m, "controls", &obj.Controls, UnmarshalControl)
+	if err != nil {
+		return
+	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "discover_id", "profile_id", "profile_name", "scope_id", "controls")
 	if err != nil {
 		return
 	}
@@ -7954,6 +13137,9 @@ type SummaryItem struct {
 
 	// The list of group profiles.
 	GroupProfiles []ProfileResult `json:"group_profiles" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the SummaryItem.Status property.
@@ -8001,6 +13187,129 @@ const (
 	SummaryItemStatusWaitingForRefineConst                = "waiting_for_refine"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummaryItem
+func (o *SummaryItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummaryItem
+func (o *SummaryItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummaryItem
+func (o *SummaryItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummaryItem
+func (o *SummaryItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummaryItem
+func (o *SummaryItem) MarshalJSON() (buffer []byte, err error) {
+	type model SummaryItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the SummaryItem is nil
+func (o *SummaryItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the SummaryItem is nil
+func (o *SummaryItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetScopeID returns the value of ScopeID, or the zero value when ScopeID or the SummaryItem is nil
+func (o *SummaryItem) GetScopeID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeID)
+}
+
+// GetScopeName returns the value of ScopeName, or the zero value when ScopeName or the SummaryItem is nil
+func (o *SummaryItem) GetScopeName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScopeName)
+}
+
+// GetReportRunBy returns the value of ReportRunBy, or the zero value when ReportRunBy or the SummaryItem is nil
+func (o *SummaryItem) GetReportRunBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportRunBy)
+}
+
+// GetStartTime returns the value of StartTime, or the zero value when StartTime or the SummaryItem is nil
+func (o *SummaryItem) GetStartTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.StartTime)
+}
+
+// GetEndTime returns the value of EndTime, or the zero value when EndTime or the SummaryItem is nil
+func (o *SummaryItem) GetEndTime() strfmt.DateTime {
+	if o == nil {
+		return strfmt.DateTime{}
+	}
+	return common.Deref(o.EndTime)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the SummaryItem is nil
+func (o *SummaryItem) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetProfiles returns Profiles, or nil when the SummaryItem is nil
+func (o *SummaryItem) GetProfiles() []ProfileResult {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
+}
+
+// GetGroupProfiles returns GroupProfiles, or nil when the SummaryItem is nil
+func (o *SummaryItem) GetGroupProfiles() []ProfileResult {
+	if o == nil {
+		return nil
+	}
+	return o.GroupProfiles
+}
+
 // UnmarshalSummaryItem unmarshals an instance of SummaryItem from the specified map of raw messages.
 func UnmarshalSummaryItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SummaryItem)
@@ -8044,6 +13353,10 @@ func UnmarshalSummaryItem(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "scope_id", "scope_name", "report_run_by", "start_time", "end_time", "status", "profiles", "group_profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -8073,6 +13386,116 @@ type SummaryList struct {
 
 	// Summaries.
 	Summaries []SummaryItem `json:"summaries" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of SummaryList
+func (o *SummaryList) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of SummaryList
+func (o *SummaryList) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of SummaryList
+func (o *SummaryList) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of SummaryList
+func (o *SummaryList) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SummaryList
+func (o *SummaryList) MarshalJSON() (buffer []byte, err error) {
+	type model SummaryList
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetOffset returns the value of Offset, or the zero value when Offset or the SummaryList is nil
+func (o *SummaryList) GetOffset() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Offset)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the SummaryList is nil
+func (o *SummaryList) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the SummaryList is nil
+func (o *SummaryList) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetFirst returns First, or nil when the SummaryList is nil
+func (o *SummaryList) GetFirst() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetLast returns Last, or nil when the SummaryList is nil
+func (o *SummaryList) GetLast() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Last
+}
+
+// GetPrevious returns Previous, or nil when the SummaryList is nil
+func (o *SummaryList) GetPrevious() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Previous
+}
+
+// GetNext returns Next, or nil when the SummaryList is nil
+func (o *SummaryList) GetNext() *PageLink {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetSummaries returns Summaries, or nil when the SummaryList is nil
+func (o *SummaryList) GetSummaries() []SummaryItem {
+	if o == nil {
+		return nil
+	}
+	return o.Summaries
 }
 
 // UnmarshalSummaryList unmarshals an instance of SummaryList from the specified map of raw messages.
@@ -8110,6 +13533,10 @@ func UnmarshalSummaryList(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "offset", "limit", "total_count", "first", "last", "previous", "next", "summaries")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -8133,11 +13560,60 @@ func (resp *SummaryList) GetNextOffset() (*int64, error) {
 
 // TaskLogs : The logs for the tasks that you ran.
 type TaskLogs struct {
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of TaskLogs
+func (o *TaskLogs) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of TaskLogs
+func (o *TaskLogs) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of TaskLogs
+func (o *TaskLogs) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of TaskLogs
+func (o *TaskLogs) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of TaskLogs
+func (o *TaskLogs) MarshalJSON() (buffer []byte, err error) {
+	type model TaskLogs
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
 }
 
 // UnmarshalTaskLogs unmarshals an instance of TaskLogs from the specified map of raw messages.
 func UnmarshalTaskLogs(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(TaskLogs)
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m)
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -8273,6 +13749,244 @@ type UpdateCredentialDisplayFields struct {
 
 	// The base64 encoded form of pem.
 	PemData *string `json:"pem_data,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of UpdateCredentialDisplayFields
+func (o *UpdateCredentialDisplayFields) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of UpdateCredentialDisplayFields
+func (o *UpdateCredentialDisplayFields) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of UpdateCredentialDisplayFields
+func (o *UpdateCredentialDisplayFields) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of UpdateCredentialDisplayFields
+func (o *UpdateCredentialDisplayFields) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of UpdateCredentialDisplayFields
+func (o *UpdateCredentialDisplayFields) MarshalJSON() (buffer []byte, err error) {
+	type model UpdateCredentialDisplayFields
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetIBMAPIKey returns the value of IBMAPIKey, or the zero value when IBMAPIKey or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetIBMAPIKey() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.IBMAPIKey)
+}
+
+// GetAwsClientID returns the value of AwsClientID, or the zero value when AwsClientID or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAwsClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientID)
+}
+
+// GetAwsClientSecret returns the value of AwsClientSecret, or the zero value when AwsClientSecret or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAwsClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsClientSecret)
+}
+
+// GetAwsRegion returns the value of AwsRegion, or the zero value when AwsRegion or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAwsRegion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsRegion)
+}
+
+// GetAwsArn returns the value of AwsArn, or the zero value when AwsArn or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAwsArn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AwsArn)
+}
+
+// GetUsername returns the value of Username, or the zero value when Username or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetUsername() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Username)
+}
+
+// GetPassword returns the value of Password, or the zero value when Password or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetPassword() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Password)
+}
+
+// GetAzureClientID returns the value of AzureClientID, or the zero value when AzureClientID or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAzureClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientID)
+}
+
+// GetAzureClientSecret returns the value of AzureClientSecret, or the zero value when AzureClientSecret or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAzureClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureClientSecret)
+}
+
+// GetAzureSubscriptionID returns the value of AzureSubscriptionID, or the zero value when AzureSubscriptionID or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAzureSubscriptionID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureSubscriptionID)
+}
+
+// GetAzureResourceGroup returns the value of AzureResourceGroup, or the zero value when AzureResourceGroup or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAzureResourceGroup() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AzureResourceGroup)
+}
+
+// GetDatabaseName returns the value of DatabaseName, or the zero value when DatabaseName or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetDatabaseName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.DatabaseName)
+}
+
+// GetWinrmAuthtype returns the value of WinrmAuthtype, or the zero value when WinrmAuthtype or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetWinrmAuthtype() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmAuthtype)
+}
+
+// GetWinrmUsessl returns the value of WinrmUsessl, or the zero value when WinrmUsessl or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetWinrmUsessl() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmUsessl)
+}
+
+// GetWinrmPort returns the value of WinrmPort, or the zero value when WinrmPort or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetWinrmPort() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.WinrmPort)
+}
+
+// GetMs365ClientID returns the value of Ms365ClientID, or the zero value when Ms365ClientID or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetMs365ClientID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientID)
+}
+
+// GetMs365ClientSecret returns the value of Ms365ClientSecret, or the zero value when Ms365ClientSecret or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetMs365ClientSecret() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365ClientSecret)
+}
+
+// GetMs365TenantID returns the value of Ms365TenantID, or the zero value when Ms365TenantID or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetMs365TenantID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Ms365TenantID)
+}
+
+// GetAuthURL returns the value of AuthURL, or the zero value when AuthURL or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetAuthURL() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AuthURL)
+}
+
+// GetProjectName returns the value of ProjectName, or the zero value when ProjectName or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetProjectName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectName)
+}
+
+// GetUserDomainName returns the value of UserDomainName, or the zero value when UserDomainName or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetUserDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UserDomainName)
+}
+
+// GetProjectDomainName returns the value of ProjectDomainName, or the zero value when ProjectDomainName or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetProjectDomainName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ProjectDomainName)
+}
+
+// GetPemFileName returns the value of PemFileName, or the zero value when PemFileName or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetPemFileName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemFileName)
+}
+
+// GetPemData returns the value of PemData, or the zero value when PemData or the UpdateCredentialDisplayFields is nil
+func (o *UpdateCredentialDisplayFields) GetPemData() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PemData)
 }
 
 // UnmarshalUpdateCredentialDisplayFields unmarshals an instance of UpdateCredentialDisplayFields from the specified map of raw messages.
@@ -8374,6 +14088,10 @@ func UnmarshalUpdateCredentialDisplayFields(m map[string]json.RawMessage, result
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "ibm_api_key", "aws_client_id", "aws_client_secret", "aws_region", "aws_arn", "username", "password", "azure_client_id", "azure_client_secret", "azure_subscription_id", "azure_resource_group", "database_name", "winrm_authtype", "winrm_usessl", "winrm_port", "ms_365_client_id", "ms_365_client_secret", "ms_365_tenant_id", "auth_url", "project_name", "user_domain_name", "project_domain_name", "pem_file_name", "pem_data")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -8408,6 +14126,10 @@ type UpdateCredentialOptions struct {
 	// sends a transaction ID as a response header of the request.
 	TransactionID *string `json:"-"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -8503,6 +14225,24 @@ func (options *UpdateCredentialOptions) SetHeaders(param map[string]string) *Upd
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateCredentialOptions) SetAdditionalProperty(key string, value interface{}) *UpdateCredentialOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateCredentialOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateCredentialOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // UpdateProfilesOptions : The UpdateProfiles options.
 type UpdateProfilesOptions struct {
 	// The ID for the API.
@@ -8535,6 +14275,10 @@ type UpdateProfilesOptions struct {
 	// sends a transaction ID as a response header of the request.
 	TransactionID *string `json:"-"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -8614,6 +14358,24 @@ func (options *UpdateProfilesOptions) SetHeaders(param map[string]string) *Updat
 	return options
 }
 
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateProfilesOptions) SetAdditionalProperty(key string, value interface{}) *UpdateProfilesOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateProfilesOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateProfilesOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
+
 // UpdateScopeDetailsOptions : The UpdateScopeDetails options.
 type UpdateScopeDetailsOptions struct {
 	// The ID for the API.
@@ -8632,6 +14394,10 @@ type UpdateScopeDetailsOptions struct {
 	// sends a transaction ID as a response header of the request.
 	TransactionID *string `json:"-"`
 
+	// Properties that are sent in the request body in addition to the declared ones, for example the
+	// additional properties of a fetched model. The declared properties take precedence.
+	AdditionalProperties map[string]interface{} `json:"-"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -8678,3 +14444,21 @@ func (options *UpdateScopeDetailsOptions) SetHeaders(param map[string]string) *U
 	options.Headers = param
 	return options
 }
+
+// SetAdditionalProperty : Allow user to set an additional property
+func (options *UpdateScopeDetailsOptions) SetAdditionalProperty(key string, value interface{}) *UpdateScopeDetailsOptions {
+	if options.AdditionalProperties == nil {
+		options.AdditionalProperties = make(map[string]interface{})
+	}
+	options.AdditionalProperties[key] = value
+	return options
+}
+
+// SetAdditionalProperties : Allow user to set AdditionalProperties
+func (options *UpdateScopeDetailsOptions) SetAdditionalProperties(param map[string]interface{}) *UpdateScopeDetailsOptions {
+	options.AdditionalProperties = make(map[string]interface{})
+	for k, v := range param {
+		options.AdditionalProperties[k] = v
+	}
+	return options
+}
@@ -0,0 +1,407 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The profile import file is a CSV file. It starts with one "key","value" row for every profile attribute, followed
+// by a "##METAINFO ENDS##" row, a header row and one row for every control:
+//
+//	"profilename","My profile"
+//	"profilemnemonic","MP"
+//	"profiledescription","My custom profile"
+//	"##METAINFO ENDS##"
+//	"ExternalControlId","Description","Parent","ControlId","Tags"
+//	"AC-1","Access control policy","","ctrl-1","nist;access"
+//
+// Tags holds a list separated by ProfileImportListSeparator. The file has no goal columns, so the goals of a
+// ProfileImport and the goal references of its controls are validated but not written or read.
+
+// Constants used in the profile import file format.
+const (
+	ProfileImportMetaInfoEnd   = "##METAINFO ENDS##"
+	ProfileImportListSeparator = ";"
+)
+
+// The metadata keys of the profile import file.
+const (
+	profileImportNameKey        = "profilename"
+	profileImportMnemonicKey    = "profilemnemonic"
+	profileImportDescriptionKey = "profiledescription"
+)
+
+// The control columns of the profile import file, in the order in which they are written.
+var profileImportColumns = []string{"ExternalControlId", "Description", "Parent", "ControlId", "Tags"}
+
+// ProfileImport : A custom profile as it is authored locally and uploaded with ImportProfiles.
+type ProfileImport struct {
+	// The name of the profile.
+	Name string
+
+	// A short mnemonic of the profile.
+	Mnemonic string
+
+	// The description of the profile.
+	Description string
+
+	// The controls of the profile.
+	Controls []ProfileImportControl
+
+	// The goals that the controls refer to. The import file format cannot hold goals, so they are not uploaded.
+	Goals []ProfileImportGoal
+}
+
+// ProfileImportControl : A control of a profile import, mirroring ControlItem.
+type ProfileImportControl struct {
+	// The external identifier of the control, for example the ID in the compliance framework.
+	ExternalControlID string
+
+	// The description of the control.
+	Description string
+
+	// The ControlID of the parent control, or empty for a top-level control.
+	Parent string
+
+	// The identifier of the control. It must be unique within the profile.
+	ControlID string
+
+	// The tags of the control.
+	Tags []string
+
+	// The IDs of the goals of the profile that are mapped to the control. Not part of the import file format.
+	GoalIDs []string
+}
+
+// ProfileImportGoal : A goal of a profile import, mirroring GoalItem.
+type ProfileImportGoal struct {
+	// The goal ID. It must be unique within the profile.
+	ID string
+
+	// The description of the goal.
+	Description string
+
+	// The severity of the goal.
+	Severity string
+
+	// The goal is manually checked.
+	IsManual bool
+
+	// The goal is remediable or not.
+	IsRemediable bool
+
+	// The goal is reversible or not.
+	IsReversible bool
+
+	// The goal is automatable or not.
+	IsAutomatable bool
+
+	// The goal is autoremediable or not.
+	IsAutoRemediable bool
+}
+
+// ProfileImportValidationError : Returned by ProfileImport.Validate with every problem that was found.
+type ProfileImportValidationError struct {
+	Problems []string
+}
+
+func (e *ProfileImportValidationError) Error() string {
+	return fmt.Sprintf("invalid profile import: %s", strings.Join(e.Problems, "; "))
+}
+
+// NewProfileImportFromControls : Instantiate a ProfileImport from an existing profile and its controls, for example
+// to copy a profile into a new custom profile. The goals of the controls are collected into Goals, once per goal ID.
+func NewProfileImportFromControls(profile *Profile, controls []ControlItem) *ProfileImport {
+	profileImport := new(ProfileImport)
+	if profile != nil {
+		profileImport.Name = core.StringNilMapper(profile.Name)
+		profileImport.Description = core.StringNilMapper(profile.Description)
+	}
+	goals := make(map[string]bool)
+	for _, control := range controls {
+		importControl := ProfileImportControl{
+			ExternalControlID: core.StringNilMapper(control.ExternalControlID),
+			Description:       core.StringNilMapper(control.Description),
+			ControlID:         core.StringNilMapper(control.ID),
+		}
+		for i := range control.Goals {
+			goal := &control.Goals[i]
+			if goal.ID == nil {
+				continue
+			}
+			importControl.GoalIDs = append(importControl.GoalIDs, *goal.ID)
+			if !goals[*goal.ID] {
+				goals[*goal.ID] = true
+				profileImport.Goals = append(profileImport.Goals, ProfileImportGoal{
+					ID:               *goal.ID,
+					Description:      goal.GetDescription(),
+					Severity:         goal.GetSeverity(),
+					IsManual:         goal.GetIsManual(),
+					IsRemediable:     goal.GetIsRemediable(),
+					IsReversible:     goal.GetIsReversible(),
+					IsAutomatable:    goal.GetIsAutomatable(),
+					IsAutoRemediable: goal.GetIsAutoRemediable(),
+				})
+			}
+		}
+		profileImport.Controls = append(profileImport.Controls, importControl)
+	}
+	return profileImport
+}
+
+// AddControl : Append a control to the profile
+func (profileImport *ProfileImport) AddControl(control ProfileImportControl) *ProfileImport {
+	profileImport.Controls = append(profileImport.Controls, control)
+	return profileImport
+}
+
+// AddGoal : Append a goal to the profile
+func (profileImport *ProfileImport) AddGoal(goal ProfileImportGoal) *ProfileImport {
+	profileImport.Goals = append(profileImport.Goals, goal)
+	return profileImport
+}
+
+// Validate checks that the profile has a name, that control IDs, external control IDs and goal IDs are unique, that
+// every parent refers to a control of the profile without forming a cycle and that every goal ID of a control refers
+// to a goal of the profile, once. Every problem is reported in a *ProfileImportValidationError.
+func (profileImport *ProfileImport) Validate() error {
+	var problems []string
+	if strings.TrimSpace(profileImport.Name) == "" {
+		problems = append(problems, "the profile name is empty")
+	}
+	if len(profileImport.Controls) == 0 {
+		problems = append(problems, "the profile has no controls")
+	}
+
+	controls := make(map[string]*ProfileImportControl)
+	externalIDs := make(map[string]bool)
+	for i := range profileImport.Controls {
+		control := &profileImport.Controls[i]
+		if control.ControlID == "" {
+			problems = append(problems, fmt.Sprintf("control %d has no ControlId", i+1))
+		} else if controls[control.ControlID] != nil {
+			problems = append(problems, fmt.Sprintf("duplicate ControlId %q", control.ControlID))
+		} else {
+			controls[control.ControlID] = control
+		}
+		if control.ExternalControlID == "" {
+			problems = append(problems, fmt.Sprintf("control %d has no ExternalControlId", i+1))
+		} else if externalIDs[control.ExternalControlID] {
+			problems = append(problems, fmt.Sprintf("duplicate ExternalControlId %q", control.ExternalControlID))
+		} else {
+			externalIDs[control.ExternalControlID] = true
+		}
+	}
+
+	goals := make(map[string]bool)
+	for i, goal := range profileImport.Goals {
+		if goal.ID == "" {
+			problems = append(problems, fmt.Sprintf("goal %d has no ID", i+1))
+		} else if goals[goal.ID] {
+			problems = append(problems, fmt.Sprintf("duplicate goal ID %q", goal.ID))
+		} else {
+			goals[goal.ID] = true
+		}
+	}
+	for _, control := range profileImport.Controls {
+		mapped := make(map[string]bool)
+		for _, goalID := range control.GoalIDs {
+			switch {
+			case !goals[goalID]:
+				problems = append(problems, fmt.Sprintf("control %q refers to unknown goal %q", control.ControlID, goalID))
+			case mapped[goalID]:
+				problems = append(problems, fmt.Sprintf("goal %q is mapped twice to control %q", goalID, control.ControlID))
+			}
+			mapped[goalID] = true
+		}
+	}
+
+	for _, control := range profileImport.Controls {
+		if control.Parent == "" {
+			continue
+		}
+		if controls[control.Parent] == nil {
+			problems = append(problems, fmt.Sprintf("control %q refers to unknown parent %q", control.ControlID, control.Parent))
+			continue
+		}
+		// Follow the parents. A path longer than the number of controls leads into a cycle that does not contain
+		// this control; that cycle is reported for its own members.
+		parent := control.Parent
+		for steps := 0; parent != "" && controls[parent] != nil && steps <= len(controls); steps++ {
+			if parent == control.ControlID {
+				problems = append(problems, fmt.Sprintf("control %q is its own ancestor", control.ControlID))
+				break
+			}
+			parent = controls[parent].Parent
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ProfileImportValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// WriteTo writes the profile in the import file format. It implements io.WriterTo. Goals are not written because the
+// format has no columns for them.
+func (profileImport *ProfileImport) WriteTo(w io.Writer) (n int64, err error) {
+	var buf bytes.Buffer
+	writeProfileImportRow(&buf, profileImportNameKey, profileImport.Name)
+	writeProfileImportRow(&buf, profileImportMnemonicKey, profileImport.Mnemonic)
+	writeProfileImportRow(&buf, profileImportDescriptionKey, profileImport.Description)
+	writeProfileImportRow(&buf, ProfileImportMetaInfoEnd)
+	writeProfileImportRow(&buf, profileImportColumns...)
+	for _, control := range profileImport.Controls {
+		writeProfileImportRow(&buf,
+			control.ExternalControlID,
+			control.Description,
+			control.Parent,
+			control.ControlID,
+			strings.Join(control.Tags, ProfileImportListSeparator),
+		)
+	}
+	return buf.WriteTo(w)
+}
+
+// writeProfileImportRow writes one row with every field quoted.
+func writeProfileImportRow(buf *bytes.Buffer, fields ...string) {
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('"')
+		buf.WriteString(strings.ReplaceAll(field, `"`, `""`))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('\n')
+}
+
+// ParseProfileImport : Parse a profile import file
+// Files without the Tags column are accepted, columns are matched by name regardless of their order or case, and
+// unknown columns are ignored. The file holds no goals, so Goals and GoalIDs are left empty.
+func ParseProfileImport(r io.Reader) (*ProfileImport, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	profileImport := new(ProfileImport)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil, fmt.Errorf("profile import file has no %q row", ProfileImportMetaInfoEnd)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if record[0] == ProfileImportMetaInfoEnd {
+			break
+		}
+		var value string
+		if len(record) > 1 {
+			value = record[1]
+		}
+		switch strings.ToLower(record[0]) {
+		case profileImportNameKey:
+			profileImport.Name = value
+		case profileImportMnemonicKey:
+			profileImport.Mnemonic = value
+		case profileImportDescriptionKey:
+			profileImport.Description = value
+		}
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("profile import file has no control header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range profileImportColumns[:4] {
+		if _, ok := columns[strings.ToLower(required)]; !ok {
+			return nil, fmt.Errorf("profile import file has no %q column", required)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) == 1 && record[0] == "" {
+			continue
+		}
+		profileImport.Controls = append(profileImport.Controls, ProfileImportControl{
+			ExternalControlID: field(record, "ExternalControlId"),
+			Description:       field(record, "Description"),
+			Parent:            field(record, "Parent"),
+			ControlID:         field(record, "ControlId"),
+			Tags:              splitProfileImportList(field(record, "Tags")),
+		})
+	}
+	return profileImport, nil
+}
+
+func splitProfileImportList(s string) (list []string) {
+	for _, item := range strings.Split(s, ProfileImportListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return
+}
+
+// NewImportProfilesOptionsFromProfileImport : Instantiate ImportProfilesOptions from a ProfileImport
+// The profile is validated first so that an invalid file is never uploaded.
+func (*PostureManagementV2) NewImportProfilesOptionsFromProfileImport(profileImport *ProfileImport) (*ImportProfilesOptions, error) {
+	if profileImport == nil {
+		return nil, fmt.Errorf("profileImport cannot be nil")
+	}
+	if err := profileImport.Validate(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := profileImport.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return &ImportProfilesOptions{
+		File: ioutil.NopCloser(&buf),
+	}, nil
+}
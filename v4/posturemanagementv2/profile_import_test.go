@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 profile import`, func() {
+	newProfileImport := func() *posturemanagementv2.ProfileImport {
+		profileImport := &posturemanagementv2.ProfileImport{
+			Name:        "My profile",
+			Mnemonic:    "MP",
+			Description: `A "quoted" description`,
+		}
+		profileImport.AddControl(posturemanagementv2.ProfileImportControl{
+			ExternalControlID: "AC-1",
+			Description:       "Access control, policy",
+			ControlID:         "ctrl-1",
+			Tags:              []string{"nist", "access"},
+		}).AddControl(posturemanagementv2.ProfileImportControl{
+			ExternalControlID: "AC-1.a",
+			Description:       "Sub control",
+			Parent:            "ctrl-1",
+			ControlID:         "ctrl-2",
+		})
+		return profileImport
+	}
+
+	It(`Writes and parses the import file format`, func() {
+		var buf bytes.Buffer
+		_, err := newProfileImport().WriteTo(&buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal(`"profilename","My profile"
+"profilemnemonic","MP"
+"profiledescription","A ""quoted"" description"
+"##METAINFO ENDS##"
+"ExternalControlId","Description","Parent","ControlId","Tags"
+"AC-1","Access control, policy","","ctrl-1","nist;access"
+"AC-1.a","Sub control","ctrl-1","ctrl-2",""
+`))
+
+		parsed, err := posturemanagementv2.ParseProfileImport(&buf)
+		Expect(err).To(BeNil())
+		Expect(parsed).To(Equal(newProfileImport()))
+	})
+	It(`Parses a file without the optional columns`, func() {
+		parsed, err := posturemanagementv2.ParseProfileImport(strings.NewReader("\"profilename\",\"CUSTOM PROFILE SDK\"\n" +
+			"\"profilemnemonic\",\n" +
+			"\"profiledescription\",\"CUSTOM PROFILE SDK\"\n" +
+			"\"##METAINFO ENDS##\"\n" +
+			"\"ControlId\",\"ExternalControlId\",\"Description\",\"Parent\"\n" +
+			"\"c1\",\"E1\",\"Control\",\"\"\n"))
+		Expect(err).To(BeNil())
+		Expect(parsed.Name).To(Equal("CUSTOM PROFILE SDK"))
+		Expect(parsed.Mnemonic).To(BeEmpty())
+		Expect(parsed.Controls).To(Equal([]posturemanagementv2.ProfileImportControl{{ExternalControlID: "E1", Description: "Control", ControlID: "c1"}}))
+	})
+	It(`Round-trips the service's import sample`, func() {
+		// The sample that the ImportProfiles integration test uploads.
+		sample := "\"profilename\",\"CUSTOM PROFILE SDK\"\n" +
+			"\"profilemnemonic\",\n" +
+			"\"profiledescription\",\"CUSTOM PROFILE SDK\"\n" +
+			"\"##METAINFO ENDS##\"\n" +
+			"\"ExternalControlId\",\"Description\",\"Parent\",\"ControlId\",\"Tags\""
+		parsed, err := posturemanagementv2.ParseProfileImport(strings.NewReader(sample))
+		Expect(err).To(BeNil())
+		Expect(parsed).To(Equal(&posturemanagementv2.ProfileImport{Name: "CUSTOM PROFILE SDK", Description: "CUSTOM PROFILE SDK"}))
+
+		var buf bytes.Buffer
+		_, err = parsed.WriteTo(&buf)
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[4]).To(Equal(strings.Split(sample, "\n")[4]))
+
+		reparsed, err := posturemanagementv2.ParseProfileImport(&buf)
+		Expect(err).To(BeNil())
+		Expect(reparsed).To(Equal(parsed))
+
+		parsed.AddControl(posturemanagementv2.ProfileImportControl{ExternalControlID: "E1", ControlID: "c1", Tags: []string{"t1", "t2"}})
+		buf.Reset()
+		_, err = parsed.WriteTo(&buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HaveSuffix("\n\"E1\",\"\",\"\",\"c1\",\"t1;t2\"\n"))
+		reparsed, err = posturemanagementv2.ParseProfileImport(&buf)
+		Expect(err).To(BeNil())
+		Expect(reparsed).To(Equal(parsed))
+	})
+	It(`Rejects a file without the metainfo end row`, func() {
+		_, err := posturemanagementv2.ParseProfileImport(strings.NewReader("\"profilename\",\"x\"\n"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Reports duplicates and broken references`, func() {
+		profileImport := newProfileImport()
+		profileImport.AddControl(posturemanagementv2.ProfileImportControl{
+			ExternalControlID: "AC-1",
+			ControlID:         "ctrl-1",
+			Parent:            "missing",
+			GoalIDs:           []string{"g1", "g1", "g9"},
+		}).AddGoal(posturemanagementv2.ProfileImportGoal{ID: "g1"}).
+			AddGoal(posturemanagementv2.ProfileImportGoal{ID: "g1"}).
+			AddGoal(posturemanagementv2.ProfileImportGoal{})
+		profileImport.Controls[0].Parent = "ctrl-2"
+		err := profileImport.Validate()
+		var validationErr *posturemanagementv2.ProfileImportValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.Problems).To(ConsistOf(
+			`duplicate ControlId "ctrl-1"`,
+			`duplicate ExternalControlId "AC-1"`,
+			`control "ctrl-1" is its own ancestor`,
+			`control "ctrl-2" is its own ancestor`,
+			`control "ctrl-1" refers to unknown parent "missing"`,
+			`duplicate goal ID "g1"`,
+			`goal 3 has no ID`,
+			`goal "g1" is mapped twice to control "ctrl-1"`,
+			`control "ctrl-1" refers to unknown goal "g9"`,
+		))
+	})
+	It(`Builds ImportProfilesOptions from a valid profile`, func() {
+		service, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           "http://posturemanagementv2.test",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		importProfilesOptions, err := service.NewImportProfilesOptionsFromProfileImport(newProfileImport())
+		Expect(err).To(BeNil())
+		data, err := ioutil.ReadAll(importProfilesOptions.File)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(HavePrefix(`"profilename","My profile"`))
+
+		_, err = service.NewImportProfilesOptionsFromProfileImport(&posturemanagementv2.ProfileImport{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Copies an existing profile and its controls`, func() {
+		profileImport := posturemanagementv2.NewProfileImportFromControls(
+			&posturemanagementv2.Profile{Name: core.StringPtr("CIS"), Description: core.StringPtr("desc")},
+			[]posturemanagementv2.ControlItem{{
+				ID:                core.StringPtr("1"),
+				ExternalControlID: core.StringPtr("CIS-1"),
+				Description:       core.StringPtr("control"),
+				Goals: []posturemanagementv2.GoalItem{
+					{ID: core.StringPtr("g1"), Description: core.StringPtr("goal"), Severity: core.StringPtr("high"), IsAutomatable: core.BoolPtr(true)},
+				},
+			}, {
+				ID:                core.StringPtr("2"),
+				ExternalControlID: core.StringPtr("CIS-2"),
+				Goals:             []posturemanagementv2.GoalItem{{ID: core.StringPtr("g1")}},
+			}},
+		)
+		Expect(profileImport.Validate()).To(Succeed())
+		Expect(profileImport.Controls).To(Equal([]posturemanagementv2.ProfileImportControl{
+			{ExternalControlID: "CIS-1", Description: "control", ControlID: "1", GoalIDs: []string{"g1"}},
+			{ExternalControlID: "CIS-2", ControlID: "2", GoalIDs: []string{"g1"}},
+		}))
+		Expect(profileImport.Goals).To(Equal([]posturemanagementv2.ProfileImportGoal{
+			{ID: "g1", Description: "goal", Severity: "high", IsAutomatable: true},
+		}))
+
+		var buf bytes.Buffer
+		_, err := profileImport.WriteTo(&buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HaveSuffix("\n\"CIS-2\",\"\",\"\",\"2\",\"\"\n"))
+	})
+})
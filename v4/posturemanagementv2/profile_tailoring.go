@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ProfileControlFilter : Selects controls of a profile. Every criterion that is set must match. A list criterion
+// matches when the control matches any of its values. The goal criteria match when at least one goal of the control
+// matches all of them.
+type ProfileControlFilter struct {
+	// The IDs of the controls.
+	ControlIDs []string
+
+	// The external IDs of the controls.
+	ExternalControlIDs []string
+
+	// The severities of the goals, compared case-insensitively.
+	Severities []string
+
+	// Whether the goal is automatable.
+	IsAutomatable *bool
+
+	// Whether the goal is remediable.
+	IsRemediable *bool
+}
+
+// Matches reports whether control is selected by the filter. An empty filter matches every control.
+func (filter *ProfileControlFilter) Matches(control *ControlItem) bool {
+	if len(filter.ControlIDs) > 0 && !containsString(filter.ControlIDs, core.StringNilMapper(control.ID)) {
+		return false
+	}
+	if len(filter.ExternalControlIDs) > 0 && !containsString(filter.ExternalControlIDs, core.StringNilMapper(control.ExternalControlID)) {
+		return false
+	}
+	if len(filter.Severities) == 0 && filter.IsAutomatable == nil && filter.IsRemediable == nil {
+		return true
+	}
+	for i := range control.Goals {
+		if filter.matchesGoal(&control.Goals[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (filter *ProfileControlFilter) matchesGoal(goal *GoalItem) bool {
+	if len(filter.Severities) > 0 {
+		matched := false
+		for _, severity := range filter.Severities {
+			if strings.EqualFold(severity, core.StringNilMapper(goal.Severity)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if filter.IsAutomatable != nil && (goal.IsAutomatable == nil || *goal.IsAutomatable != *filter.IsAutomatable) {
+		return false
+	}
+	if filter.IsRemediable != nil && (goal.IsRemediable == nil || *goal.IsRemediable != *filter.IsRemediable) {
+		return false
+	}
+	return true
+}
+
+// TailorProfileOptions : The TailorProfile options.
+type TailorProfileOptions struct {
+	// The ID of the profile that the controls are pulled from.
+	BaseProfileID *string `validate:"required,ne="`
+
+	// The name of the tailored profile.
+	Name *string `validate:"required,ne="`
+
+	// The description of the tailored profile.
+	Description *string
+
+	// The ID of an existing custom profile to update. When nil a new custom profile is created.
+	ProfileID *string
+
+	// The controls to keep. A control is kept when any filter matches it. When empty every control of the base profile
+	// is kept.
+	Include []ProfileControlFilter
+
+	// The controls to drop. A control is dropped when any filter matches it, even if it was included.
+	Exclude []ProfileControlFilter
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewTailorProfileOptions : Instantiate TailorProfileOptions
+func (*PostureManagementV2) NewTailorProfileOptions(baseProfileID string, name string) *TailorProfileOptions {
+	return &TailorProfileOptions{
+		BaseProfileID: core.StringPtr(baseProfileID),
+		Name:          core.StringPtr(name),
+	}
+}
+
+// SetDescription : Allow user to set Description
+func (_options *TailorProfileOptions) SetDescription(description string) *TailorProfileOptions {
+	_options.Description = core.StringPtr(description)
+	return _options
+}
+
+// SetProfileID : Allow user to set ProfileID
+func (_options *TailorProfileOptions) SetProfileID(profileID string) *TailorProfileOptions {
+	_options.ProfileID = core.StringPtr(profileID)
+	return _options
+}
+
+// AddInclude : Allow user to add a filter of the controls to keep
+func (_options *TailorProfileOptions) AddInclude(filter ProfileControlFilter) *TailorProfileOptions {
+	_options.Include = append(_options.Include, filter)
+	return _options
+}
+
+// AddExclude : Allow user to add a filter of the controls to drop
+func (_options *TailorProfileOptions) AddExclude(filter ProfileControlFilter) *TailorProfileOptions {
+	_options.Exclude = append(_options.Exclude, filter)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *TailorProfileOptions) SetAccountID(accountID string) *TailorProfileOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// ProfileTailoringPreview : The control set that a TailorProfile call would produce.
+type ProfileTailoringPreview struct {
+	// The ID of the base profile.
+	BaseProfileID string
+
+	// The controls of the base profile that are kept, in the order of the base profile.
+	Included []ControlItem
+
+	// The controls of the base profile that are dropped.
+	Excluded []ControlItem
+}
+
+// ControlIDs returns the IDs of the included controls.
+func (preview *ProfileTailoringPreview) ControlIDs() []string {
+	ids := make([]string, 0, len(preview.Included))
+	for _, control := range preview.Included {
+		if control.ID != nil {
+			ids = append(ids, *control.ID)
+		}
+	}
+	return ids
+}
+
+// TailorProfileResult : The outcome of a TailorProfile call.
+type TailorProfileResult struct {
+	// The control set of the tailored profile.
+	Preview *ProfileTailoringPreview
+
+	// The ID of the tailored profile.
+	ProfileID string
+
+	// The tailored profile as returned by the service.
+	Profile *Profile
+
+	// Whether a new profile was created rather than an existing one updated.
+	Created bool
+}
+
+// PreviewTailoredProfile : Preview a tailored profile
+// List the controls of the base profile and apply the include and exclude filters without changing anything.
+func (postureManagement *PostureManagementV2) PreviewTailoredProfile(tailorProfileOptions *TailorProfileOptions) (result *ProfileTailoringPreview, err error) {
+	return postureManagement.PreviewTailoredProfileWithContext(context.Background(), tailorProfileOptions)
+}
+
+// PreviewTailoredProfileWithContext is an alternate form of the PreviewTailoredProfile method which supports a Context parameter
+func (postureManagement *PostureManagementV2) PreviewTailoredProfileWithContext(ctx context.Context, tailorProfileOptions *TailorProfileOptions) (result *ProfileTailoringPreview, err error) {
+	err = core.ValidateNotNil(tailorProfileOptions, "tailorProfileOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(tailorProfileOptions, "tailorProfileOptions")
+	if err != nil {
+		return
+	}
+
+	controls, err := postureManagement.listAllProfileControls(ctx, *tailorProfileOptions.BaseProfileID, tailorProfileOptions.AccountID)
+	if err != nil {
+		return
+	}
+	result = &ProfileTailoringPreview{
+		BaseProfileID: *tailorProfileOptions.BaseProfileID,
+	}
+	for i := range controls {
+		if tailorProfileOptions.keeps(&controls[i]) {
+			result.Included = append(result.Included, controls[i])
+		} else {
+			result.Excluded = append(result.Excluded, controls[i])
+		}
+	}
+	return
+}
+
+func (_options *TailorProfileOptions) keeps(control *ControlItem) bool {
+	for i := range _options.Exclude {
+		if _options.Exclude[i].Matches(control) {
+			return false
+		}
+	}
+	if len(_options.Include) == 0 {
+		return true
+	}
+	for i := range _options.Include {
+		if _options.Include[i].Matches(control) {
+			return true
+		}
+	}
+	return false
+}
+
+// TailorProfile : Create or update a tailored custom profile
+// Apply the include and exclude filters to the controls of the base profile and save the result as a custom profile.
+// When ProfileID is nil the profile is created by importing the selected controls; it is then updated so that it
+// records the base profile and the selected control IDs. When that update fails after the import, a result without
+// Profile is returned along with the error so that the ID of the imported profile is not lost; set ProfileID to it to
+// retry the update without importing another profile.
+func (postureManagement *PostureManagementV2) TailorProfile(tailorProfileOptions *TailorProfileOptions) (result *TailorProfileResult, err error) {
+	return postureManagement.TailorProfileWithContext(context.Background(), tailorProfileOptions)
+}
+
+// TailorProfileWithContext is an alternate form of the TailorProfile method which supports a Context parameter
+func (postureManagement *PostureManagementV2) TailorProfileWithContext(ctx context.Context, tailorProfileOptions *TailorProfileOptions) (result *TailorProfileResult, err error) {
+	preview, err := postureManagement.PreviewTailoredProfileWithContext(ctx, tailorProfileOptions)
+	if err != nil {
+		return
+	}
+	if len(preview.Included) == 0 {
+		err = fmt.Errorf("the tailored profile has no controls; every control of base profile %s was excluded", preview.BaseProfileID)
+		return
+	}
+
+	profileID := core.StringNilMapper(tailorProfileOptions.ProfileID)
+	created := profileID == ""
+	if created {
+		profileID, err = postureManagement.importTailoredProfile(ctx, tailorProfileOptions, preview)
+		if err != nil {
+			return
+		}
+	}
+
+	updateProfilesOptions := postureManagement.NewUpdateProfilesOptions(profileID)
+	updateProfilesOptions.SetName(*tailorProfileOptions.Name)
+	updateProfilesOptions.Description = tailorProfileOptions.Description
+	updateProfilesOptions.SetBaseProfile(preview.BaseProfileID)
+	updateProfilesOptions.SetType(UpdateProfilesOptionsTypeCustomConst)
+	updateProfilesOptions.SetControlIds(preview.ControlIDs())
+	updateProfilesOptions.AccountID = tailorProfileOptions.AccountID
+	profile, _, err := postureManagement.UpdateProfilesWithContext(ctx, updateProfilesOptions)
+	if err != nil {
+		if created {
+			result = &TailorProfileResult{
+				Preview:   preview,
+				ProfileID: profileID,
+				Created:   created,
+			}
+		}
+		return
+	}
+	result = &TailorProfileResult{
+		Preview:   preview,
+		ProfileID: profileID,
+		Profile:   profile,
+		Created:   created,
+	}
+	return
+}
+
+func (postureManagement *PostureManagementV2) importTailoredProfile(ctx context.Context, tailorProfileOptions *TailorProfileOptions, preview *ProfileTailoringPreview) (string, error) {
+	profileImport := NewProfileImportFromControls(&Profile{
+		Name:        tailorProfileOptions.Name,
+		Description: tailorProfileOptions.Description,
+	}, preview.Included)
+	// The import requires an external control ID for every control, which controls of the base profile may not have.
+	for i := range profileImport.Controls {
+		if profileImport.Controls[i].ExternalControlID == "" {
+			profileImport.Controls[i].ExternalControlID = profileImport.Controls[i].ControlID
+		}
+	}
+	importProfilesOptions, err := postureManagement.NewImportProfilesOptionsFromProfileImport(profileImport)
+	if err != nil {
+		return "", err
+	}
+	importProfilesOptions.AccountID = tailorProfileOptions.AccountID
+	basicResult, _, err := postureManagement.ImportProfilesWithContext(ctx, importProfilesOptions)
+	if err != nil {
+		return "", err
+	}
+	if basicResult == nil || basicResult.ProfileID == nil {
+		message := ""
+		if basicResult != nil {
+			message = core.StringNilMapper(basicResult.Message)
+		}
+		return "", fmt.Errorf("the tailored profile was not created: %s", message)
+	}
+	return *basicResult.ProfileID, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 profile tailoring`, func() {
+	var testServer *fixtureserver.Server
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /posture/v2/profiles/base/controls": `{"offset": 0, "limit": 10, "total_count": 3, "first": {"href": "first"}, "last": {"href": "last"}, "controls": [
+				{"id": "1", "external_control_id": "AC-1", "description": "one", "goals": [{"id": "g1", "description": "d", "severity": "High", "is_manual": false, "is_remediable": true, "is_reversible": false, "is_automatable": true, "is_auto_remediable": false}]},
+				{"id": "2", "external_control_id": "AC-2", "description": "two", "goals": [{"id": "g2", "description": "d", "severity": "low", "is_manual": true, "is_remediable": false, "is_reversible": false, "is_automatable": false, "is_auto_remediable": false}]},
+				{"id": "3", "external_control_id": "AU-1", "description": "three", "goals": [{"id": "g3", "description": "d", "severity": "high", "is_manual": false, "is_remediable": false, "is_reversible": false, "is_automatable": true, "is_auto_remediable": false}]}
+			]}`,
+			"POST /posture/v2/profiles/import":    `{"result": true, "message": "imported", "profile_id": "custom-1"}`,
+			"PATCH /posture/v2/profiles/custom-1": `{"id": "custom-1", "name": "tailored", "base_profile": "base", "type": "custom"}`,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	lastBody := func(key string) string {
+		requests := testServer.RequestsTo(key)
+		Expect(requests).ToNot(BeEmpty())
+		return requests[len(requests)-1].Body
+	}
+
+	It(`Previews controls selected by goal attributes`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		preview, err := postureManagementService.PreviewTailoredProfile(postureManagementService.NewTailorProfileOptions("base", "tailored").
+			AddInclude(posturemanagementv2.ProfileControlFilter{Severities: []string{"HIGH"}, IsAutomatable: core.BoolPtr(true)}).
+			AddExclude(posturemanagementv2.ProfileControlFilter{ExternalControlIDs: []string{"AU-1"}}))
+		Expect(err).To(BeNil())
+		Expect(preview.ControlIDs()).To(Equal([]string{"1"}))
+		Expect(preview.Excluded).To(HaveLen(2))
+		Expect(testServer.Requests()).To(Equal([]string{"GET /posture/v2/profiles/base/controls"}))
+	})
+	It(`Creates a custom profile from the selected controls`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		result, err := postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored").
+			AddExclude(posturemanagementv2.ProfileControlFilter{ControlIDs: []string{"2"}}))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(result.ProfileID).To(Equal("custom-1"))
+		Expect(*result.Profile.ID).To(Equal("custom-1"))
+		Expect(lastBody("POST /posture/v2/profiles/import")).To(ContainSubstring(`"AU-1","three","","3",""`))
+		Expect(lastBody("PATCH /posture/v2/profiles/custom-1")).To(MatchJSON(`{"name": "tailored", "base_profile": "base", "type": "custom", "control_ids": ["1", "3"]}`))
+	})
+	It(`Returns the imported profile ID when the update fails`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.DeleteResponse("PATCH /posture/v2/profiles/custom-1")
+		result, err := postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored"))
+		Expect(err).ToNot(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(result.ProfileID).To(Equal("custom-1"))
+		Expect(result.Profile).To(BeNil())
+
+		_, err = postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored").SetProfileID(result.ProfileID))
+		Expect(err).ToNot(BeNil())
+		Expect(testServer.RequestsTo("POST /posture/v2/profiles/import")).To(HaveLen(1))
+	})
+	It(`Uses the control ID for controls without an external control ID`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/profiles/base/controls", `{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "controls": [
+			{"id": "1", "description": "one", "goals": []},
+			{"id": "2", "external_control_id": "", "description": "two", "goals": []}
+		]}`)
+		result, err := postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored"))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(lastBody("POST /posture/v2/profiles/import")).To(ContainSubstring(`"1","one","","1",""`))
+		Expect(lastBody("POST /posture/v2/profiles/import")).To(ContainSubstring(`"2","two","","2",""`))
+	})
+	It(`Updates an existing profile without importing`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		result, err := postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored").
+			SetProfileID("custom-1").
+			AddInclude(posturemanagementv2.ProfileControlFilter{IsRemediable: core.BoolPtr(true)}))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(testServer.Requests()).ToNot(ContainElement("POST /posture/v2/profiles/import"))
+		Expect(lastBody("PATCH /posture/v2/profiles/custom-1")).To(ContainSubstring(`"control_ids":["1"]`))
+	})
+	It(`Refuses to save a profile without controls`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		_, err := postureManagementService.TailorProfile(postureManagementService.NewTailorProfileOptions("base", "tailored").
+			AddInclude(posturemanagementv2.ProfileControlFilter{ControlIDs: []string{"missing"}}))
+		Expect(err).ToNot(BeNil())
+		Expect(testServer.Requests()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,383 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RemediationCapability : How much of the remediation of a goal can be automated.
+type RemediationCapability string
+
+// Constants associated with RemediationCapability, from the most to the least automated.
+const (
+	RemediationCapabilityAutoRemediableConst RemediationCapability = "auto_remediable"
+	RemediationCapabilityAutomatableConst    RemediationCapability = "automatable"
+	RemediationCapabilityRemediableConst     RemediationCapability = "remediable"
+	RemediationCapabilityManualConst         RemediationCapability = "manual"
+	RemediationCapabilityUnknownConst        RemediationCapability = "unknown"
+)
+
+var remediationCapabilityRank = map[RemediationCapability]int{
+	RemediationCapabilityAutoRemediableConst: 0,
+	RemediationCapabilityAutomatableConst:    1,
+	RemediationCapabilityRemediableConst:     2,
+	RemediationCapabilityManualConst:         3,
+	RemediationCapabilityUnknownConst:        4,
+}
+
+var remediationSeverityRank = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+}
+
+// severityRank orders severities from the most to the least severe; unknown severities come last.
+func severityRank(severity string) int {
+	if rank, ok := remediationSeverityRank[strings.ToLower(severity)]; ok {
+		return rank
+	}
+	return len(remediationSeverityRank)
+}
+
+// GetRemediationCapability returns the most automated capability that the goal supports, or
+// RemediationCapabilityUnknownConst when goal is nil.
+func GetRemediationCapability(goal *GoalItem) RemediationCapability {
+	switch {
+	case goal == nil:
+		return RemediationCapabilityUnknownConst
+	case goal.IsAutoRemediable != nil && *goal.IsAutoRemediable:
+		return RemediationCapabilityAutoRemediableConst
+	case goal.IsAutomatable != nil && *goal.IsAutomatable:
+		return RemediationCapabilityAutomatableConst
+	case goal.IsRemediable != nil && *goal.IsRemediable && (goal.IsManual == nil || !*goal.IsManual):
+		return RemediationCapabilityRemediableConst
+	default:
+		return RemediationCapabilityManualConst
+	}
+}
+
+// RemediationItem : One failing goal to remediate.
+type RemediationItem struct {
+	// The goal ID.
+	GoalID string `json:"goal_id"`
+
+	// The description of the goal.
+	Description string `json:"description,omitempty"`
+
+	// The severity of the goal.
+	Severity string `json:"severity,omitempty"`
+
+	// How much of the remediation can be automated.
+	Capability RemediationCapability `json:"capability"`
+
+	// Whether the remediation can be reversed.
+	IsReversible bool `json:"is_reversible"`
+
+	// The IDs of the controls that the goal belongs to.
+	ControlIDs []string `json:"control_ids"`
+
+	// The external IDs of the controls that the goal belongs to.
+	ExternalControlIDs []string `json:"external_control_ids,omitempty"`
+
+	// The names of the resources that did not pass the goal.
+	Resources []string `json:"resources,omitempty"`
+
+	// The number of resources that did not pass the goal. A resource that failed the goal under several controls is
+	// counted once. Resources without a name are told apart by their position in the results of the goal, so they
+	// are counted once per goal but never matched across goals.
+	AffectedResources int `json:"affected_resources"`
+}
+
+// RemediationGroup : The failing goals that share a capability and a severity.
+type RemediationGroup struct {
+	// How much of the remediation can be automated.
+	Capability RemediationCapability `json:"capability"`
+
+	// The severity of the goals.
+	Severity string `json:"severity"`
+
+	// The number of distinct resources that failed a goal of the group. A resource that failed several goals of the
+	// group is counted once; see RemediationItem.AffectedResources for resources without a name.
+	AffectedResources int `json:"affected_resources"`
+
+	// The goals, with the most affected resources first.
+	Items []RemediationItem `json:"items"`
+}
+
+// RemediationPlan : A prioritised remediation backlog for one scan and profile. Groups are ordered by severity, most
+// severe first, and then by capability, most automated first.
+type RemediationPlan struct {
+	// The scan ID.
+	ScanID string `json:"scan_id"`
+
+	// The profile ID.
+	ProfileID string `json:"profile_id"`
+
+	// The profile name.
+	ProfileName string `json:"profile_name,omitempty"`
+
+	// The number of failing goals.
+	FailingGoals int `json:"failing_goals"`
+
+	// The number of distinct resources that failed a goal. A resource that failed several goals is counted once; see
+	// RemediationItem.AffectedResources for resources without a name.
+	AffectedResources int `json:"affected_resources"`
+
+	// The groups of failing goals.
+	Groups []RemediationGroup `json:"groups"`
+}
+
+// NewRemediationPlan : Build a remediation plan from the summary of a scan
+// The goals of the profile, as returned by GetProfileControls, provide the automation capabilities; goals that are
+// missing from it are planned with RemediationCapabilityUnknownConst.
+func NewRemediationPlan(summary *Summary, controls []ControlItem) *RemediationPlan {
+	plan := new(RemediationPlan)
+	if summary == nil {
+		return plan
+	}
+	plan.ScanID = core.StringNilMapper(summary.ID)
+	plan.ProfileID = core.StringNilMapper(summary.ProfileID)
+	plan.ProfileName = core.StringNilMapper(summary.ProfileName)
+
+	goalItems := make(map[string]*GoalItem)
+	for i := range controls {
+		for j := range controls[i].Goals {
+			goal := &controls[i].Goals[j]
+			if goal.ID != nil {
+				goalItems[*goal.ID] = goal
+			}
+		}
+	}
+
+	items := make(map[string]*RemediationItem)
+	resourceKeys := make(map[string][]string)
+	var order []string
+	for _, control := range summary.Controls {
+		for _, goal := range control.Goals {
+			if core.StringNilMapper(goal.Status) != GoalStatusFailConst || goal.ID == nil {
+				continue
+			}
+			item := items[*goal.ID]
+			if item == nil {
+				goalItem := goalItems[*goal.ID]
+				item = &RemediationItem{
+					GoalID:      *goal.ID,
+					Description: core.StringNilMapper(goal.Description),
+					Severity:    core.StringNilMapper(goal.Severity),
+					Capability:  GetRemediationCapability(goalItem),
+				}
+				if goalItem != nil {
+					item.IsReversible = goalItem.IsReversible != nil && *goalItem.IsReversible
+					if item.Description == "" {
+						item.Description = core.StringNilMapper(goalItem.Description)
+					}
+					if item.Severity == "" {
+						item.Severity = core.StringNilMapper(goalItem.Severity)
+					}
+				}
+				items[*goal.ID] = item
+				order = append(order, *goal.ID)
+			}
+			item.ControlIDs = appendUnique(item.ControlIDs, core.StringNilMapper(control.ID))
+			item.ExternalControlIDs = appendUnique(item.ExternalControlIDs, core.StringNilMapper(control.ExternalControlID))
+			for i, resource := range goal.ResourceResult {
+				// Resource results have their own statuses; every resource that does not pass is affected.
+				status := core.StringNilMapper(resource.Status)
+				if status == "" || status == ResourceResultStatusPassConst {
+					continue
+				}
+				// A resource that fails the goal under several controls is counted once.
+				key := affectedResourceKey(*goal.ID, i, resource)
+				if containsString(resourceKeys[*goal.ID], key) {
+					continue
+				}
+				resourceKeys[*goal.ID] = append(resourceKeys[*goal.ID], key)
+				item.Resources = appendUnique(item.Resources, core.StringNilMapper(resource.Name))
+				item.AffectedResources++
+			}
+		}
+	}
+
+	groups := make(map[string]*RemediationGroup)
+	groupResources := make(map[string]map[string]bool)
+	planResources := make(map[string]bool)
+	for _, goalID := range order {
+		item := items[goalID]
+		key := string(item.Capability) + "/" + strings.ToLower(item.Severity)
+		group := groups[key]
+		if group == nil {
+			group = &RemediationGroup{
+				Capability: item.Capability,
+				Severity:   strings.ToLower(item.Severity),
+			}
+			groups[key] = group
+			groupResources[key] = make(map[string]bool)
+		}
+		group.Items = append(group.Items, *item)
+		for _, resourceKey := range resourceKeys[goalID] {
+			groupResources[key][resourceKey] = true
+			planResources[resourceKey] = true
+		}
+		group.AffectedResources = len(groupResources[key])
+		plan.FailingGoals++
+	}
+	plan.AffectedResources = len(planResources)
+	for _, group := range groups {
+		sort.SliceStable(group.Items, func(i, j int) bool {
+			if group.Items[i].AffectedResources != group.Items[j].AffectedResources {
+				return group.Items[i].AffectedResources > group.Items[j].AffectedResources
+			}
+			return group.Items[i].GoalID < group.Items[j].GoalID
+		})
+		plan.Groups = append(plan.Groups, *group)
+	}
+	sort.Slice(plan.Groups, func(i, j int) bool {
+		a, b := &plan.Groups[i], &plan.Groups[j]
+		if severityRank(a.Severity) != severityRank(b.Severity) {
+			return severityRank(a.Severity) < severityRank(b.Severity)
+		}
+		if remediationCapabilityRank[a.Capability] != remediationCapabilityRank[b.Capability] {
+			return remediationCapabilityRank[a.Capability] < remediationCapabilityRank[b.Capability]
+		}
+		return a.Severity < b.Severity
+	})
+	return plan
+}
+
+// affectedResourceKey identifies a resource of a goal by its name. A resource without a name is identified by its
+// position in the results of the goal, which is the same under every control of the goal.
+func affectedResourceKey(goalID string, index int, resource ResourceResult) string {
+	if name := core.StringNilMapper(resource.Name); name != "" {
+		return "name:" + name
+	}
+	return fmt.Sprintf("goal:%s/%d", goalID, index)
+}
+
+// appendUnique appends s to list unless it is empty or already present.
+func appendUnique(list []string, s string) []string {
+	if s == "" || containsString(list, s) {
+		return list
+	}
+	return append(list, s)
+}
+
+// WriteMarkdown writes the plan as a Markdown document with one section per group and one table row per goal.
+func (plan *RemediationPlan) WriteMarkdown(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# Remediation plan for scan %s\n\n", plan.ScanID)
+	profile := plan.ProfileID
+	if plan.ProfileName != "" {
+		profile = fmt.Sprintf("%s (%s)", plan.ProfileName, plan.ProfileID)
+	}
+	fmt.Fprintf(bw, "Profile: %s\n\n", profile)
+	fmt.Fprintf(bw, "%d failing goals, %d affected resources.\n", plan.FailingGoals, plan.AffectedResources)
+	for _, group := range plan.Groups {
+		severity := group.Severity
+		if severity == "" {
+			severity = "unknown"
+		}
+		fmt.Fprintf(bw, "\n## %s severity, %s\n\n", severity, strings.ReplaceAll(string(group.Capability), "_", " "))
+		fmt.Fprintf(bw, "| Goal | Description | Controls | Affected resources | Reversible |\n")
+		fmt.Fprintf(bw, "| --- | --- | --- | ---: | --- |\n")
+		for _, item := range group.Items {
+			reversible := "no"
+			if item.IsReversible {
+				reversible = "yes"
+			}
+			controls := item.ExternalControlIDs
+			if len(controls) == 0 {
+				controls = item.ControlIDs
+			}
+			fmt.Fprintf(bw, "| %s | %s | %s | %d | %s |\n",
+				markdownCell(item.GoalID), markdownCell(item.Description), markdownCell(strings.Join(controls, ", ")),
+				item.AffectedResources, reversible)
+		}
+	}
+	return bw.Flush()
+}
+
+// markdownCell escapes the characters that would break a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// PlanRemediationOptions : The PlanRemediation options.
+type PlanRemediationOptions struct {
+	// The scan ID.
+	ScanID *string `validate:"required,ne="`
+
+	// The profile ID.
+	ProfileID *string `validate:"required,ne="`
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewPlanRemediationOptions : Instantiate PlanRemediationOptions
+func (*PostureManagementV2) NewPlanRemediationOptions(scanID string, profileID string) *PlanRemediationOptions {
+	return &PlanRemediationOptions{
+		ScanID:    core.StringPtr(scanID),
+		ProfileID: core.StringPtr(profileID),
+	}
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *PlanRemediationOptions) SetAccountID(accountID string) *PlanRemediationOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// PlanRemediation : Plan the remediation of a scan
+// Fetch the summary of the scan and the controls of the profile and build a remediation plan of the failing goals.
+func (postureManagement *PostureManagementV2) PlanRemediation(planRemediationOptions *PlanRemediationOptions) (result *RemediationPlan, err error) {
+	return postureManagement.PlanRemediationWithContext(context.Background(), planRemediationOptions)
+}
+
+// PlanRemediationWithContext is an alternate form of the PlanRemediation method which supports a Context parameter
+func (postureManagement *PostureManagementV2) PlanRemediationWithContext(ctx context.Context, planRemediationOptions *PlanRemediationOptions) (result *RemediationPlan, err error) {
+	err = core.ValidateNotNil(planRemediationOptions, "planRemediationOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(planRemediationOptions, "planRemediationOptions")
+	if err != nil {
+		return
+	}
+
+	scansSummaryOptions := postureManagement.NewScansSummaryOptions(*planRemediationOptions.ScanID, *planRemediationOptions.ProfileID)
+	scansSummaryOptions.AccountID = planRemediationOptions.AccountID
+	summary, _, err := postureManagement.ScansSummaryWithContext(ctx, scansSummaryOptions)
+	if err != nil {
+		return
+	}
+	controls, err := postureManagement.listAllProfileControls(ctx, *planRemediationOptions.ProfileID, planRemediationOptions.AccountID)
+	if err != nil {
+		return
+	}
+	result = NewRemediationPlan(summary, controls)
+	return
+}
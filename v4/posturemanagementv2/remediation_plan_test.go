@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 remediation plan`, func() {
+	var testServer *fixtureserver.Server
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /posture/v2/scans/validations/scan-1/summary": `{"id": "scan-1", "discover_id": "d1", "profile_id": "p1", "profile_name": "CIS", "scope_id": "scope-1", "controls": [
+				{"id": "c1", "external_control_id": "1.1", "goals": [
+					{"id": "g-auto", "description": "enable | encryption", "status": "fail", "severity": "high", "resource_result": [
+						{"name": "a", "status": "fail"}, {"name": "b", "status": "fail"}, {"name": "c", "status": "pass"}
+					]},
+					{"id": "g-manual", "description": "review access", "status": "fail", "severity": "high", "resource_result": [{"name": "a", "status": "fail"}, {"name": "e", "status": "unable_to_perform"}]},
+					{"id": "g-pass", "status": "pass", "severity": "critical"}
+				]},
+				{"id": "c2", "external_control_id": "1.2", "goals": [
+					{"id": "g-auto", "status": "fail", "severity": "high", "resource_result": [{"name": "a", "status": "fail"}, {"name": "d", "status": "fail"}]},
+					{"id": "g-low", "status": "fail", "severity": "low"}
+				]}
+			]}`,
+			"GET /posture/v2/profiles/p1/controls": `{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "controls": [
+				{"id": "c1", "external_control_id": "1.1", "description": "d", "goals": [
+					{"id": "g-auto", "description": "d", "severity": "high", "is_manual": false, "is_remediable": true, "is_reversible": true, "is_automatable": true, "is_auto_remediable": true},
+					{"id": "g-manual", "description": "d", "severity": "high", "is_manual": true, "is_remediable": false, "is_reversible": false, "is_automatable": false, "is_auto_remediable": false}
+				]}
+			]}`,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Groups failing goals by severity and capability`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		plan, err := postureManagementService.PlanRemediation(postureManagementService.NewPlanRemediationOptions("scan-1", "p1"))
+		Expect(err).To(BeNil())
+		Expect(plan.FailingGoals).To(Equal(3))
+		Expect(plan.AffectedResources).To(Equal(4))
+		Expect(plan.Groups).To(HaveLen(3))
+
+		Expect(plan.Groups[0].Severity).To(Equal("high"))
+		Expect(plan.Groups[0].Capability).To(Equal(posturemanagementv2.RemediationCapabilityAutoRemediableConst))
+		auto := plan.Groups[0].Items[0]
+		Expect(auto.GoalID).To(Equal("g-auto"))
+		Expect(auto.ControlIDs).To(Equal([]string{"c1", "c2"}))
+		Expect(auto.Resources).To(Equal([]string{"a", "b", "d"}))
+		Expect(auto.AffectedResources).To(Equal(3))
+		Expect(auto.IsReversible).To(BeTrue())
+
+		Expect(plan.Groups[0].AffectedResources).To(Equal(3))
+
+		Expect(plan.Groups[1].Capability).To(Equal(posturemanagementv2.RemediationCapabilityManualConst))
+		Expect(plan.Groups[1].Items[0].Resources).To(Equal([]string{"a", "e"}))
+		Expect(plan.Groups[2].Severity).To(Equal("low"))
+		Expect(plan.Groups[2].Capability).To(Equal(posturemanagementv2.RemediationCapabilityUnknownConst))
+
+		data, err := json.Marshal(plan)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"scan_id":"scan-1","profile_id":"p1","profile_name":"CIS","failing_goals":3`))
+
+		var md bytes.Buffer
+		Expect(plan.WriteMarkdown(&md)).To(Succeed())
+		Expect(md.String()).To(HavePrefix("# Remediation plan for scan scan-1\n\nProfile: CIS (p1)\n\n3 failing goals, 4 affected resources.\n"))
+		Expect(md.String()).To(ContainSubstring("## high severity, auto remediable\n\n"))
+		Expect(md.String()).To(ContainSubstring(`| g-auto | enable \| encryption | 1.1, 1.2 | 3 | yes |`))
+	})
+	It(`Counts resources without a name once per goal`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scans/validations/scan-1/summary", `{"id": "scan-1", "discover_id": "d1", "profile_id": "p1", "profile_name": "CIS", "scope_id": "scope-1", "controls": [
+			{"id": "c1", "goals": [
+				{"id": "g-auto", "status": "fail", "severity": "high", "resource_result": [{"status": "fail"}, {"status": "fail"}]},
+				{"id": "g-manual", "status": "fail", "severity": "high", "resource_result": [{"status": "fail"}]}
+			]},
+			{"id": "c2", "goals": [
+				{"id": "g-auto", "status": "fail", "severity": "high", "resource_result": [{"status": "fail"}, {"status": "fail"}]}
+			]}
+		]}`)
+		plan, err := postureManagementService.PlanRemediation(postureManagementService.NewPlanRemediationOptions("scan-1", "p1"))
+		Expect(err).To(BeNil())
+		Expect(plan.Groups).To(HaveLen(2))
+		Expect(plan.Groups[0].Items[0].GoalID).To(Equal("g-auto"))
+		Expect(plan.Groups[0].Items[0].Resources).To(BeEmpty())
+		Expect(plan.Groups[0].Items[0].AffectedResources).To(Equal(2))
+		Expect(plan.Groups[1].Items[0].AffectedResources).To(Equal(1))
+		Expect(plan.AffectedResources).To(Equal(3))
+	})
+	It(`Returns an empty plan without a summary`, func() {
+		plan := posturemanagementv2.NewRemediationPlan(nil, nil)
+		Expect(plan.Groups).To(BeEmpty())
+	})
+})
@@ -0,0 +1,325 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ScanResultRow : One (scan, profile, control, goal, resource) combination of a scan summary. A goal without
+// resource results and a control without goals are each reported as a single row with the lower levels left empty.
+type ScanResultRow struct {
+	// The ID of the scan.
+	ScanID string `json:"scan_id"`
+
+	// The name of the scan. It is only set when the scan item is given to FlattenScanSummary.
+	ScanName string `json:"scan_name,omitempty"`
+
+	// The ID of the scope that was scanned.
+	ScopeID string `json:"scope_id"`
+
+	// The name of the scope. It is only set when the scan item is given to FlattenScanSummary.
+	ScopeName string `json:"scope_name,omitempty"`
+
+	// The ID of the profile that the scope was validated against.
+	ProfileID string `json:"profile_id"`
+
+	// The name of the profile.
+	ProfileName string `json:"profile_name"`
+
+	// The ID of the control.
+	ControlID string `json:"control_id"`
+
+	// The external ID of the control, as it is known in the standard that the profile implements.
+	ExternalControlID string `json:"external_control_id,omitempty"`
+
+	// The description of the control.
+	ControlDescription string `json:"control_description,omitempty"`
+
+	// The status of the control as a whole, from the control level of the summary. It is the same on every row of the
+	// control.
+	ControlStatus string `json:"control_status,omitempty"`
+
+	// The ID of the goal. It is empty on the row of a control without goals.
+	GoalID string `json:"goal_id,omitempty"`
+
+	// The description of the goal.
+	GoalDescription string `json:"goal_description,omitempty"`
+
+	// The status of the goal as a whole, from the goal level of the summary. It is the same on every row of the goal.
+	GoalStatus string `json:"goal_status,omitempty"`
+
+	// The severity of the goal.
+	Severity string `json:"severity,omitempty"`
+
+	// The error that the goal reported, if any.
+	GoalError string `json:"goal_error,omitempty"`
+
+	// The name of the resource. It is empty on the row of a goal without resource results.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// The type of the resource.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// The status of this resource for the goal, from the resource result level of the summary.
+	ResourceStatus string `json:"resource_status,omitempty"`
+
+	// The value that the goal expects, as the display string that the service returns rather than a typed value.
+	ExpectedValue string `json:"expected_value,omitempty"`
+
+	// The value that was found on the resource, as the string that the service returns rather than a typed value.
+	ActualValue string `json:"actual_value,omitempty"`
+
+	// More information about the result of the resource.
+	ResultsInfo string `json:"results_info,omitempty"`
+
+	// The reason why the goal is not applicable to the resource.
+	NotApplicableReason string `json:"not_applicable_reason,omitempty"`
+}
+
+// scanResultColumns are the CSV column names, in the order of the ScanResultRow fields.
+var scanResultColumns = []string{
+	"scan_id", "scan_name", "scope_id", "scope_name", "profile_id", "profile_name",
+	"control_id", "external_control_id", "control_description", "control_status",
+	"goal_id", "goal_description", "goal_status", "severity", "goal_error",
+	"resource_name", "resource_type", "resource_status", "expected_value", "actual_value", "results_info",
+	"not_applicable_reason",
+}
+
+func (row *ScanResultRow) record() []string {
+	return []string{
+		row.ScanID, row.ScanName, row.ScopeID, row.ScopeName, row.ProfileID, row.ProfileName,
+		row.ControlID, row.ExternalControlID, row.ControlDescription, row.ControlStatus,
+		row.GoalID, row.GoalDescription, row.GoalStatus, row.Severity, row.GoalError,
+		row.ResourceName, row.ResourceType, row.ResourceStatus, row.ExpectedValue, row.ActualValue, row.ResultsInfo,
+		row.NotApplicableReason,
+	}
+}
+
+// FlattenScanSummary : Flatten the summary of a scan for one profile into rows
+// The scan item is optional; when it is given its name and scope name are added to every row.
+func FlattenScanSummary(scan *SummaryItem, summary *Summary) (rows []ScanResultRow) {
+	if summary == nil {
+		return
+	}
+	base := ScanResultRow{
+		ScanID:      core.StringNilMapper(summary.ID),
+		ScopeID:     core.StringNilMapper(summary.ScopeID),
+		ProfileID:   core.StringNilMapper(summary.ProfileID),
+		ProfileName: core.StringNilMapper(summary.ProfileName),
+	}
+	if scan != nil {
+		base.ScanName = core.StringNilMapper(scan.Name)
+		base.ScopeName = core.StringNilMapper(scan.ScopeName)
+	}
+	for _, control := range summary.Controls {
+		controlRow := base
+		controlRow.ControlID = core.StringNilMapper(control.ID)
+		controlRow.ExternalControlID = core.StringNilMapper(control.ExternalControlID)
+		controlRow.ControlDescription = core.StringNilMapper(control.Description)
+		controlRow.ControlStatus = core.StringNilMapper(control.Status)
+		if len(control.Goals) == 0 {
+			rows = append(rows, controlRow)
+			continue
+		}
+		for _, goal := range control.Goals {
+			goalRow := controlRow
+			goalRow.GoalID = core.StringNilMapper(goal.ID)
+			goalRow.GoalDescription = core.StringNilMapper(goal.Description)
+			goalRow.GoalStatus = core.StringNilMapper(goal.Status)
+			goalRow.Severity = core.StringNilMapper(goal.Severity)
+			goalRow.GoalError = core.StringNilMapper(goal.Error)
+			if len(goal.ResourceResult) == 0 {
+				rows = append(rows, goalRow)
+				continue
+			}
+			for _, resource := range goal.ResourceResult {
+				resourceRow := goalRow
+				resourceRow.ResourceName = core.StringNilMapper(resource.Name)
+				resourceRow.ResourceType = core.StringNilMapper(resource.Types)
+				resourceRow.ResourceStatus = core.StringNilMapper(resource.Status)
+				resourceRow.ExpectedValue = core.StringNilMapper(resource.DisplayExpectedValue)
+				resourceRow.ActualValue = core.StringNilMapper(resource.ActualValue)
+				resourceRow.ResultsInfo = core.StringNilMapper(resource.ResultsInfo)
+				resourceRow.NotApplicableReason = core.StringNilMapper(resource.NotApplicableReason)
+				rows = append(rows, resourceRow)
+			}
+		}
+	}
+	return
+}
+
+// ScanResultWriter : Writes flattened scan result rows. Flush must be called after the last row.
+type ScanResultWriter interface {
+	Write(row *ScanResultRow) error
+	Flush() error
+}
+
+// ScanResultCSVWriter : Writes scan result rows as CSV with a header row.
+type ScanResultCSVWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+// NewScanResultCSVWriter : Instantiate ScanResultCSVWriter
+func NewScanResultCSVWriter(w io.Writer) *ScanResultCSVWriter {
+	return &ScanResultCSVWriter{
+		writer: csv.NewWriter(w),
+	}
+}
+
+func (writer *ScanResultCSVWriter) writeHeader() error {
+	if writer.headerWritten {
+		return nil
+	}
+	writer.headerWritten = true
+	return writer.writer.Write(scanResultColumns)
+}
+
+// Write writes one row, preceded by the header row if it is the first.
+func (writer *ScanResultCSVWriter) Write(row *ScanResultRow) error {
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+	return writer.writer.Write(row.record())
+}
+
+// Flush writes any buffered data. The header row is written even when there are no rows.
+func (writer *ScanResultCSVWriter) Flush() error {
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+// ScanResultNDJSONWriter : Writes scan result rows as newline-delimited JSON, one object per row.
+type ScanResultNDJSONWriter struct {
+	encoder *json.Encoder
+}
+
+// NewScanResultNDJSONWriter : Instantiate ScanResultNDJSONWriter
+func NewScanResultNDJSONWriter(w io.Writer) *ScanResultNDJSONWriter {
+	return &ScanResultNDJSONWriter{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// Write writes one row followed by a newline.
+func (writer *ScanResultNDJSONWriter) Write(row *ScanResultRow) error {
+	return writer.encoder.Encode(row)
+}
+
+// Flush does nothing; rows are written as they come.
+func (writer *ScanResultNDJSONWriter) Flush() error {
+	return nil
+}
+
+// ExportScanResultsOptions : The ExportScanResults options.
+type ExportScanResultsOptions struct {
+	// The report setting ID whose scans are exported.
+	ReportSettingID *string `validate:"required,ne="`
+
+	// Where the rows are written.
+	Writer ScanResultWriter `validate:"required"`
+
+	// The IDs of the scans to export. When empty every scan of the report setting is exported.
+	ScanIDs []string
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewExportScanResultsOptions : Instantiate ExportScanResultsOptions
+func (*PostureManagementV2) NewExportScanResultsOptions(reportSettingID string, writer ScanResultWriter) *ExportScanResultsOptions {
+	return &ExportScanResultsOptions{
+		ReportSettingID: core.StringPtr(reportSettingID),
+		Writer:          writer,
+	}
+}
+
+// SetScanIDs : Allow user to set ScanIDs
+func (_options *ExportScanResultsOptions) SetScanIDs(scanIDs []string) *ExportScanResultsOptions {
+	_options.ScanIDs = scanIDs
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *ExportScanResultsOptions) SetAccountID(accountID string) *ExportScanResultsOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// ExportScanResults : Export flattened scan results
+// List the scans of a report setting, fetch the summary of every profile of every scan and write one row per
+// (scan, profile, control, goal, resource). The number of rows written is returned.
+func (postureManagement *PostureManagementV2) ExportScanResults(exportScanResultsOptions *ExportScanResultsOptions) (rows int, err error) {
+	return postureManagement.ExportScanResultsWithContext(context.Background(), exportScanResultsOptions)
+}
+
+// ExportScanResultsWithContext is an alternate form of the ExportScanResults method which supports a Context parameter
+func (postureManagement *PostureManagementV2) ExportScanResultsWithContext(ctx context.Context, exportScanResultsOptions *ExportScanResultsOptions) (rows int, err error) {
+	err = core.ValidateNotNil(exportScanResultsOptions, "exportScanResultsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(exportScanResultsOptions, "exportScanResultsOptions")
+	if err != nil {
+		return
+	}
+
+	scans, err := postureManagement.listAllScanSummaries(ctx, *exportScanResultsOptions.ReportSettingID, exportScanResultsOptions.AccountID)
+	if err != nil {
+		return
+	}
+	writer := exportScanResultsOptions.Writer
+	for i := range scans {
+		scan := &scans[i]
+		if scan.ID == nil {
+			continue
+		}
+		if len(exportScanResultsOptions.ScanIDs) > 0 && !containsString(exportScanResultsOptions.ScanIDs, *scan.ID) {
+			continue
+		}
+		for _, profile := range scan.Profiles {
+			if profile.ID == nil {
+				continue
+			}
+			scansSummaryOptions := postureManagement.NewScansSummaryOptions(*scan.ID, *profile.ID)
+			scansSummaryOptions.AccountID = exportScanResultsOptions.AccountID
+			var summary *Summary
+			summary, _, err = postureManagement.ScansSummaryWithContext(ctx, scansSummaryOptions)
+			if err != nil {
+				return
+			}
+			for _, row := range FlattenScanSummary(scan, summary) {
+				row := row
+				if err = writer.Write(&row); err != nil {
+					return
+				}
+				rows++
+			}
+		}
+	}
+	err = writer.Flush()
+	return
+}
@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 scan results export`, func() {
+	var testServer *fixtureserver.Server
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /posture/v2/scans/validations/summaries": `{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "summaries": [
+				{"id": "scan-1", "name": "nightly", "scope_id": "scope-1", "scope_name": "prod", "profiles": [{"id": "p1", "name": "CIS", "type": "predefined"}]},
+				{"id": "scan-2", "name": "other", "scope_id": "scope-2", "scope_name": "dev", "profiles": [{"id": "p1", "name": "CIS", "type": "predefined"}]}
+			]}`,
+			"GET /posture/v2/scans/validations/scan-1/summary": `{"id": "scan-1", "discover_id": "d1", "profile_id": "p1", "profile_name": "CIS", "scope_id": "scope-1", "controls": [
+				{"id": "c1", "status": "pass", "external_control_id": "1.1", "description": "control", "goals": [
+					{"id": "g1", "description": "goal, one", "status": "fail", "severity": "high", "resource_result": [
+						{"name": "bucket-a", "types": "bucket", "status": "fail", "display_expected_value": "true", "actual_value": "false"},
+						{"name": "bucket-b", "types": "bucket", "status": "not_applicable", "not_applicable_reason": "archived"}
+					]},
+					{"id": "g2", "status": "pass", "severity": "low"}
+				]},
+				{"id": "c2", "status": "unable_to_perform"}
+			]}`,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Exports one CSV row per control, goal and resource`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		var buf bytes.Buffer
+		rows, err := postureManagementService.ExportScanResults(postureManagementService.NewExportScanResultsOptions("rs-1", posturemanagementv2.NewScanResultCSVWriter(&buf)).
+			SetScanIDs([]string{"scan-1"}))
+		Expect(err).To(BeNil())
+		Expect(rows).To(Equal(4))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[0]).To(HavePrefix("scan_id,scan_name,scope_id,scope_name,profile_id,profile_name,control_id"))
+		Expect(lines[1]).To(Equal(`scan-1,nightly,scope-1,prod,p1,CIS,c1,1.1,control,pass,g1,"goal, one",fail,high,,bucket-a,bucket,fail,true,false,,`))
+		Expect(lines[2]).To(HaveSuffix(`,bucket-b,bucket,not_applicable,,,,archived`))
+		Expect(lines[3]).To(HavePrefix(`scan-1,nightly,scope-1,prod,p1,CIS,c1,1.1,control,pass,g2,,pass,low,`))
+		Expect(lines[4]).To(HavePrefix(`scan-1,nightly,scope-1,prod,p1,CIS,c2,,,unable_to_perform,`))
+	})
+	It(`Exports NDJSON`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		var buf bytes.Buffer
+		_, err := postureManagementService.ExportScanResults(postureManagementService.NewExportScanResultsOptions("rs-1", posturemanagementv2.NewScanResultNDJSONWriter(&buf)).
+			SetScanIDs([]string{"scan-1"}))
+		Expect(err).To(BeNil())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		Expect(lines[1]).To(MatchJSON(`{"scan_id": "scan-1", "scan_name": "nightly", "scope_id": "scope-1", "scope_name": "prod", "profile_id": "p1", "profile_name": "CIS", "control_id": "c1", "external_control_id": "1.1", "control_description": "control", "control_status": "pass", "goal_id": "g1", "goal_description": "goal, one", "goal_status": "fail", "severity": "high", "resource_name": "bucket-b", "resource_type": "bucket", "resource_status": "not_applicable", "not_applicable_reason": "archived"}`))
+	})
+	It(`Fails when a scan summary cannot be fetched`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		var buf bytes.Buffer
+		_, err := postureManagementService.ExportScanResults(postureManagementService.NewExportScanResultsOptions("rs-1", posturemanagementv2.NewScanResultCSVWriter(&buf)))
+		Expect(err).ToNot(BeNil())
+	})
+})
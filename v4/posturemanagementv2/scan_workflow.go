@@ -0,0 +1,563 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// ScanCheckpoint : The progress of a RunScan workflow. A checkpoint is saved after every step so that a workflow that
+// was interrupted can resume where it stopped.
+type ScanCheckpoint struct {
+	// The ID of the collector that the scope uses.
+	CollectorID string `json:"collector_id,omitempty"`
+
+	// The ID of the credential that the scope uses.
+	CredentialID string `json:"credential_id,omitempty"`
+
+	// The ID of the scope that is scanned.
+	ScopeID string `json:"scope_id,omitempty"`
+
+	// The time that the scope was created. The zero time when an existing scope was reused, so that the latest
+	// discovery of the scope is waited for.
+	DiscoveryStartedAt *strfmt.DateTime `json:"discovery_started_at,omitempty"`
+
+	// Whether discovery has finished.
+	DiscoveryCompleted bool `json:"discovery_completed,omitempty"`
+
+	// The time that validation was triggered, taken from the server clock when that is behind the local clock.
+	ValidationStartedAt *strfmt.DateTime `json:"validation_started_at,omitempty"`
+
+	// Whether validation has finished.
+	ValidationCompleted bool `json:"validation_completed,omitempty"`
+
+	// The ID of the scan that validation produced.
+	ScanID string `json:"scan_id,omitempty"`
+
+	// The report setting ID of the scan that validation produced.
+	ReportSettingID string `json:"report_setting_id,omitempty"`
+}
+
+// ScanCheckpointStore : Persists the checkpoint of a RunScan workflow.
+type ScanCheckpointStore interface {
+	// Load returns the saved checkpoint, or nil when no checkpoint has been saved.
+	Load() (*ScanCheckpoint, error)
+
+	// Save replaces the saved checkpoint.
+	Save(checkpoint *ScanCheckpoint) error
+}
+
+// FileScanCheckpointStore : A ScanCheckpointStore that keeps the checkpoint in a JSON file.
+type FileScanCheckpointStore struct {
+	// The path of the checkpoint file.
+	Path string
+}
+
+// NewFileScanCheckpointStore : Instantiate FileScanCheckpointStore
+func NewFileScanCheckpointStore(path string) *FileScanCheckpointStore {
+	return &FileScanCheckpointStore{
+		Path: path,
+	}
+}
+
+// Load reads the checkpoint file. A missing file is not an error.
+func (store *FileScanCheckpointStore) Load() (*ScanCheckpoint, error) {
+	data, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := new(ScanCheckpoint)
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("error reading scan checkpoint %s: %s", store.Path, err.Error())
+	}
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to a temporary file and renames it over the checkpoint file, so that a crash never
+// leaves a partially written checkpoint behind.
+func (store *FileScanCheckpointStore) Save(checkpoint *ScanCheckpoint) error {
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(store.Path), filepath.Base(store.Path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), store.Path)
+}
+
+// RunScanOptions : The RunScan options.
+type RunScanOptions struct {
+	// The collector to use. An existing collector with the same name is reused.
+	Collector *CreateCollectorOptions
+
+	// The credential to use. An existing credential with the same name is reused.
+	Credential *CreateCredentialOptions
+
+	// The scope to scan. An existing scope with the same name is reused once its latest discovery has finished, provided
+	// that it uses the collector and the credential of the workflow; otherwise RunScan fails rather than scanning with
+	// another collector or credential. CollectorIds and CredentialID are filled in by the workflow.
+	Scope *CreateScopeOptions
+
+	// The ID of the profile to validate the scope against.
+	ProfileID *string
+
+	// The ID of the profile group to validate the scope against.
+	GroupProfileID *string
+
+	// Where progress is saved. When nil the workflow cannot resume after an interruption.
+	Checkpoint ScanCheckpointStore
+
+	// Controls how discovery and validation are polled. CorrelationID, ScopeID, Since and TaskType are set by the
+	// workflow.
+	Wait *WaitForScopeTaskOptions
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+}
+
+// NewRunScanOptions : Instantiate RunScanOptions
+func (*PostureManagementV2) NewRunScanOptions(collector *CreateCollectorOptions, credential *CreateCredentialOptions, scope *CreateScopeOptions, profileID string) *RunScanOptions {
+	return &RunScanOptions{
+		Collector:  collector,
+		Credential: credential,
+		Scope:      scope,
+		ProfileID:  core.StringPtr(profileID),
+	}
+}
+
+// SetGroupProfileID : Allow user to set GroupProfileID
+func (_options *RunScanOptions) SetGroupProfileID(groupProfileID string) *RunScanOptions {
+	_options.GroupProfileID = core.StringPtr(groupProfileID)
+	return _options
+}
+
+// SetCheckpoint : Allow user to set Checkpoint
+func (_options *RunScanOptions) SetCheckpoint(checkpoint ScanCheckpointStore) *RunScanOptions {
+	_options.Checkpoint = checkpoint
+	return _options
+}
+
+// SetWait : Allow user to set Wait
+func (_options *RunScanOptions) SetWait(wait *WaitForScopeTaskOptions) *RunScanOptions {
+	_options.Wait = wait
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *RunScanOptions) SetAccountID(accountID string) *RunScanOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// RunScanResult : The outcome of a RunScan workflow.
+type RunScanResult struct {
+	// The checkpoint of the completed workflow, holding the IDs of every resource that was used.
+	Checkpoint *ScanCheckpoint
+
+	// The latest scan of the scope.
+	Scan *ScanItem
+
+	// The summary of the scan, including its profiles.
+	SummaryItem *SummaryItem
+
+	// The summary of the scan for ProfileID, including its controls and goals.
+	Summary *Summary
+}
+
+// ScanNotFoundError is returned by RunScan when validation finished but the latest scans hold no scan of the scope
+// that started after validation was triggered. The scan is looked up again when the workflow is resumed.
+type ScanNotFoundError struct {
+	// The ID of the scope.
+	ScopeID string
+
+	// The time that validation was triggered.
+	Since *strfmt.DateTime
+}
+
+func (e *ScanNotFoundError) Error() string {
+	if e.Since == nil {
+		return fmt.Sprintf("no scan was found for scope %s", e.ScopeID)
+	}
+	return fmt.Sprintf("no scan was found for scope %s that started since %s", e.ScopeID, e.Since.String())
+}
+
+// RunScan : Run a posture scan from start to finish
+// Create or reuse a collector, credential and scope, wait for discovery, trigger validation, wait for it to finish
+// and fetch the scan summary. Progress is saved to the checkpoint store after every step.
+func (postureManagement *PostureManagementV2) RunScan(runScanOptions *RunScanOptions) (result *RunScanResult, err error) {
+	return postureManagement.RunScanWithContext(context.Background(), runScanOptions)
+}
+
+// RunScanWithContext is an alternate form of the RunScan method which supports a Context parameter
+func (postureManagement *PostureManagementV2) RunScanWithContext(ctx context.Context, runScanOptions *RunScanOptions) (result *RunScanResult, err error) {
+	err = core.ValidateNotNil(runScanOptions, "runScanOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	if runScanOptions.Collector == nil || runScanOptions.Credential == nil || runScanOptions.Scope == nil || runScanOptions.ProfileID == nil {
+		err = fmt.Errorf("runScanOptions requires Collector, Credential, Scope and ProfileID")
+		return
+	}
+
+	run := &scanRun{
+		service:    postureManagement,
+		options:    runScanOptions,
+		checkpoint: new(ScanCheckpoint),
+	}
+	if runScanOptions.Checkpoint != nil {
+		var saved *ScanCheckpoint
+		saved, err = runScanOptions.Checkpoint.Load()
+		if err != nil {
+			return
+		}
+		if saved != nil {
+			run.checkpoint = saved
+		}
+	}
+
+	steps := []func(context.Context) error{
+		run.ensureCollector,
+		run.ensureCredential,
+		run.ensureScope,
+		run.waitForDiscovery,
+		run.validate,
+		run.waitForValidation,
+		run.findScan,
+	}
+	for _, step := range steps {
+		if err = step(ctx); err != nil {
+			return
+		}
+	}
+
+	result = &RunScanResult{
+		Checkpoint: run.checkpoint,
+		Scan:       run.scan,
+	}
+	result.SummaryItem, err = run.summaryItem(ctx)
+	if err != nil {
+		return
+	}
+	scansSummaryOptions := postureManagement.NewScansSummaryOptions(run.checkpoint.ScanID, *runScanOptions.ProfileID)
+	scansSummaryOptions.AccountID = runScanOptions.AccountID
+	result.Summary, _, err = postureManagement.ScansSummaryWithContext(ctx, scansSummaryOptions)
+	return
+}
+
+// scanRun holds the state of one RunScan invocation.
+type scanRun struct {
+	service    *PostureManagementV2
+	options    *RunScanOptions
+	checkpoint *ScanCheckpoint
+	scan       *ScanItem
+}
+
+func (run *scanRun) save() error {
+	if run.options.Checkpoint == nil {
+		return nil
+	}
+	return run.options.Checkpoint.Save(run.checkpoint)
+}
+
+func (run *scanRun) ensureCollector(ctx context.Context) error {
+	if run.checkpoint.CollectorID != "" {
+		return nil
+	}
+	collectors, err := run.service.listAllCollectors(ctx, run.options.AccountID)
+	if err != nil {
+		return err
+	}
+	name := core.StringNilMapper(run.options.Collector.Name)
+	for _, collector := range collectors {
+		if core.StringNilMapper(collector.Name) == name || core.StringNilMapper(collector.DisplayName) == name {
+			if collector.GetID() == "" {
+				return fmt.Errorf("collector %s was listed without an ID", name)
+			}
+			run.checkpoint.CollectorID = collector.GetID()
+			return run.save()
+		}
+	}
+	createCollectorOptions := *run.options.Collector
+	createCollectorOptions.AccountID = run.options.AccountID
+	collector, _, err := run.service.CreateCollectorWithContext(ctx, &createCollectorOptions)
+	if err != nil {
+		return err
+	}
+	if collector.GetID() == "" {
+		return fmt.Errorf("collector %s was created without an ID", name)
+	}
+	run.checkpoint.CollectorID = collector.GetID()
+	return run.save()
+}
+
+func (run *scanRun) ensureCredential(ctx context.Context) error {
+	if run.checkpoint.CredentialID != "" {
+		return nil
+	}
+	credentials, err := run.service.listAllCredentials(ctx, run.options.AccountID)
+	if err != nil {
+		return err
+	}
+	name := core.StringNilMapper(run.options.Credential.Name)
+	for _, credential := range credentials {
+		if core.StringNilMapper(credential.Name) == name {
+			if credential.GetID() == "" {
+				return fmt.Errorf("credential %s was listed without an ID", name)
+			}
+			run.checkpoint.CredentialID = credential.GetID()
+			return run.save()
+		}
+	}
+	createCredentialOptions := *run.options.Credential
+	createCredentialOptions.AccountID = run.options.AccountID
+	credential, _, err := run.service.CreateCredentialWithContext(ctx, &createCredentialOptions)
+	if err != nil {
+		return err
+	}
+	if credential.GetID() == "" {
+		return fmt.Errorf("credential %s was created without an ID", name)
+	}
+	run.checkpoint.CredentialID = credential.GetID()
+	return run.save()
+}
+
+func (run *scanRun) ensureScope(ctx context.Context) error {
+	if run.checkpoint.ScopeID != "" {
+		return nil
+	}
+	scopes, err := run.service.listAllScopes(ctx, run.options.AccountID)
+	if err != nil {
+		return err
+	}
+	name := core.StringNilMapper(run.options.Scope.Name)
+	for _, scope := range scopes {
+		if core.StringNilMapper(scope.Name) == name {
+			// The latest discovery of an existing scope may still be running or may have failed.
+			if scope.GetID() == "" {
+				return fmt.Errorf("scope %s was listed without an ID", name)
+			}
+			if err = run.checkScope(ctx, scope.GetID(), name); err != nil {
+				return err
+			}
+			discoveryStartedAt := strfmt.DateTime(time.Time{})
+			run.checkpoint.ScopeID = scope.GetID()
+			run.checkpoint.DiscoveryStartedAt = &discoveryStartedAt
+			return run.save()
+		}
+	}
+	createScopeOptions := *run.options.Scope
+	createScopeOptions.CollectorIds = []string{run.checkpoint.CollectorID}
+	createScopeOptions.CredentialID = core.StringPtr(run.checkpoint.CredentialID)
+	createScopeOptions.AccountID = run.options.AccountID
+	localStartedAt := time.Now()
+	scope, response, err := run.service.CreateScopeWithContext(ctx, &createScopeOptions)
+	if err != nil {
+		return err
+	}
+	if scope.GetID() == "" {
+		return fmt.Errorf("scope %s was created without an ID", name)
+	}
+	startedAt := requestStartedAt(localStartedAt, response)
+	run.checkpoint.ScopeID = scope.GetID()
+	run.checkpoint.DiscoveryStartedAt = &startedAt
+	return run.save()
+}
+
+// checkScope returns an error unless the existing scope uses the collector and the credential of the checkpoint, so
+// that a scope which happens to share the name is not scanned with another collector or credential.
+func (run *scanRun) checkScope(ctx context.Context, scopeID string, name string) error {
+	getScopeDetailsCollectorOptions := run.service.NewGetScopeDetailsCollectorOptions(scopeID)
+	getScopeDetailsCollectorOptions.AccountID = run.options.AccountID
+	collector, _, err := run.service.GetScopeDetailsCollectorWithContext(ctx, getScopeDetailsCollectorOptions)
+	if err != nil {
+		return err
+	}
+	usesCollector := false
+	for _, collectorID := range collector.GetCollectorIds() {
+		if collectorID == run.checkpoint.CollectorID {
+			usesCollector = true
+		}
+	}
+	if !usesCollector {
+		return fmt.Errorf("scope %s uses collectors %v, not collector %s", name, collector.GetCollectorIds(), run.checkpoint.CollectorID)
+	}
+
+	getScopeDetailsCredentialsOptions := run.service.NewGetScopeDetailsCredentialsOptions(scopeID)
+	getScopeDetailsCredentialsOptions.AccountID = run.options.AccountID
+	credential, _, err := run.service.GetScopeDetailsCredentialsWithContext(ctx, getScopeDetailsCredentialsOptions)
+	if err != nil {
+		return err
+	}
+	if credential.GetCredentialID() != run.checkpoint.CredentialID {
+		return fmt.Errorf("scope %s uses credential %s, not credential %s", name, credential.GetCredentialID(), run.checkpoint.CredentialID)
+	}
+	return nil
+}
+
+func (run *scanRun) wait(ctx context.Context, since *strfmt.DateTime, taskType string) error {
+	waitOptions := new(WaitForScopeTaskOptions)
+	if run.options.Wait != nil {
+		*waitOptions = *run.options.Wait
+	}
+	waitOptions.CorrelationID = nil
+	waitOptions.SetScopeID(run.checkpoint.ScopeID)
+	waitOptions.SetSince(since)
+	waitOptions.SetTaskType(taskType)
+	waitOptions.AccountID = run.options.AccountID
+	_, err := run.service.WaitForScopeTaskWithContext(ctx, waitOptions)
+	return err
+}
+
+func (run *scanRun) waitForDiscovery(ctx context.Context) error {
+	if run.checkpoint.DiscoveryCompleted {
+		return nil
+	}
+	if err := run.wait(ctx, run.checkpoint.DiscoveryStartedAt, EventItemTaskTypeDiscoveryConst); err != nil {
+		return err
+	}
+	run.checkpoint.DiscoveryCompleted = true
+	return run.save()
+}
+
+func (run *scanRun) validate(ctx context.Context) error {
+	if run.checkpoint.ValidationStartedAt != nil {
+		return nil
+	}
+	createValidationOptions := run.service.NewCreateValidationOptions(run.checkpoint.ScopeID, *run.options.ProfileID)
+	createValidationOptions.GroupProfileID = run.options.GroupProfileID
+	createValidationOptions.AccountID = run.options.AccountID
+	localStartedAt := time.Now()
+	result, response, err := run.service.CreateValidationWithContext(ctx, createValidationOptions)
+	if err != nil {
+		return err
+	}
+	if result != nil && result.Result != nil && !*result.Result {
+		return fmt.Errorf("validation of scope %s was not started: %s", run.checkpoint.ScopeID, core.StringNilMapper(result.Message))
+	}
+	startedAt := requestStartedAt(localStartedAt, response)
+	run.checkpoint.ValidationStartedAt = &startedAt
+	return run.save()
+}
+
+func (run *scanRun) waitForValidation(ctx context.Context) error {
+	if run.checkpoint.ValidationCompleted {
+		return nil
+	}
+	if err := run.wait(ctx, run.checkpoint.ValidationStartedAt, EventItemTaskTypeFactCollectionConst); err != nil {
+		return err
+	}
+	run.checkpoint.ValidationCompleted = true
+	return run.save()
+}
+
+// findScan looks up the most recent scan of the scope among the latest scans. Scans that started before validation
+// was triggered belong to an earlier run and are ignored.
+func (run *scanRun) findScan(ctx context.Context) error {
+	scans, err := run.service.listAllLatestScans(ctx, run.options.AccountID)
+	if err != nil {
+		return err
+	}
+	run.scan = nil
+	for i := range scans {
+		scan := &scans[i]
+		if core.StringNilMapper(scan.ScopeID) != run.checkpoint.ScopeID || !scanStartedSince(scan, run.checkpoint.ValidationStartedAt) {
+			continue
+		}
+		if run.scan == nil || scanStartedAfter(scan, run.scan) {
+			run.scan = scan
+		}
+	}
+	if run.scan == nil {
+		return &ScanNotFoundError{ScopeID: run.checkpoint.ScopeID, Since: run.checkpoint.ValidationStartedAt}
+	}
+	run.checkpoint.ScanID = core.StringNilMapper(run.scan.ScanID)
+	run.checkpoint.ReportSettingID = core.StringNilMapper(run.scan.ReportSettingID)
+	return run.save()
+}
+
+// summaryItem returns the summary of the scan from the summaries of its report setting.
+func (run *scanRun) summaryItem(ctx context.Context) (*SummaryItem, error) {
+	if run.checkpoint.ReportSettingID == "" {
+		return nil, nil
+	}
+	summaries, err := run.service.listAllScanSummaries(ctx, run.checkpoint.ReportSettingID, run.options.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range summaries {
+		if core.StringNilMapper(summaries[i].ID) == run.checkpoint.ScanID {
+			return &summaries[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// requestStartedAt returns the time from which the effects of a request are looked for: the local time before the
+// request, or the server time of the response when that is earlier, so that a local clock that runs ahead of the
+// server does not hide the events and scans that the request started. The Date header of the response only has a
+// resolution of one second, so one second is allowed for.
+func requestStartedAt(localStartedAt time.Time, response *core.DetailedResponse) strfmt.DateTime {
+	startedAt := localStartedAt
+	if response != nil && response.Headers != nil {
+		serverTime, err := http.ParseTime(response.Headers.Get("Date"))
+		if err == nil && serverTime.Add(-time.Second).Before(startedAt) {
+			startedAt = serverTime.Add(-time.Second)
+		}
+	}
+	return strfmt.DateTime(startedAt)
+}
+
+func scanStartedSince(scan *ScanItem, since *strfmt.DateTime) bool {
+	if since == nil {
+		return true
+	}
+	return scan.StartTime != nil && !time.Time(*scan.StartTime).Before(time.Time(*since))
+}
+
+func scanStartedAfter(a *ScanItem, b *ScanItem) bool {
+	if a.StartTime == nil {
+		return false
+	}
+	if b.StartTime == nil {
+		return true
+	}
+	return time.Time(*a.StartTime).After(time.Time(*b.StartTime))
+}
@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 scan workflow`, func() {
+	var testServer *fixtureserver.Server
+	var checkpointDir string
+
+	BeforeEach(func() {
+		checkpointDir, _ = ioutil.TempDir("", "scan-checkpoint")
+		later := time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+		responses := map[string]string{
+			"GET /posture/v2/collectors":                 `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "collectors": [{"id": "collector-1", "name": "test-collector", "display_name": "test-collector", "status": "active"}]}`,
+			"GET /posture/v2/credentials":                `{"offset": 0, "limit": 10, "total_count": 0, "first": {"href": "first"}, "last": {"href": "last"}, "credentials": []}`,
+			"POST /posture/v2/credentials":               `{"id": "credential-1", "name": "test-credential"}`,
+			"GET /posture/v2/scopes":                     `{"offset": 0, "limit": 10, "total_count": 0, "first": {"href": "first"}, "last": {"href": "last"}, "scopes": []}`,
+			"POST /posture/v2/scopes":                    `{"id": "scope-1", "name": "test-scope"}`,
+			"GET /posture/v2/scopes/scope-1/collectors":  `{"collector_ids": ["collector-1"]}`,
+			"GET /posture/v2/scopes/scope-1/credentials": `{"credential_id": "credential-1"}`,
+			"GET /posture/v2/scopes/scope-1/events": fmt.Sprintf(`{"events": [
+				{"id": "e1", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "discovery", "status": "discovery_result_posted_no_error", "data_available": true, "status_message": "done"},
+				{"id": "e2", "created_at": "%[1]s", "updated_at": "%[1]s", "task_type": "fact_collection", "status": "validation_result_posted_no_error", "data_available": true, "status_message": "done"}
+			]}`, later),
+			"POST /posture/v2/scans/validations":               `{"result": true, "message": "Successfully started validation"}`,
+			"GET /posture/v2/scans/validations/latest_scans":   fmt.Sprintf(`{"offset": 0, "limit": 10, "total_count": 2, "first": {"href": "first"}, "last": {"href": "last"}, "latest_scans": [{"scan_id": "scan-old", "scope_id": "scope-1", "report_setting_id": "rs-1", "start_time": "2021-01-01T00:00:00.000Z"}, {"scan_id": "scan-1", "scope_id": "scope-1", "report_setting_id": "rs-1", "start_time": "%s"}]}`, later),
+			"GET /posture/v2/scans/validations/summaries":      `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "summaries": [{"id": "scan-1", "name": "test", "scope_id": "scope-1", "status": "validation_result_posted_no_error", "profiles": []}]}`,
+			"GET /posture/v2/scans/validations/scan-1/summary": `{"id": "scan-1", "discover_id": "d1", "profile_id": "profile-1", "profile_name": "test", "scope_id": "scope-1", "controls": [{"id": "c1", "status": "pass", "goals": [{"id": "g1", "status": "pass"}]}]}`,
+		}
+		testServer = fixtureserver.New(responses)
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(checkpointDir)
+	})
+
+	newRunScanOptions := func(service *posturemanagementv2.PostureManagementV2) *posturemanagementv2.RunScanOptions {
+		credentialOptions, err := service.NewCreateCredentialOptionsFromSpec("test-credential", "desc", posturemanagementv2.CreateCredentialOptionsPurposeDiscoveryFactCollectionRemediationConst, &posturemanagementv2.IBMCredential{
+			APIKey: "sample_api_key",
+		})
+		Expect(err).To(BeNil())
+		return service.NewRunScanOptions(
+			service.NewCreateCollectorOptions("test-collector", true, "ibm"),
+			credentialOptions,
+			&posturemanagementv2.CreateScopeOptions{
+				Name:           core.StringPtr("test-scope"),
+				Description:    core.StringPtr("desc"),
+				CredentialType: core.StringPtr(posturemanagementv2.CreateScopeOptionsCredentialTypeIBMConst),
+			},
+			"profile-1",
+		).SetWait(service.NewWaitForScopeTaskOptions().SetPollInterval(time.Millisecond, time.Millisecond))
+	}
+
+	It(`Reuses, creates, validates and returns the summary`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		store := posturemanagementv2.NewFileScanCheckpointStore(filepath.Join(checkpointDir, "checkpoint.json"))
+		result, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService).SetCheckpoint(store))
+		Expect(err).To(BeNil())
+		Expect(result.Checkpoint.CollectorID).To(Equal("collector-1"))
+		Expect(result.Checkpoint.CredentialID).To(Equal("credential-1"))
+		Expect(result.Checkpoint.ScopeID).To(Equal("scope-1"))
+		Expect(*result.Scan.ScanID).To(Equal("scan-1"))
+		Expect(*result.SummaryItem.ID).To(Equal("scan-1"))
+		Expect(result.Summary.Controls).To(HaveLen(1))
+		Expect(result.Summary.Controls[0].Goals).To(HaveLen(1))
+		Expect(testServer.Requests()).ToNot(ContainElement("POST /posture/v2/collectors"))
+		Expect(testServer.Requests()).To(ContainElement("POST /posture/v2/scopes"))
+
+		saved, err := store.Load()
+		Expect(err).To(BeNil())
+		Expect(saved.ValidationCompleted).To(BeTrue())
+		Expect(saved.ScanID).To(Equal("scan-1"))
+	})
+	It(`Waits for the latest discovery of an existing scope`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scopes", `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "scopes": [{"id": "scope-1", "name": "test-scope"}]}`)
+		testServer.SetResponse("GET /posture/v2/scopes/scope-1/events", `{"events": [
+			{"id": "e1", "created_at": "2021-01-01T00:00:00.000Z", "updated_at": "2021-01-01T00:00:00.000Z", "task_type": "discovery", "status": "discovery_result_posted_no_error", "data_available": true, "status_message": "done"},
+			{"id": "e2", "created_at": "2022-01-01T00:00:00.000Z", "updated_at": "2022-01-01T00:00:00.000Z", "task_type": "discovery", "status": "error_in_discovery", "data_available": false, "status_message": "collector unreachable"}
+		]}`)
+		store := posturemanagementv2.NewFileScanCheckpointStore(filepath.Join(checkpointDir, "checkpoint.json"))
+		_, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService).SetCheckpoint(store))
+		var failed *posturemanagementv2.ScopeTaskFailedError
+		Expect(errors.As(err, &failed)).To(BeTrue())
+		Expect(failed.Message).To(Equal("collector unreachable"))
+		Expect(testServer.Requests()).ToNot(ContainElement("POST /posture/v2/scopes"))
+		Expect(testServer.Requests()).ToNot(ContainElement("POST /posture/v2/scans/validations"))
+
+		saved, err := store.Load()
+		Expect(err).To(BeNil())
+		Expect(saved.ScopeID).To(Equal("scope-1"))
+		Expect(saved.DiscoveryCompleted).To(BeFalse())
+	})
+	It(`Fails when an existing scope uses another collector or credential`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scopes", `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "scopes": [{"id": "scope-1", "name": "test-scope"}]}`)
+		testServer.SetResponse("GET /posture/v2/scopes/scope-1/collectors", `{"collector_ids": ["collector-2"]}`)
+		_, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(MatchError("scope test-scope uses collectors [collector-2], not collector collector-1"))
+
+		testServer.SetResponse("GET /posture/v2/scopes/scope-1/collectors", `{"collector_ids": ["collector-2", "collector-1"]}`)
+		testServer.SetResponse("GET /posture/v2/scopes/scope-1/credentials", `{"credential_id": "credential-2"}`)
+		_, err = postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(MatchError("scope test-scope uses credential credential-2, not credential credential-1"))
+		Expect(testServer.Requests()).ToNot(ContainElement("POST /posture/v2/scans/validations"))
+	})
+	It(`Resumes from a saved checkpoint`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		store := posturemanagementv2.NewFileScanCheckpointStore(filepath.Join(checkpointDir, "checkpoint.json"))
+		startedAt := strfmt.DateTime(time.Now())
+		Expect(store.Save(&posturemanagementv2.ScanCheckpoint{
+			CollectorID:         "collector-1",
+			CredentialID:        "credential-1",
+			ScopeID:             "scope-1",
+			DiscoveryCompleted:  true,
+			ValidationStartedAt: &startedAt,
+			ValidationCompleted: true,
+		})).To(Succeed())
+
+		result, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService).SetCheckpoint(store))
+		Expect(err).To(BeNil())
+		Expect(*result.Summary.ID).To(Equal("scan-1"))
+		for _, request := range testServer.Requests() {
+			Expect(request).To(HavePrefix("GET /posture/v2/scans/"))
+		}
+	})
+	It(`Ignores scans that started before validation`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scans/validations/latest_scans", `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "latest_scans": [
+			{"scan_id": "scan-old", "scope_id": "scope-1", "report_setting_id": "rs-1", "start_time": "2021-01-01T00:00:00.000Z"}
+		]}`)
+		store := posturemanagementv2.NewFileScanCheckpointStore(filepath.Join(checkpointDir, "checkpoint.json"))
+		_, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService).SetCheckpoint(store))
+		var notFound *posturemanagementv2.ScanNotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(notFound.ScopeID).To(Equal("scope-1"))
+		Expect(testServer.Requests()).ToNot(ContainElement("GET /posture/v2/scans/validations/scan-old/summary"))
+
+		saved, err := store.Load()
+		Expect(err).To(BeNil())
+		Expect(saved.ValidationCompleted).To(BeTrue())
+		Expect(saved.ScanID).To(BeEmpty())
+	})
+	It(`Finds the scan when the local clock is ahead of the server`, func() {
+		serverNow := time.Now().Add(-time.Hour)
+		skewedServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Date", serverNow.UTC().Format(http.TimeFormat))
+			testServer.Config.Handler.ServeHTTP(res, req)
+		}))
+		defer skewedServer.Close()
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           skewedServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/scans/validations/latest_scans", fmt.Sprintf(`{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "latest_scans": [
+			{"scan_id": "scan-1", "scope_id": "scope-1", "report_setting_id": "rs-1", "start_time": "%s"}
+		]}`, serverNow.UTC().Format("2006-01-02T15:04:05.000Z")))
+		result, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(BeNil())
+		Expect(*result.Scan.ScanID).To(Equal("scan-1"))
+		Expect(time.Time(*result.Checkpoint.ValidationStartedAt).Before(serverNow)).To(BeTrue())
+	})
+	It(`Fails when a collector or credential has no ID`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		testServer.SetResponse("GET /posture/v2/collectors", `{"offset": 0, "limit": 10, "total_count": 1, "first": {"href": "first"}, "last": {"href": "last"}, "collectors": [{"name": "test-collector", "display_name": "test-collector", "status": "active"}]}`)
+		_, err := postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(MatchError("collector test-collector was listed without an ID"))
+
+		testServer.SetResponse("GET /posture/v2/collectors", `{"offset": 0, "limit": 10, "total_count": 0, "first": {"href": "first"}, "last": {"href": "last"}, "collectors": []}`)
+		testServer.SetResponse("POST /posture/v2/collectors", `{"name": "test-collector"}`)
+		_, err = postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(MatchError("collector test-collector was created without an ID"))
+
+		testServer.SetResponse("POST /posture/v2/collectors", `{"id": "collector-1", "name": "test-collector"}`)
+		testServer.SetResponse("POST /posture/v2/credentials", `{"name": "test-credential"}`)
+		_, err = postureManagementService.RunScan(newRunScanOptions(postureManagementService))
+		Expect(err).To(MatchError("credential test-credential was created without an ID"))
+	})
+	It(`Fails when options are missing`, func() {
+		postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		_, err := postureManagementService.RunScan(&posturemanagementv2.RunScanOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = postureManagementService.RunScan(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
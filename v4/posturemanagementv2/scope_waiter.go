@@ -0,0 +1,421 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// Default polling intervals used by WaitForScopeTask.
+const (
+	DefaultScopeTaskPollInterval    = 5 * time.Second
+	DefaultScopeTaskMaxPollInterval = time.Minute
+)
+
+// ScopeTaskState : The coarse state of a scope task, derived from its status.
+type ScopeTaskState string
+
+// Constants associated with ScopeTaskState.
+const (
+	ScopeTaskStateRunningConst   ScopeTaskState = "running"
+	ScopeTaskStateSucceededConst ScopeTaskState = "succeeded"
+	ScopeTaskStateFailedConst    ScopeTaskState = "failed"
+)
+
+// scopeTaskSucceededStatuses are the statuses that end a task without error.
+var scopeTaskSucceededStatuses = map[string]bool{
+	EventItemStatusDiscoveryResultPostedNoErrorConst:   true,
+	EventItemStatusValidationResultPostedNoErrorConst:  true,
+	EventItemStatusInventoryCompletedConst:             true,
+	EventItemStatusRemediationCompletedConst:           true,
+	EventItemStatusCertValidationCompletedConst:        true,
+	EventItemStatusCertRegularValidationCompletedConst: true,
+	EventItemStatusCveValidationCompletedConst:         true,
+	EventItemStatusCveRegularValidationCompletedConst:  true,
+	EventItemStatusEolValidationCompletedConst:         true,
+	EventItemStatusEolRegularValidationCompletedConst:  true,
+}
+
+// scopeTaskFailedStatuses are the statuses that end a task with an error. An aborted task did not complete, so it
+// fails as well.
+var scopeTaskFailedStatuses = map[string]bool{
+	EventItemStatusDiscoveryResultPostedWithErrorConst:  true,
+	EventItemStatusValidationResultPostedWithErrorConst: true,
+	EventItemStatusInventoryCompletedWithErrorConst:     true,
+	EventItemStatusCertValidationErrorConst:             true,
+	EventItemStatusCertRegularValidationErrorConst:      true,
+	EventItemStatusCveValidationErrorConst:              true,
+	EventItemStatusCveRegularValidationErrorConst:       true,
+	EventItemStatusEolValidationErrorConst:              true,
+	EventItemStatusEolRegularValidationErrorConst:       true,
+	EventItemStatusAbortTaskRequestCompletedConst:       true,
+	EventItemStatusAbortTaskRequestFailedConst:          true,
+	EventItemStatusControllerAbortedConst:               true,
+	EventItemStatusGatewayAbortedConst:                  true,
+	EventItemStatusLocationChangeAbortedConst:           true,
+	EventItemStatusUserAbortedConst:                     true,
+	EventItemStatusNotAcceptedConst:                     true,
+}
+
+// GetScopeTaskState returns the state of a task with the specified status. Any status starting with "error_in_"
+// is treated as a failure, and statuses that are not known to be terminal are treated as running.
+func GetScopeTaskState(status string) ScopeTaskState {
+	switch {
+	case scopeTaskSucceededStatuses[status]:
+		return ScopeTaskStateSucceededConst
+	case scopeTaskFailedStatuses[status], strings.HasPrefix(status, "error_in_"):
+		return ScopeTaskStateFailedConst
+	default:
+		return ScopeTaskStateRunningConst
+	}
+}
+
+// ScopeTaskProgress : A progress update that is sent while waiting for a scope task.
+type ScopeTaskProgress struct {
+	// The status of the task.
+	Status string
+
+	// The state derived from Status.
+	State ScopeTaskState
+
+	// The task status, set when waiting on a correlation ID.
+	Task *ScopeTaskStatus
+
+	// The timeline event, set when waiting on a scope ID.
+	Event *EventItem
+}
+
+// ScopeTaskFailedError is returned by WaitForScopeTask when the task ends with an error status.
+type ScopeTaskFailedError struct {
+	// The final status of the task.
+	Status *ScopeTaskStatus
+
+	// The status message of the final timeline event, if any.
+	Message string
+}
+
+func (e *ScopeTaskFailedError) Error() string {
+	msg := fmt.Sprintf("scope task %s failed with status %s", core.StringNilMapper(e.Status.CorrelationID), core.StringNilMapper(e.Status.Status))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// ScopeTaskTimeoutError is returned by WaitForScopeTask when the timeout or context deadline passes before the task
+// ends.
+type ScopeTaskTimeoutError struct {
+	// The last status that was observed, or nil if none was.
+	LastStatus *ScopeTaskStatus
+
+	// The time spent waiting.
+	Elapsed time.Duration
+
+	// The context error that stopped the wait.
+	Err error
+}
+
+func (e *ScopeTaskTimeoutError) Error() string {
+	last := "none"
+	if e.LastStatus != nil {
+		last = core.StringNilMapper(e.LastStatus.Status)
+	}
+	return fmt.Sprintf("timed out after %s waiting for scope task, last status: %s", e.Elapsed, last)
+}
+
+// Unwrap returns the context error that stopped the wait.
+func (e *ScopeTaskTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForScopeTaskOptions : The WaitForScopeTask options.
+type WaitForScopeTaskOptions struct {
+	// The correlation ID of the task, as returned when discovery or validation is triggered. When set, the task is
+	// polled with GetCorrelationID.
+	CorrelationID *string
+
+	// The ID of the scope. When CorrelationID is not set, the scope timeline is polled with GetScopeTimeline.
+	ScopeID *string
+
+	// Timeline events last updated before this time are ignored. Defaults to the time the wait starts.
+	Since *strfmt.DateTime
+
+	// When set, timeline events of other task types are ignored. Use the EventItemTaskType*Const values.
+	TaskType *string
+
+	// The delay before the second poll. The delay doubles after each poll up to MaxPollInterval.
+	PollInterval time.Duration
+
+	// The longest delay between two polls.
+	MaxPollInterval time.Duration
+
+	// The longest time to wait. Zero means the wait is bounded only by the context.
+	Timeout time.Duration
+
+	// Receives an update for every new status that is observed. The channel is not closed.
+	Progress chan<- ScopeTaskProgress
+
+	// Your IBM Cloud account ID.
+	AccountID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewWaitForScopeTaskOptions : Instantiate WaitForScopeTaskOptions
+func (*PostureManagementV2) NewWaitForScopeTaskOptions() *WaitForScopeTaskOptions {
+	return &WaitForScopeTaskOptions{}
+}
+
+// SetCorrelationID : Allow user to set CorrelationID
+func (_options *WaitForScopeTaskOptions) SetCorrelationID(correlationID string) *WaitForScopeTaskOptions {
+	_options.CorrelationID = core.StringPtr(correlationID)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *WaitForScopeTaskOptions) SetScopeID(scopeID string) *WaitForScopeTaskOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetSince : Allow user to set Since
+func (_options *WaitForScopeTaskOptions) SetSince(since *strfmt.DateTime) *WaitForScopeTaskOptions {
+	_options.Since = since
+	return _options
+}
+
+// SetTaskType : Allow user to set TaskType
+func (_options *WaitForScopeTaskOptions) SetTaskType(taskType string) *WaitForScopeTaskOptions {
+	_options.TaskType = core.StringPtr(taskType)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval and MaxPollInterval
+func (_options *WaitForScopeTaskOptions) SetPollInterval(pollInterval time.Duration, maxPollInterval time.Duration) *WaitForScopeTaskOptions {
+	_options.PollInterval = pollInterval
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForScopeTaskOptions) SetTimeout(timeout time.Duration) *WaitForScopeTaskOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetProgress : Allow user to set Progress
+func (_options *WaitForScopeTaskOptions) SetProgress(progress chan<- ScopeTaskProgress) *WaitForScopeTaskOptions {
+	_options.Progress = progress
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *WaitForScopeTaskOptions) SetAccountID(accountID string) *WaitForScopeTaskOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForScopeTaskOptions) SetHeaders(param map[string]string) *WaitForScopeTaskOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForScopeTask : Wait for a discovery or validation task to finish
+// Poll the status of a task until it reaches a terminal status. The final status is returned when the task succeeds,
+// a *ScopeTaskFailedError when it fails and a *ScopeTaskTimeoutError when the wait runs out of time.
+func (postureManagement *PostureManagementV2) WaitForScopeTask(waitForScopeTaskOptions *WaitForScopeTaskOptions) (result *ScopeTaskStatus, err error) {
+	return postureManagement.WaitForScopeTaskWithContext(context.Background(), waitForScopeTaskOptions)
+}
+
+// WaitForScopeTaskWithContext is an alternate form of the WaitForScopeTask method which supports a Context parameter
+func (postureManagement *PostureManagementV2) WaitForScopeTaskWithContext(ctx context.Context, waitForScopeTaskOptions *WaitForScopeTaskOptions) (result *ScopeTaskStatus, err error) {
+	err = core.ValidateNotNil(waitForScopeTaskOptions, "waitForScopeTaskOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	options := *waitForScopeTaskOptions
+	if options.CorrelationID == nil && options.ScopeID == nil {
+		err = fmt.Errorf("either CorrelationID or ScopeID must be set")
+		return
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultScopeTaskPollInterval
+	}
+	if options.MaxPollInterval < options.PollInterval {
+		options.MaxPollInterval = DefaultScopeTaskMaxPollInterval
+		if options.MaxPollInterval < options.PollInterval {
+			options.MaxPollInterval = options.PollInterval
+		}
+	}
+	start := time.Now()
+	if options.Since == nil {
+		since := strfmt.DateTime(start)
+		options.Since = &since
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	poller := &scopeTaskPoller{
+		service: postureManagement,
+		options: &options,
+		seen:    make(map[string]bool),
+	}
+	interval := options.PollInterval
+	for {
+		var progress *ScopeTaskProgress
+		progress, err = poller.poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = &ScopeTaskTimeoutError{LastStatus: poller.last, Elapsed: time.Since(start), Err: ctx.Err()}
+			}
+			return
+		}
+		if progress != nil {
+			if options.Progress != nil {
+				select {
+				case options.Progress <- *progress:
+				case <-ctx.Done():
+				}
+			}
+			switch progress.State {
+			case ScopeTaskStateSucceededConst:
+				result = poller.last
+				return
+			case ScopeTaskStateFailedConst:
+				failed := &ScopeTaskFailedError{Status: poller.last}
+				if progress.Event != nil {
+					failed.Message = core.StringNilMapper(progress.Event.StatusMessage)
+				}
+				err = failed
+				return
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = &ScopeTaskTimeoutError{LastStatus: poller.last, Elapsed: time.Since(start), Err: ctx.Err()}
+			return
+		case <-timer.C:
+		}
+		interval *= 2
+		if interval > options.MaxPollInterval {
+			interval = options.MaxPollInterval
+		}
+	}
+}
+
+// scopeTaskPoller fetches the current status of a task and remembers what has already been reported.
+type scopeTaskPoller struct {
+	service *PostureManagementV2
+	options *WaitForScopeTaskOptions
+	last    *ScopeTaskStatus
+	seen    map[string]bool
+}
+
+// poll returns a progress update when the status has changed since the previous poll, and nil otherwise.
+func (poller *scopeTaskPoller) poll(ctx context.Context) (*ScopeTaskProgress, error) {
+	if poller.options.CorrelationID != nil {
+		return poller.pollCorrelationID(ctx)
+	}
+	return poller.pollTimeline(ctx)
+}
+
+func (poller *scopeTaskPoller) pollCorrelationID(ctx context.Context) (*ScopeTaskProgress, error) {
+	getCorrelationIDOptions := poller.service.NewGetCorrelationIDOptions(*poller.options.CorrelationID)
+	getCorrelationIDOptions.AccountID = poller.options.AccountID
+	getCorrelationIDOptions.Headers = poller.options.Headers
+	status, _, err := poller.service.GetCorrelationIDWithContext(ctx, getCorrelationIDOptions)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil || status.Status == nil {
+		return nil, nil
+	}
+	poller.last = status
+	if poller.seen[*status.Status] {
+		return nil, nil
+	}
+	poller.seen[*status.Status] = true
+	return &ScopeTaskProgress{
+		Status: *status.Status,
+		State:  GetScopeTaskState(*status.Status),
+		Task:   status,
+	}, nil
+}
+
+func (poller *scopeTaskPoller) pollTimeline(ctx context.Context) (*ScopeTaskProgress, error) {
+	getScopeTimelineOptions := poller.service.NewGetScopeTimelineOptions(*poller.options.ScopeID)
+	getScopeTimelineOptions.AccountID = poller.options.AccountID
+	getScopeTimelineOptions.Headers = poller.options.Headers
+	eventList, _, err := poller.service.GetScopeTimelineWithContext(ctx, getScopeTimelineOptions)
+	if err != nil {
+		return nil, err
+	}
+	if eventList == nil {
+		return nil, nil
+	}
+
+	since := time.Time(*poller.options.Since)
+	var latest *EventItem
+	for i := range eventList.Events {
+		event := &eventList.Events[i]
+		// Events without a status say nothing about the progress of the task.
+		if event.Status == nil || event.UpdatedAt == nil || time.Time(*event.UpdatedAt).Before(since) {
+			continue
+		}
+		if poller.options.TaskType != nil && core.StringNilMapper(event.TaskType) != *poller.options.TaskType {
+			continue
+		}
+		if latest == nil || time.Time(*event.UpdatedAt).After(time.Time(*latest.UpdatedAt)) {
+			latest = event
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	poller.last = &ScopeTaskStatus{
+		CorrelationID: latest.ID,
+		Status:        latest.Status,
+	}
+	if latest.CreatedAt != nil {
+		poller.last.StartTime = core.StringPtr(latest.CreatedAt.String())
+	}
+	key := core.StringNilMapper(latest.ID) + "/" + *latest.Status
+	if poller.seen[key] {
+		return nil, nil
+	}
+	poller.seen[key] = true
+	return &ScopeTaskProgress{
+		Status: *latest.Status,
+		State:  GetScopeTaskState(*latest.Status),
+		Event:  latest,
+	}, nil
+}
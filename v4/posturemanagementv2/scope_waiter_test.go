@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 scope task waiter`, func() {
+	var testServer *httptest.Server
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	Context(`GetScopeTaskState`, func() {
+		It(`Classifies terminal and running statuses`, func() {
+			Expect(posturemanagementv2.GetScopeTaskState("validation_result_posted_no_error")).To(Equal(posturemanagementv2.ScopeTaskStateSucceededConst))
+			Expect(posturemanagementv2.GetScopeTaskState("discovery_result_posted_with_error")).To(Equal(posturemanagementv2.ScopeTaskStateFailedConst))
+			Expect(posturemanagementv2.GetScopeTaskState("error_in_discovery")).To(Equal(posturemanagementv2.ScopeTaskStateFailedConst))
+			Expect(posturemanagementv2.GetScopeTaskState("discovery_in_progress")).To(Equal(posturemanagementv2.ScopeTaskStateRunningConst))
+		})
+		It(`Fails aborted tasks and certificate, CVE and EOL validation errors`, func() {
+			statuses := []string{
+				"abort_task_request_completed",
+				"abort_task_request_failed",
+				"cert_validation_error",
+				"cert_regular_validation_error",
+				"cve_validation_error",
+				"cve_regular_validation_error",
+				"eol_validation_error",
+				"eol_regular_validation_error",
+			}
+			for _, status := range statuses {
+				Expect(posturemanagementv2.GetScopeTaskState(status)).To(Equal(posturemanagementv2.ScopeTaskStateFailedConst), status)
+			}
+		})
+		It(`Succeeds completed certificate, CVE and EOL validations`, func() {
+			statuses := []string{
+				"cert_validation_completed",
+				"cert_regular_validation_completed",
+				"cve_validation_completed",
+				"cve_regular_validation_completed",
+				"eol_validation_completed",
+				"eol_regular_validation_completed",
+			}
+			for _, status := range statuses {
+				Expect(posturemanagementv2.GetScopeTaskState(status)).To(Equal(posturemanagementv2.ScopeTaskStateSucceededConst), status)
+			}
+		})
+	})
+
+	Context(`WaitForScopeTask by correlation ID`, func() {
+		It(`Returns the final status and streams progress`, func() {
+			statuses := []string{"sent_to_collector", "discovery_in_progress", "discovery_in_progress", "discovery_result_posted_no_error"}
+			calls := 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/posture/v2/scope/status/testCorrelation"))
+				Expect(req.URL.Query()["account_id"]).To(Equal([]string{"testAccount"}))
+				status := statuses[calls]
+				if calls < len(statuses)-1 {
+					calls++
+				}
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"correlation_id": "testCorrelation", "status": "%s", "start_time": "2022-01-01T00:00:00Z", "last_heartbeat": "2022-01-01T00:00:00.000Z"}`, status)
+			}))
+			postureManagementService, serviceErr := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			progress := make(chan posturemanagementv2.ScopeTaskProgress, 10)
+			waitOptions := postureManagementService.NewWaitForScopeTaskOptions().
+				SetCorrelationID("testCorrelation").
+				SetAccountID("testAccount").
+				SetPollInterval(time.Millisecond, 5*time.Millisecond).
+				SetProgress(progress)
+			result, err := postureManagementService.WaitForScopeTask(waitOptions)
+			Expect(err).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(*result.Status).To(Equal("discovery_result_posted_no_error"))
+
+			close(progress)
+			var seen []string
+			for p := range progress {
+				seen = append(seen, p.Status)
+			}
+			Expect(seen).To(Equal([]string{"sent_to_collector", "discovery_in_progress", "discovery_result_posted_no_error"}))
+		})
+		It(`Returns a typed error when the task fails`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"correlation_id": "testCorrelation", "status": "validation_result_posted_with_error", "start_time": "2022-01-01T00:00:00Z", "last_heartbeat": "2022-01-01T00:00:00.000Z"}`)
+			}))
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+
+			result, err := postureManagementService.WaitForScopeTask(postureManagementService.NewWaitForScopeTaskOptions().SetCorrelationID("testCorrelation"))
+			Expect(result).To(BeNil())
+			var failed *posturemanagementv2.ScopeTaskFailedError
+			Expect(errors.As(err, &failed)).To(BeTrue())
+			Expect(*failed.Status.Status).To(Equal("validation_result_posted_with_error"))
+		})
+		It(`Returns a typed error when the timeout passes`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"correlation_id": "testCorrelation", "status": "validation_in_progress", "start_time": "2022-01-01T00:00:00Z", "last_heartbeat": "2022-01-01T00:00:00.000Z"}`)
+			}))
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+
+			waitOptions := postureManagementService.NewWaitForScopeTaskOptions().
+				SetCorrelationID("testCorrelation").
+				SetPollInterval(5*time.Millisecond, 5*time.Millisecond).
+				SetTimeout(30 * time.Millisecond)
+			_, err := postureManagementService.WaitForScopeTask(waitOptions)
+			var timeout *posturemanagementv2.ScopeTaskTimeoutError
+			Expect(errors.As(err, &timeout)).To(BeTrue())
+			Expect(*timeout.LastStatus.Status).To(Equal("validation_in_progress"))
+		})
+	})
+
+	Context(`WaitForScopeTask by scope ID`, func() {
+		It(`Ignores events before Since and uses the latest event`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/posture/v2/scopes/testScope/events"))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"events": [
+					{"id": "old", "created_at": "2021-01-01T00:00:00.000Z", "updated_at": "2021-01-01T00:00:00.000Z", "task_type": "discovery", "status": "discovery_result_posted_no_error", "data_available": true, "status_message": "old"},
+					{"id": "new", "created_at": "2022-01-01T00:00:00.000Z", "updated_at": "2022-01-01T00:10:00.000Z", "task_type": "discovery", "status": "error_in_discovery", "data_available": false, "status_message": "collector unreachable"}
+				]}`)
+			}))
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+
+			since, _ := strfmt.ParseDateTime("2021-06-01T00:00:00.000Z")
+			_, err := postureManagementService.WaitForScopeTask(postureManagementService.NewWaitForScopeTaskOptions().SetScopeID("testScope").SetSince(&since))
+			var failed *posturemanagementv2.ScopeTaskFailedError
+			Expect(errors.As(err, &failed)).To(BeTrue())
+			Expect(failed.Message).To(Equal("collector unreachable"))
+		})
+		It(`Skips events without a status and leaves the start time unset without a creation time`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"events": [
+					{"id": "done", "updated_at": "2022-01-01T00:10:00.000Z", "task_type": "discovery", "status": "discovery_result_posted_no_error"},
+					{"id": "unknown", "created_at": "2022-01-01T00:00:00.000Z", "updated_at": "2022-01-01T00:20:00.000Z", "task_type": "discovery"}
+				]}`)
+			}))
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+
+			since, _ := strfmt.ParseDateTime("2021-06-01T00:00:00.000Z")
+			result, err := postureManagementService.WaitForScopeTask(postureManagementService.NewWaitForScopeTaskOptions().SetScopeID("testScope").SetSince(&since))
+			Expect(err).To(BeNil())
+			Expect(*result.CorrelationID).To(Equal("done"))
+			Expect(result.StartTime).To(BeNil())
+		})
+		It(`Requires a correlation ID or scope ID`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           "http://posturemanagementv2modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			_, err := postureManagementService.WaitForScopeTask(postureManagementService.NewWaitForScopeTaskOptions())
+			Expect(err).ToNot(BeNil())
+			_, err = postureManagementService.WaitForScopeTask(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
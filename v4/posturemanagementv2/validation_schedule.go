@@ -0,0 +1,306 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// DefaultValidationScheduleMinGap is the minimum time between two validations of a scope below which they are
+	// reported as overlapping.
+	DefaultValidationScheduleMinGap = time.Hour
+
+	// MaxValidationScheduleRuns caps the number of runs that a schedule without an end is expanded to.
+	MaxValidationScheduleRuns = 1000
+)
+
+// ValidationSchedule : The runs of a scheduled validation. The service does not list scheduled validations, so the
+// schedules that were submitted earlier must be kept by the caller to be checked against.
+type ValidationSchedule struct {
+	// The unique ID of the scope.
+	ScopeID string
+
+	// The name of the scheduled scan.
+	Name string
+
+	// The time of the first run.
+	Start time.Time
+
+	// The time between two runs. Zero for a single run.
+	Frequency time.Duration
+
+	// The number of runs. Zero when the number of runs is not limited.
+	Occurrences int64
+
+	// No run starts after this time. Zero when the schedule has no end time.
+	EndTime time.Time
+}
+
+// NewValidationSchedule : Instantiate a ValidationSchedule from CreateValidationOptions
+// The frequency of the options is in milliseconds. The first run is assumed to start at start, which is normally the
+// time at which the validation is submitted.
+func NewValidationSchedule(createValidationOptions *CreateValidationOptions, start time.Time) *ValidationSchedule {
+	schedule := &ValidationSchedule{
+		ScopeID: core.StringNilMapper(createValidationOptions.ScopeID),
+		Name:    core.StringNilMapper(createValidationOptions.Name),
+		Start:   start,
+	}
+	if createValidationOptions.Frequency != nil {
+		schedule.Frequency = time.Duration(*createValidationOptions.Frequency) * time.Millisecond
+	}
+	if createValidationOptions.NoOfOccurrences != nil {
+		schedule.Occurrences = *createValidationOptions.NoOfOccurrences
+	}
+	if createValidationOptions.EndTime != nil {
+		schedule.EndTime = time.Time(*createValidationOptions.EndTime)
+	}
+	return schedule
+}
+
+// Runs returns the start times of the runs of the schedule in order, capped at MaxValidationScheduleRuns.
+func (schedule *ValidationSchedule) Runs() []time.Time {
+	return schedule.RunsBetween(time.Time{}, time.Time{}, MaxValidationScheduleRuns)
+}
+
+// RunsBetween returns at most limit run times that are not before from and, unless until is zero, not after until.
+func (schedule *ValidationSchedule) RunsBetween(from time.Time, until time.Time, limit int) (runs []time.Time) {
+	if schedule.Frequency <= 0 {
+		if schedule.inRange(schedule.Start, from, until) && limit > 0 {
+			runs = append(runs, schedule.Start)
+		}
+		return
+	}
+	var i int64
+	if from.After(schedule.Start) {
+		// Skip straight to the first run at or after from.
+		i = int64(from.Sub(schedule.Start) / schedule.Frequency)
+	}
+	for ; len(runs) < limit; i++ {
+		if schedule.Occurrences > 0 && i >= schedule.Occurrences {
+			return
+		}
+		run := schedule.Start.Add(time.Duration(i) * schedule.Frequency)
+		if !schedule.EndTime.IsZero() && run.After(schedule.EndTime) {
+			return
+		}
+		if !until.IsZero() && run.After(until) {
+			return
+		}
+		if schedule.inRange(run, from, until) {
+			runs = append(runs, run)
+		}
+	}
+	return
+}
+
+func (schedule *ValidationSchedule) inRange(run time.Time, from time.Time, until time.Time) bool {
+	if !schedule.EndTime.IsZero() && run.After(schedule.EndTime) {
+		return false
+	}
+	return !run.Before(from) && (until.IsZero() || !run.After(until))
+}
+
+// ValidationScheduleWarningKind : The kind of a ValidationScheduleWarning.
+type ValidationScheduleWarningKind string
+
+// Constants associated with ValidationScheduleWarningKind.
+const (
+	ValidationScheduleWarningOverlapConst         ValidationScheduleWarningKind = "overlap"
+	ValidationScheduleWarningPastEndTimeConst     ValidationScheduleWarningKind = "past_end_time"
+	ValidationScheduleWarningEndsBeforeStartConst ValidationScheduleWarningKind = "ends_before_start"
+)
+
+// ValidationScheduleWarning : A problem with a proposed validation schedule.
+type ValidationScheduleWarning struct {
+	// The kind of problem.
+	Kind ValidationScheduleWarningKind
+
+	// A description of the problem.
+	Message string
+
+	// For an overlap, the existing schedule that is overlapped.
+	Existing *ValidationSchedule
+
+	// For an overlap, the first run of the proposed schedule that overlaps.
+	At time.Time
+}
+
+// CheckValidationSchedule : Check a proposed validation schedule
+// Warn when runs of the proposed schedule start within minGap of runs of an existing schedule of the same scope, when
+// some of the requested occurrences fall after the end time and when the end time is before the first run. A minGap of
+// zero uses DefaultValidationScheduleMinGap.
+func CheckValidationSchedule(proposed *ValidationSchedule, existing []ValidationSchedule, minGap time.Duration) (warnings []ValidationScheduleWarning) {
+	if minGap <= 0 {
+		minGap = DefaultValidationScheduleMinGap
+	}
+
+	if !proposed.EndTime.IsZero() && proposed.EndTime.Before(proposed.Start) {
+		warnings = append(warnings, ValidationScheduleWarning{
+			Kind:    ValidationScheduleWarningEndsBeforeStartConst,
+			Message: fmt.Sprintf("end time %s is before the first run at %s; the validation never runs", proposed.EndTime.Format(time.RFC3339), proposed.Start.Format(time.RFC3339)),
+		})
+		return
+	}
+	if proposed.Frequency > 0 && proposed.Occurrences > 0 && !proposed.EndTime.IsZero() {
+		last := proposed.Start.Add(time.Duration(proposed.Occurrences-1) * proposed.Frequency)
+		if last.After(proposed.EndTime) {
+			runs := int64(proposed.EndTime.Sub(proposed.Start)/proposed.Frequency) + 1
+			warnings = append(warnings, ValidationScheduleWarning{
+				Kind:    ValidationScheduleWarningPastEndTimeConst,
+				Message: fmt.Sprintf("%d of %d occurrences fall after the end time %s and do not run", proposed.Occurrences-runs, proposed.Occurrences, proposed.EndTime.Format(time.RFC3339)),
+			})
+		}
+	}
+
+	proposedRuns := proposed.Runs()
+	for i := range existing {
+		other := &existing[i]
+		if other.ScopeID != proposed.ScopeID {
+			continue
+		}
+		otherRuns := other.RunsBetween(proposed.Start.Add(-minGap), time.Time{}, MaxValidationScheduleRuns)
+		if at, ok := firstOverlap(proposedRuns, otherRuns, minGap); ok {
+			name := other.Name
+			if name == "" {
+				name = "an existing validation"
+			}
+			warnings = append(warnings, ValidationScheduleWarning{
+				Kind:     ValidationScheduleWarningOverlapConst,
+				Message:  fmt.Sprintf("the run at %s is less than %s from a run of %s on scope %s", at.Format(time.RFC3339), minGap, name, proposed.ScopeID),
+				Existing: other,
+				At:       at,
+			})
+		}
+	}
+	return
+}
+
+// firstOverlap returns the first run of a that is less than minGap away from a run of b. Both must be sorted.
+func firstOverlap(a []time.Time, b []time.Time, minGap time.Duration) (time.Time, bool) {
+	j := 0
+	for _, run := range a {
+		for j < len(b) && !b[j].Add(minGap).After(run) {
+			j++
+		}
+		if j < len(b) && b[j].Sub(run) < minGap {
+			return run, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ValidationScheduleConflictError : Returned by ScheduleValidation when the schedule has warnings and Force is not set.
+type ValidationScheduleConflictError struct {
+	Warnings []ValidationScheduleWarning
+}
+
+func (e *ValidationScheduleConflictError) Error() string {
+	messages := make([]string, len(e.Warnings))
+	for i, warning := range e.Warnings {
+		messages[i] = warning.Message
+	}
+	return fmt.Sprintf("validation schedule was not submitted: %s", strings.Join(messages, "; "))
+}
+
+// ScheduleValidationOptions : The ScheduleValidation options.
+type ScheduleValidationOptions struct {
+	// The validation to submit.
+	Validation *CreateValidationOptions `validate:"required"`
+
+	// The schedules that were submitted earlier.
+	Existing []ValidationSchedule
+
+	// The minimum time between two validations of the scope. Zero uses DefaultValidationScheduleMinGap.
+	MinGap time.Duration
+
+	// Submit the validation even when the schedule has warnings.
+	Force bool
+}
+
+// NewScheduleValidationOptions : Instantiate ScheduleValidationOptions
+func (*PostureManagementV2) NewScheduleValidationOptions(validation *CreateValidationOptions) *ScheduleValidationOptions {
+	return &ScheduleValidationOptions{
+		Validation: validation,
+	}
+}
+
+// SetExisting : Allow user to set Existing
+func (_options *ScheduleValidationOptions) SetExisting(existing []ValidationSchedule) *ScheduleValidationOptions {
+	_options.Existing = existing
+	return _options
+}
+
+// SetMinGap : Allow user to set MinGap
+func (_options *ScheduleValidationOptions) SetMinGap(minGap time.Duration) *ScheduleValidationOptions {
+	_options.MinGap = minGap
+	return _options
+}
+
+// SetForce : Allow user to set Force
+func (_options *ScheduleValidationOptions) SetForce(force bool) *ScheduleValidationOptions {
+	_options.Force = force
+	return _options
+}
+
+// ScheduleValidationResult : The outcome of ScheduleValidation.
+type ScheduleValidationResult struct {
+	// The schedule that was checked, starting at the time of submission.
+	Schedule *ValidationSchedule
+
+	// The problems that were found.
+	Warnings []ValidationScheduleWarning
+
+	// The response of CreateValidation. Nil when the validation was not submitted.
+	Result *Result
+}
+
+// ScheduleValidation : Check and submit a scheduled validation
+// Expand the schedule of the validation, check it against the existing schedules and submit it with CreateValidation.
+// When there are warnings and Force is not set, nothing is submitted and a *ValidationScheduleConflictError is
+// returned together with the result.
+func (postureManagement *PostureManagementV2) ScheduleValidation(scheduleValidationOptions *ScheduleValidationOptions) (result *ScheduleValidationResult, err error) {
+	return postureManagement.ScheduleValidationWithContext(context.Background(), scheduleValidationOptions)
+}
+
+// ScheduleValidationWithContext is an alternate form of the ScheduleValidation method which supports a Context parameter
+func (postureManagement *PostureManagementV2) ScheduleValidationWithContext(ctx context.Context, scheduleValidationOptions *ScheduleValidationOptions) (result *ScheduleValidationResult, err error) {
+	err = core.ValidateNotNil(scheduleValidationOptions, "scheduleValidationOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(scheduleValidationOptions.Validation, "createValidationOptions")
+	if err != nil {
+		return
+	}
+
+	result = &ScheduleValidationResult{
+		Schedule: NewValidationSchedule(scheduleValidationOptions.Validation, time.Now()),
+	}
+	result.Warnings = CheckValidationSchedule(result.Schedule, scheduleValidationOptions.Existing, scheduleValidationOptions.MinGap)
+	if len(result.Warnings) > 0 && !scheduleValidationOptions.Force {
+		err = &ValidationScheduleConflictError{Warnings: result.Warnings}
+		return
+	}
+	result.Result, _, err = postureManagement.CreateValidationWithContext(ctx, scheduleValidationOptions.Validation)
+	return
+}
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posturemanagementv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/posturemanagementv2"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PostureManagementV2 validation schedule`, func() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	Context(`ValidationSchedule`, func() {
+		It(`Expands frequency and occurrences into run times`, func() {
+			end := strfmt.DateTime(start.Add(50 * time.Hour))
+			schedule := posturemanagementv2.NewValidationSchedule(&posturemanagementv2.CreateValidationOptions{
+				ScopeID:         core.StringPtr("scope-1"),
+				Frequency:       core.Int64Ptr(int64(24 * time.Hour / time.Millisecond)),
+				NoOfOccurrences: core.Int64Ptr(5),
+				EndTime:         &end,
+			}, start)
+			Expect(schedule.Runs()).To(Equal([]time.Time{start, start.Add(24 * time.Hour), start.Add(48 * time.Hour)}))
+			Expect(schedule.RunsBetween(start.Add(time.Hour), time.Time{}, 1)).To(Equal([]time.Time{start.Add(24 * time.Hour)}))
+
+			warnings := posturemanagementv2.CheckValidationSchedule(schedule, nil, 0)
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Kind).To(Equal(posturemanagementv2.ValidationScheduleWarningPastEndTimeConst))
+			Expect(warnings[0].Message).To(HavePrefix("2 of 5 occurrences fall after the end time"))
+		})
+		It(`Runs once without a frequency`, func() {
+			schedule := &posturemanagementv2.ValidationSchedule{Start: start}
+			Expect(schedule.Runs()).To(Equal([]time.Time{start}))
+		})
+		It(`Caps schedules without an end`, func() {
+			schedule := &posturemanagementv2.ValidationSchedule{Start: start, Frequency: time.Hour}
+			Expect(schedule.Runs()).To(HaveLen(posturemanagementv2.MaxValidationScheduleRuns))
+		})
+		It(`Detects overlapping runs on the same scope only`, func() {
+			proposed := &posturemanagementv2.ValidationSchedule{ScopeID: "scope-1", Start: start, Frequency: 6 * time.Hour, Occurrences: 4}
+			existing := []posturemanagementv2.ValidationSchedule{
+				{ScopeID: "scope-1", Name: "nightly", Start: start.Add(-24*time.Hour + 12*time.Hour + 30*time.Minute), Frequency: 24 * time.Hour},
+				{ScopeID: "scope-1", Name: "apart", Start: start.Add(3 * time.Hour), Frequency: 6 * time.Hour, Occurrences: 4},
+				{ScopeID: "scope-2", Name: "elsewhere", Start: start},
+			}
+			warnings := posturemanagementv2.CheckValidationSchedule(proposed, existing, time.Hour)
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Kind).To(Equal(posturemanagementv2.ValidationScheduleWarningOverlapConst))
+			Expect(warnings[0].Existing.Name).To(Equal("nightly"))
+			Expect(warnings[0].At).To(Equal(start.Add(12 * time.Hour)))
+		})
+		It(`Warns when the end time is before the start`, func() {
+			proposed := &posturemanagementv2.ValidationSchedule{Start: start, EndTime: start.Add(-time.Hour)}
+			warnings := posturemanagementv2.CheckValidationSchedule(proposed, nil, 0)
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Kind).To(Equal(posturemanagementv2.ValidationScheduleWarningEndsBeforeStartConst))
+		})
+	})
+
+	Context(`ScheduleValidation`, func() {
+		var testServer *httptest.Server
+		var submitted int
+		BeforeEach(func() {
+			submitted = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/posture/v2/scans/validations"))
+				submitted++
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"result": true, "message": "Successfully started validation"}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Refuses an overlapping schedule unless forced`, func() {
+			postureManagementService, _ := posturemanagementv2.NewPostureManagementV2(&posturemanagementv2.PostureManagementV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			existing := []posturemanagementv2.ValidationSchedule{{ScopeID: "scope-1", Start: time.Now().Add(10 * time.Minute)}}
+			scheduleValidationOptions := postureManagementService.NewScheduleValidationOptions(
+				postureManagementService.NewCreateValidationOptions("scope-1", "profile-1").SetName("scheduled"),
+			).SetExisting(existing)
+
+			result, err := postureManagementService.ScheduleValidation(scheduleValidationOptions)
+			var conflictErr *posturemanagementv2.ValidationScheduleConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(result.Warnings).To(HaveLen(1))
+			Expect(result.Result).To(BeNil())
+			Expect(submitted).To(Equal(0))
+
+			result, err = postureManagementService.ScheduleValidation(scheduleValidationOptions.SetForce(true))
+			Expect(err).To(BeNil())
+			Expect(*result.Result.Result).To(BeTrue())
+			Expect(submitted).To(Equal(1))
+		})
+	})
+})
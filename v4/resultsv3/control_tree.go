@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SkipControlTreeNode can be returned by a ControlTreeWalkFunc to skip the children of the node it was called for.
+var SkipControlTreeNode = errors.New("skip this node")
+
+// ControlPathSplitter splits a control path into the labels of its levels, from the top of the hierarchy down.
+type ControlPathSplitter func(controlPath string) []string
+
+var (
+	nistControlPath    = regexp.MustCompile(`^([A-Za-z]+)-(\d+)((?:\([^()]*\))*)(.*)$`)
+	dottedControlPath  = regexp.MustCompile(`^[\w-]+(?:\.[\w-]+)+$`)
+	controlEnhancement = regexp.MustCompile(`\([^()]*\)`)
+)
+
+// SplitControlPath is the default ControlPathSplitter.
+// A path with ">" or "/" separators is split on them. A NIST style path is split into family, control and
+// enhancements, so "AC-2(a)(1)" becomes "AC", "AC-2", "AC-2(a)" and "AC-2(a)(1)". A dotted path is split into its
+// prefixes, so "1.2.3" becomes "1", "1.2" and "1.2.3". Any other path is a single level.
+func SplitControlPath(controlPath string) (labels []string) {
+	controlPath = strings.TrimSpace(controlPath)
+	if controlPath == "" {
+		return nil
+	}
+	for _, separator := range []string{">", "/"} {
+		if strings.Contains(controlPath, separator) {
+			for _, label := range strings.Split(controlPath, separator) {
+				if label = strings.TrimSpace(label); label != "" {
+					labels = append(labels, label)
+				}
+			}
+			return
+		}
+	}
+	if match := nistControlPath.FindStringSubmatch(controlPath); match != nil {
+		control := match[1] + "-" + match[2]
+		labels = append(labels, match[1], control)
+		for _, enhancement := range controlEnhancement.FindAllString(match[3], -1) {
+			control += enhancement
+			labels = append(labels, control)
+		}
+		if match[4] != "" {
+			labels[len(labels)-1] += match[4]
+		}
+		return
+	}
+	if dottedControlPath.MatchString(controlPath) {
+		parts := strings.Split(controlPath, ".")
+		for i := range parts {
+			labels = append(labels, strings.Join(parts[:i+1], "."))
+		}
+		return
+	}
+	return []string{controlPath}
+}
+
+// ControlTreeNode : A level of the control hierarchy.
+type ControlTreeNode struct {
+	// The label of the level. Empty for the root.
+	Name string
+
+	// The labels of the levels from the top of the hierarchy down to and including this one. Empty for the root.
+	Path []string
+
+	// The controls whose path ends at this level.
+	Controls []*ControlWithStats
+
+	// The levels below this one, in the order in which they were first seen.
+	Children []*ControlTreeNode
+
+	// The check counts of every control at or below this level added up. The status is the worst status of those
+	// controls.
+	Stats ComplianceStats
+
+	// The number of controls at or below this level.
+	ControlCount int64
+}
+
+// NewControlTree : Build the control hierarchy of a list of controls
+// Every control is placed at the node of its control path, split with splitter, or SplitControlPath when splitter is
+// nil. A control without a path is placed at a top level node named after the control, or its ID when it has no name.
+// The returned root has no name and holds the rolled up stats of all controls.
+func NewControlTree(controls []ControlWithStats, splitter ControlPathSplitter) *ControlTreeNode {
+	if splitter == nil {
+		splitter = SplitControlPath
+	}
+	root := &ControlTreeNode{}
+	for i := range controls {
+		control := &controls[i]
+		labels := splitter(control.GetControlPath())
+		if len(labels) == 0 {
+			label := control.GetControlName()
+			if label == "" {
+				label = control.GetID()
+			}
+			labels = []string{label}
+		}
+		node := root
+		for _, label := range labels {
+			node = node.child(label)
+		}
+		node.Controls = append(node.Controls, control)
+	}
+	root.rollUp()
+	return root
+}
+
+func (node *ControlTreeNode) child(name string) *ControlTreeNode {
+	for _, child := range node.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+	path := make([]string, len(node.Path), len(node.Path)+1)
+	copy(path, node.Path)
+	child := &ControlTreeNode{
+		Name: name,
+		Path: append(path, name),
+	}
+	node.Children = append(node.Children, child)
+	return child
+}
+
+// controlStatusRank orders statuses from best to worst.
+var controlStatusRank = map[string]int{
+	ControlWithStats_Status_Compliant:              1,
+	ControlWithStats_Status_UserEvaluationRequired: 2,
+	ControlWithStats_Status_UnableToPerform:        3,
+	ControlWithStats_Status_NotCompliant:           4,
+}
+
+// rollUp recomputes the stats of the node and every node below it.
+func (node *ControlTreeNode) rollUp() {
+	var total, compliant, notCompliant, unableToPerform, userEvaluationRequired int64
+	status := ""
+	worse := func(other string) {
+		if controlStatusRank[other] > controlStatusRank[status] {
+			status = other
+		}
+	}
+	node.ControlCount = int64(len(node.Controls))
+	for _, control := range node.Controls {
+		total += control.GetTotalCount()
+		compliant += control.GetCompliantCount()
+		notCompliant += control.GetNotCompliantCount()
+		unableToPerform += control.GetUnableToPerformCount()
+		userEvaluationRequired += control.GetUserEvaluationRequiredCount()
+		worse(control.GetStatus())
+	}
+	for _, child := range node.Children {
+		child.rollUp()
+		node.ControlCount += child.ControlCount
+		total += child.Stats.GetTotalCount()
+		compliant += child.Stats.GetCompliantCount()
+		notCompliant += child.Stats.GetNotCompliantCount()
+		unableToPerform += child.Stats.GetUnableToPerformCount()
+		userEvaluationRequired += child.Stats.GetUserEvaluationRequiredCount()
+		worse(child.Stats.GetStatus())
+	}
+	node.Stats = ComplianceStats{
+		TotalCount:                  core.Int64Ptr(total),
+		CompliantCount:              core.Int64Ptr(compliant),
+		NotCompliantCount:           core.Int64Ptr(notCompliant),
+		UnableToPerformCount:        core.Int64Ptr(unableToPerform),
+		UserEvaluationRequiredCount: core.Int64Ptr(userEvaluationRequired),
+	}
+	if status != "" {
+		node.Stats.Status = core.StringPtr(status)
+	}
+}
+
+// ControlTreeWalkFunc is called by Walk for every node, with the depth of the node below the node that Walk was called
+// on. Returning SkipControlTreeNode skips the children of the node; returning any other error stops the walk.
+type ControlTreeWalkFunc func(node *ControlTreeNode, depth int) error
+
+// Walk calls fn for the node and every node below it, depth first, parents before their children.
+func (node *ControlTreeNode) Walk(fn ControlTreeWalkFunc) error {
+	err := node.walk(fn, 0)
+	if err == SkipControlTreeNode {
+		return nil
+	}
+	return err
+}
+
+func (node *ControlTreeNode) walk(fn ControlTreeWalkFunc, depth int) error {
+	if err := fn(node, depth); err != nil {
+		return err
+	}
+	for _, child := range node.Children {
+		if err := child.walk(fn, depth+1); err != nil && err != SkipControlTreeNode {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find returns the node at the given labels below this node, or nil when there is no such node.
+func (node *ControlTreeNode) Find(labels ...string) *ControlTreeNode {
+	for _, label := range labels {
+		var next *ControlTreeNode
+		for _, child := range node.Children {
+			if child.Name == label {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
+
+// Filter returns a copy of the tree that holds only the controls for which keep returns true. Nodes that are left
+// without controls at or below them are removed and the stats are rolled up again. The controls are shared with the
+// original tree.
+func (node *ControlTreeNode) Filter(keep func(control *ControlWithStats) bool) *ControlTreeNode {
+	filtered := node.filter(keep)
+	if filtered == nil {
+		filtered = &ControlTreeNode{
+			Name: node.Name,
+			Path: node.Path,
+		}
+	}
+	filtered.rollUp()
+	return filtered
+}
+
+func (node *ControlTreeNode) filter(keep func(control *ControlWithStats) bool) *ControlTreeNode {
+	filtered := &ControlTreeNode{
+		Name: node.Name,
+		Path: node.Path,
+	}
+	for _, control := range node.Controls {
+		if keep(control) {
+			filtered.Controls = append(filtered.Controls, control)
+		}
+	}
+	for _, child := range node.Children {
+		if filteredChild := child.filter(keep); filteredChild != nil {
+			filtered.Children = append(filtered.Children, filteredChild)
+		}
+	}
+	if len(filtered.Controls) == 0 && len(filtered.Children) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// Render writes the tree as indented text, one line per node and one per control, for example:
+//
+//	AC  not_compliant  130/150 compliant
+//	  AC-2  not_compliant  130/150 compliant
+//	    - Account Management  not_compliant  130/150 compliant
+//
+// The root itself is not written.
+func (node *ControlTreeNode) Render(w io.Writer) error {
+	return node.Walk(func(current *ControlTreeNode, depth int) error {
+		indent := ""
+		if depth > 0 {
+			indent = strings.Repeat("  ", depth-1)
+			if _, err := fmt.Fprintf(w, "%s%s  %s\n", indent, current.Name, formatControlStats(&current.Stats)); err != nil {
+				return err
+			}
+			indent += "  "
+		}
+		for _, control := range current.Controls {
+			label := control.GetControlName()
+			if label == "" {
+				label = control.GetID()
+			}
+			if _, err := fmt.Fprintf(w, "%s- %s  %s\n", indent, label, formatControlStats(&ComplianceStats{
+				Status:         control.Status,
+				TotalCount:     control.TotalCount,
+				CompliantCount: control.CompliantCount,
+			})); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func formatControlStats(stats *ComplianceStats) string {
+	status := stats.GetStatus()
+	if status == "" {
+		status = "unknown"
+	}
+	return fmt.Sprintf("%s  %d/%d compliant", status, stats.GetCompliantCount(), stats.GetTotalCount())
+}
+
+// GetReportControlTree : Get the control hierarchy of a report
+// Get the controls of a report with GetReportControls and build their hierarchy with NewControlTree and
+// SplitControlPath.
+func (results *ResultsV3) GetReportControlTree(getReportControlsOptions *GetReportControlsOptions) (result *ControlTreeNode, err error) {
+	return results.GetReportControlTreeWithContext(context.Background(), getReportControlsOptions)
+}
+
+// GetReportControlTreeWithContext is an alternate form of the GetReportControlTree method which supports a Context parameter
+func (results *ResultsV3) GetReportControlTreeWithContext(ctx context.Context, getReportControlsOptions *GetReportControlsOptions) (result *ControlTreeNode, err error) {
+	controls, _, err := results.GetReportControlsWithContext(ctx, getReportControlsOptions)
+	if err != nil {
+		return
+	}
+	result = NewControlTree(controls.GetControls(), nil)
+	return
+}
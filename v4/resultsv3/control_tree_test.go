@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 control tree`, func() {
+	control := func(id string, path string, status string, compliant int64, notCompliant int64) resultsv3.ControlWithStats {
+		return resultsv3.ControlWithStats{
+			ID:                core.StringPtr(id),
+			ControlName:       core.StringPtr("control " + id),
+			ControlPath:       core.StringPtr(path),
+			Status:            core.StringPtr(status),
+			TotalCount:        core.Int64Ptr(compliant + notCompliant),
+			CompliantCount:    core.Int64Ptr(compliant),
+			NotCompliantCount: core.Int64Ptr(notCompliant),
+		}
+	}
+	controls := []resultsv3.ControlWithStats{
+		control("1", "AC-2", resultsv3.ControlWithStats_Status_Compliant, 4, 0),
+		control("2", "AC-2(a)", resultsv3.ControlWithStats_Status_NotCompliant, 1, 2),
+		control("3", "AC-3", resultsv3.ControlWithStats_Status_Compliant, 5, 0),
+		control("4", "SC-7(3)", resultsv3.ControlWithStats_Status_UserEvaluationRequired, 0, 0),
+	}
+
+	It(`Splits control paths`, func() {
+		Expect(resultsv3.SplitControlPath("AC-2(a)(1)")).To(Equal([]string{"AC", "AC-2", "AC-2(a)", "AC-2(a)(1)"}))
+		Expect(resultsv3.SplitControlPath("1.2.3")).To(Equal([]string{"1", "1.2", "1.2.3"}))
+		Expect(resultsv3.SplitControlPath("Identity > Access / Keys")).To(Equal([]string{"Identity", "Access / Keys"}))
+		Expect(resultsv3.SplitControlPath("Logging")).To(Equal([]string{"Logging"}))
+		Expect(resultsv3.SplitControlPath(" ")).To(BeEmpty())
+	})
+	It(`Builds the hierarchy and rolls up the stats`, func() {
+		tree := resultsv3.NewControlTree(controls, nil)
+		Expect(tree.Children).To(HaveLen(2))
+		Expect(tree.ControlCount).To(Equal(int64(4)))
+		Expect(*tree.Stats.Status).To(Equal(resultsv3.ControlWithStats_Status_NotCompliant))
+
+		ac := tree.Find("AC")
+		Expect(ac.Children).To(HaveLen(2))
+		Expect(*ac.Stats.TotalCount).To(Equal(int64(12)))
+		Expect(*ac.Stats.CompliantCount).To(Equal(int64(10)))
+		Expect(*ac.Stats.NotCompliantCount).To(Equal(int64(2)))
+
+		ac2 := tree.Find("AC", "AC-2")
+		Expect(ac2.Path).To(Equal([]string{"AC", "AC-2"}))
+		Expect(ac2.Controls).To(HaveLen(1))
+		Expect(ac2.ControlCount).To(Equal(int64(2)))
+		Expect(*ac2.Stats.Status).To(Equal(resultsv3.ControlWithStats_Status_NotCompliant))
+
+		Expect(*tree.Find("SC").Stats.Status).To(Equal(resultsv3.ControlWithStats_Status_UserEvaluationRequired))
+		Expect(tree.Find("AC", "AC-4")).To(BeNil())
+	})
+	It(`Walks, filters and renders the tree`, func() {
+		tree := resultsv3.NewControlTree(controls, nil)
+		var visited []string
+		err := tree.Walk(func(node *resultsv3.ControlTreeNode, depth int) error {
+			visited = append(visited, fmt.Sprintf("%d:%s", depth, node.Name))
+			if node.Name == "AC-2" {
+				return resultsv3.SkipControlTreeNode
+			}
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(visited).To(Equal([]string{"0:", "1:AC", "2:AC-2", "2:AC-3", "1:SC", "2:SC-7", "3:SC-7(3)"}))
+
+		failing := tree.Filter(func(control *resultsv3.ControlWithStats) bool {
+			return control.GetStatus() == resultsv3.ControlWithStats_Status_NotCompliant
+		})
+		Expect(failing.Children).To(HaveLen(1))
+		Expect(failing.ControlCount).To(Equal(int64(1)))
+		Expect(*failing.Stats.TotalCount).To(Equal(int64(3)))
+		Expect(tree.ControlCount).To(Equal(int64(4)))
+
+		var buf bytes.Buffer
+		Expect(failing.Render(&buf)).To(Succeed())
+		Expect(buf.String()).To(Equal("AC  not_compliant  1/3 compliant\n" +
+			"  AC-2  not_compliant  1/3 compliant\n" +
+			"    AC-2(a)  not_compliant  1/3 compliant\n" +
+			"      - control 2  not_compliant  1/3 compliant\n"))
+	})
+	It(`Gets the control tree of a report`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/reports/r1/controls"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"report_id": "r1", "controls": [{"id": "1", "control_path": "AC-2(a)", "status": "compliant", "total_count": 2, "compliant_count": 2}]}`)
+		}))
+		defer testServer.Close()
+		resultsService, _ := resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		tree, err := resultsService.GetReportControlTree(resultsService.NewGetReportControlsOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(*tree.Find("AC", "AC-2", "AC-2(a)").Controls[0].ID).To(Equal("1"))
+		Expect(*tree.Stats.CompliantCount).To(Equal(int64(2)))
+	})
+	It(`Gets an empty control tree for an empty response`, func() {
+		testServer := fixtureserver.New(map[string]string{"GET /reports/r1/controls": ""})
+		defer testServer.Close()
+		resultsService, _ := resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		tree, err := resultsService.GetReportControlTree(resultsService.NewGetReportControlsOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(tree.Children).To(BeEmpty())
+		Expect(tree.ControlCount).To(Equal(int64(0)))
+	})
+})
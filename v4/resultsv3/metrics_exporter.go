@@ -0,0 +1,335 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/common"
+)
+
+// Defaults of ComplianceExporterOptions.
+const (
+	// DefaultComplianceExporterRefreshInterval is the default time between two refreshes of a ComplianceExporter.
+	DefaultComplianceExporterRefreshInterval = 5 * time.Minute
+
+	// DefaultComplianceExporterRefreshTimeout is the default time that a refresh started by a scrape may take.
+	DefaultComplianceExporterRefreshTimeout = time.Minute
+)
+
+// ComplianceExporterOptions : The options of a ComplianceExporter.
+type ComplianceExporterOptions struct {
+	// The time between two refreshes. A scrape that comes sooner is served from the cache. Defaults to
+	// DefaultComplianceExporterRefreshInterval.
+	RefreshInterval time.Duration
+
+	// The time that a refresh started by a scrape may take. The refresh does not end with the scrape that started it,
+	// because concurrent scrapes wait for the same refresh. Defaults to DefaultComplianceExporterRefreshTimeout.
+	RefreshTimeout time.Duration
+
+	// The prefix of the metric names. Defaults to "scc".
+	Namespace string
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// ComplianceExporter : An http.Handler that exposes the latest compliance posture in the Prometheus text format.
+//
+// For every latest report, labelled with profile_id, profile_name, scope_id, attachment_id and report_id, it exposes the
+// gauges
+//
+//	<namespace>_compliance_score_percent
+//	<namespace>_controls{status="..."}
+//	<namespace>_evaluations{status="..."}
+//	<namespace>_resources{status="..."}
+//	<namespace>_report_scan_timestamp_seconds
+//
+// and for itself <namespace>_exporter_up, <namespace>_exporter_last_refresh_timestamp_seconds and the counter
+// <namespace>_exporter_refresh_errors_total. A report does not change once it is created, so the summary and controls
+// of a report are only fetched when it becomes the latest report. When a refresh fails the previous posture is served.
+type ComplianceExporter struct {
+	service *ResultsV3
+	options ComplianceExporterOptions
+
+	refreshMutex sync.Mutex
+	lastAttempt  time.Time
+
+	mutex         sync.Mutex
+	reports       map[string]*exportedReport
+	up            bool
+	lastRefresh   time.Time
+	refreshErrors int64
+}
+
+// exportedReport : The metrics of a latest report.
+type exportedReport struct {
+	labels      string
+	scanTime    time.Time
+	hasScanTime bool
+	summary     *ReportSummary
+	controls    map[string]int64
+}
+
+// NewComplianceExporter : Instantiate ComplianceExporter
+func (results *ResultsV3) NewComplianceExporter(options *ComplianceExporterOptions) *ComplianceExporter {
+	exporter := &ComplianceExporter{
+		service: results,
+		reports: make(map[string]*exportedReport),
+	}
+	if options != nil {
+		exporter.options = *options
+	}
+	if exporter.options.RefreshInterval <= 0 {
+		exporter.options.RefreshInterval = DefaultComplianceExporterRefreshInterval
+	}
+	if exporter.options.RefreshTimeout <= 0 {
+		exporter.options.RefreshTimeout = DefaultComplianceExporterRefreshTimeout
+	}
+	if exporter.options.Namespace == "" {
+		exporter.options.Namespace = "scc"
+	}
+	return exporter
+}
+
+// Run refreshes the posture every refresh interval until the context is done. Refresh errors are counted and do not
+// stop the exporter.
+func (exporter *ComplianceExporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(exporter.options.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		_ = exporter.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh gets the latest reports and the summary and controls of every report that was not the latest report at the
+// previous refresh.
+func (exporter *ComplianceExporter) Refresh(ctx context.Context) error {
+	exporter.refreshMutex.Lock()
+	defer exporter.refreshMutex.Unlock()
+	return exporter.refresh(ctx)
+}
+
+// refreshIfStale refreshes the posture when the last attempt is older than the refresh interval. Concurrent scrapes
+// wait for a single refresh, so the refresh runs on its own context, bounded by the refresh timeout, rather than on the
+// context of the scrape that started it.
+func (exporter *ComplianceExporter) refreshIfStale() {
+	exporter.refreshMutex.Lock()
+	defer exporter.refreshMutex.Unlock()
+	if time.Since(exporter.lastAttempt) >= exporter.options.RefreshInterval {
+		ctx, cancel := context.WithTimeout(context.Background(), exporter.options.RefreshTimeout)
+		defer cancel()
+		_ = exporter.refresh(ctx)
+	}
+}
+
+// refresh must be called with refreshMutex held.
+func (exporter *ComplianceExporter) refresh(ctx context.Context) error {
+	exporter.lastAttempt = time.Now()
+	reports, err := exporter.fetch(ctx)
+
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	exporter.up = err == nil
+	if err != nil {
+		exporter.refreshErrors++
+		return err
+	}
+	exporter.reports = reports
+	exporter.lastRefresh = exporter.lastAttempt
+	return nil
+}
+
+// fetch returns the metrics of the latest reports by report ID.
+func (exporter *ComplianceExporter) fetch(ctx context.Context) (reports map[string]*exportedReport, err error) {
+	latest, _, err := exporter.service.GetLatestReportsWithContext(ctx, &GetLatestReportsOptions{
+		XCorrelationID: exporter.options.XCorrelationID,
+		Headers:        exporter.options.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	exporter.mutex.Lock()
+	previous := exporter.reports
+	exporter.mutex.Unlock()
+
+	latestReports := latest.GetReports()
+	reports = make(map[string]*exportedReport, len(latestReports))
+	for i := range latestReports {
+		report := &latestReports[i]
+		reportID := report.GetID()
+		if reportID == "" {
+			continue
+		}
+		if cached, ok := previous[reportID]; ok {
+			reports[reportID] = cached
+			continue
+		}
+
+		exported := &exportedReport{
+			labels: common.FormatMetricLabels(
+				"profile_id", report.GetProfile().GetID(),
+				"profile_name", report.GetProfile().GetName(),
+				"scope_id", report.GetScope().GetID(),
+				"attachment_id", report.GetAttachment().GetID(),
+				"report_id", reportID,
+			),
+			controls: make(map[string]int64),
+		}
+		exported.scanTime, exported.hasScanTime = reportTime(report)
+
+		exported.summary, _, err = exporter.service.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+			ReportID:       core.StringPtr(reportID),
+			XCorrelationID: exporter.options.XCorrelationID,
+			Headers:        exporter.options.Headers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var controls *GetReportControlsResponse
+		controls, _, err = exporter.service.GetReportControlsWithContext(ctx, &GetReportControlsOptions{
+			ReportID:       core.StringPtr(reportID),
+			XCorrelationID: exporter.options.XCorrelationID,
+			Headers:        exporter.options.Headers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, control := range controls.GetControls() {
+			exported.controls[control.GetStatus()]++
+		}
+		reports[reportID] = exported
+	}
+	return
+}
+
+// ServeHTTP writes the metrics, refreshing them first when they are older than the refresh interval.
+func (exporter *ComplianceExporter) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	exporter.refreshIfStale()
+
+	res.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	res.WriteHeader(http.StatusOK)
+	_ = exporter.WriteMetrics(res)
+}
+
+// WriteMetrics writes the cached metrics in the Prometheus text format without refreshing them.
+func (exporter *ComplianceExporter) WriteMetrics(w io.Writer) error {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	buf := &bytes.Buffer{}
+
+	reports := make([]*exportedReport, 0, len(exporter.reports))
+	for _, report := range exporter.reports {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].labels < reports[j].labels
+	})
+
+	namespace := exporter.options.Namespace
+	family := func(name string, metricType string, help string) string {
+		name = namespace + "_" + name
+		common.WriteMetricFamily(buf, name, metricType, help)
+		return name
+	}
+	sample := func(name string, labels string, value int64) {
+		common.WriteMetricSample(buf, name, labels, value)
+	}
+	withStatus := func(labels string, status string) string {
+		return labels + "," + common.FormatMetricLabels("status", status)
+	}
+	statuses := []string{
+		ControlWithStats_Status_Compliant,
+		ControlWithStats_Status_NotCompliant,
+		ControlWithStats_Status_UnableToPerform,
+		ControlWithStats_Status_UserEvaluationRequired,
+	}
+
+	if len(reports) > 0 {
+		name := family("compliance_score_percent", "gauge", "The compliance score of the latest report, in percent.")
+		for _, report := range reports {
+			sample(name, report.labels, report.summary.GetScore().GetPercent())
+		}
+
+		name = family("controls", "gauge", "The number of controls of the latest report by status.")
+		for _, report := range reports {
+			for _, status := range statuses {
+				sample(name, withStatus(report.labels, status), report.controls[status])
+			}
+		}
+
+		name = family("evaluations", "gauge", "The number of evaluations of the latest report by status.")
+		for _, report := range reports {
+			evaluations := report.summary.GetEvaluations()
+			sample(name, withStatus(report.labels, Evaluation_Status_Pass), evaluations.GetPassCount())
+			sample(name, withStatus(report.labels, Evaluation_Status_Failure), evaluations.GetFailureCount())
+			sample(name, withStatus(report.labels, Evaluation_Status_Error), evaluations.GetErrorCount())
+		}
+
+		name = family("resources", "gauge", "The number of resources of the latest report by status.")
+		for _, report := range reports {
+			resources := report.summary.GetResources()
+			counts := []int64{
+				resources.GetCompliantCount(),
+				resources.GetNotCompliantCount(),
+				resources.GetUnableToPerformCount(),
+				resources.GetUserEvaluationRequiredCount(),
+			}
+			for i, status := range statuses {
+				sample(name, withStatus(report.labels, status), counts[i])
+			}
+		}
+
+		name = family("report_scan_timestamp_seconds", "gauge", "The time of the scan of the latest report.")
+		for _, report := range reports {
+			if report.hasScanTime {
+				sample(name, report.labels, report.scanTime.Unix())
+			}
+		}
+	}
+
+	up := int64(0)
+	if exporter.up {
+		up = 1
+	}
+	sample(family("exporter_up", "gauge", "Whether the last refresh succeeded."), "", up)
+	if !exporter.lastRefresh.IsZero() {
+		sample(family("exporter_last_refresh_timestamp_seconds", "gauge", "The time of the last successful refresh."), "",
+			exporter.lastRefresh.Unix())
+	}
+	sample(family("exporter_refresh_errors_total", "counter", "The number of failed refreshes."), "", exporter.refreshErrors)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
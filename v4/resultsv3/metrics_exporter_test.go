@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 compliance exporter`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+
+	const labels = `profile_id="p1",profile_name="FS \"Cloud\"",scope_id="s1",attachment_id="a1",report_id="r1"`
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports/latest": `{"reports": [{"id": "r1", "scan_time": "2023-05-01T08:00:00Z",
+				"profile": {"id": "p1", "name": "FS \"Cloud\""}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}}]}`,
+			"GET /reports/r1/summary": `{"report_id": "r1", "score": {"percent": 75},
+				"evaluations": {"pass_count": 30, "failure_count": 9, "error_count": 1},
+				"resources": {"compliant_count": 5, "not_compliant_count": 2}}`,
+			"GET /reports/r1/controls": `{"controls": [{"id": "c1", "status": "compliant"}, {"id": "c2", "status": "compliant"}, {"id": "c3", "status": "not_compliant"}]}`,
+			"GET /reports/r2/summary":  `{"report_id": "r2", "score": {"percent": 80}}`,
+			"GET /reports/r2/controls": `{"controls": []}`,
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	scrape := func(handler http.Handler) string {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		Expect(recorder.Code).To(Equal(200))
+		Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+		body, _ := io.ReadAll(recorder.Body)
+		return string(body)
+	}
+
+	It(`Exposes the latest posture and serves scrapes from the cache`, func() {
+		exporter := resultsService.NewComplianceExporter(&resultsv3.ComplianceExporterOptions{RefreshInterval: time.Hour})
+		metrics := scrape(exporter)
+		Expect(metrics).To(ContainSubstring("# TYPE scc_compliance_score_percent gauge\nscc_compliance_score_percent{" + labels + "} 75\n"))
+		Expect(metrics).To(ContainSubstring("scc_controls{" + labels + `,status="compliant"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_controls{" + labels + `,status="not_compliant"} 1` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_controls{" + labels + `,status="unable_to_perform"} 0` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_evaluations{" + labels + `,status="failure"} 9` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_resources{" + labels + `,status="not_compliant"} 2` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_report_scan_timestamp_seconds{" + labels + "} 1682928000\n"))
+		Expect(metrics).To(ContainSubstring("scc_exporter_up 1\n"))
+		Expect(metrics).To(ContainSubstring("scc_exporter_refresh_errors_total 0\n"))
+
+		scrape(exporter)
+		Expect(len(testServer.RequestsTo("GET /reports/latest"))).To(Equal(1))
+
+		testServer.SetResponse("GET /reports/latest", `{"reports": [
+			{"id": "r1", "profile": {"id": "p1"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+			{"id": "r2", "profile": {"id": "p2"}, "scope": {"id": "s1"}, "attachment": {"id": "a2"}}
+		]}`)
+		Expect(exporter.Refresh(context.Background())).To(Succeed())
+		metrics = scrape(exporter)
+		Expect(metrics).To(ContainSubstring(`scc_compliance_score_percent{profile_id="p2",profile_name="",scope_id="s1",attachment_id="a2",report_id="r2"} 80` + "\n"))
+		Expect(len(testServer.RequestsTo("GET /reports/latest"))).To(Equal(2))
+		Expect(len(testServer.RequestsTo("GET /reports/r1/summary"))).To(Equal(1))
+		Expect(len(testServer.RequestsTo("GET /reports/r1/controls"))).To(Equal(1))
+	})
+	It(`Serves scrapes when the API answers with empty bodies`, func() {
+		testServer.SetResponse("GET /reports/r1/summary", "")
+		testServer.SetResponse("GET /reports/r1/controls", "")
+		exporter := resultsService.NewComplianceExporter(nil)
+		metrics := scrape(exporter)
+		Expect(metrics).To(ContainSubstring("scc_compliance_score_percent{" + labels + "} 0\n"))
+		Expect(metrics).To(ContainSubstring("scc_controls{" + labels + `,status="compliant"} 0` + "\n"))
+		Expect(metrics).To(ContainSubstring("scc_exporter_up 1\n"))
+
+		testServer.SetResponse("GET /reports/latest", "")
+		Expect(exporter.Refresh(context.Background())).To(Succeed())
+		metrics = scrape(exporter)
+		Expect(metrics).ToNot(ContainSubstring("scc_compliance_score_percent{"))
+		Expect(metrics).To(ContainSubstring("scc_exporter_up 1\n"))
+	})
+	It(`Keeps serving the last posture when a refresh fails`, func() {
+		exporter := resultsService.NewComplianceExporter(&resultsv3.ComplianceExporterOptions{Namespace: "compliance"})
+		Expect(exporter.Refresh(context.Background())).To(Succeed())
+
+		testServer.DeleteResponse("GET /reports/latest")
+		Expect(exporter.Refresh(context.Background())).ToNot(Succeed())
+		metrics := scrape(exporter)
+		Expect(metrics).To(ContainSubstring("compliance_compliance_score_percent{" + labels + "} 75\n"))
+		Expect(metrics).To(ContainSubstring("compliance_exporter_up 0\n"))
+		Expect(metrics).To(ContainSubstring("compliance_exporter_refresh_errors_total 1\n"))
+		Expect(metrics).To(ContainSubstring("compliance_exporter_last_refresh_timestamp_seconds "))
+
+		exporter = resultsService.NewComplianceExporter(nil)
+		metrics = scrape(exporter)
+		Expect(metrics).ToNot(ContainSubstring("scc_compliance_score_percent"))
+		Expect(metrics).To(ContainSubstring("scc_exporter_up 0\n"))
+	})
+	It(`Refreshes when the scrape that started the refresh is cancelled`, func() {
+		exporter := resultsService.NewComplianceExporter(nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		recorder := httptest.NewRecorder()
+		exporter.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil).WithContext(ctx))
+		body, _ := io.ReadAll(recorder.Body)
+		Expect(string(body)).To(ContainSubstring("scc_compliance_score_percent{" + labels + "} 75\n"))
+		Expect(string(body)).To(ContainSubstring("scc_exporter_up 1\n"))
+	})
+	It(`Keeps the series of latest reports with the same labels apart`, func() {
+		testServer.SetResponse("GET /reports/latest", `{"reports": [
+			{"id": "r1", "profile": {"id": "p1"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+			{"id": "r2", "profile": {"id": "p1"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}}
+		]}`)
+		metrics := scrape(resultsService.NewComplianceExporter(nil))
+		Expect(metrics).To(ContainSubstring(`scc_compliance_score_percent{profile_id="p1",profile_name="",scope_id="s1",attachment_id="a1",report_id="r1"} 75` + "\n"))
+		Expect(metrics).To(ContainSubstring(`scc_compliance_score_percent{profile_id="p1",profile_name="",scope_id="s1",attachment_id="a1",report_id="r2"} 80` + "\n"))
+	})
+	It(`Refreshes in the background until the context is done`, func() {
+		exporter := resultsService.NewComplianceExporter(&resultsv3.ComplianceExporterOptions{RefreshInterval: 10 * time.Millisecond})
+		ctx, cancel := context.WithTimeout(context.Background(), 55*time.Millisecond)
+		defer cancel()
+		Expect(exporter.Run(ctx)).To(Equal(context.DeadlineExceeded))
+		Expect(len(testServer.RequestsTo("GET /reports/latest"))).To(BeNumerically(">=", 3))
+		Expect(len(testServer.RequestsTo("GET /reports/r1/summary"))).To(Equal(1))
+	})
+})
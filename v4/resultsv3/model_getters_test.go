@@ -0,0 +1,54 @@
+/**
+ * (C) Copyright IBM Corp. 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 model getters`, func() {
+	It(`Return zero values for unset fields and nil models`, func() {
+		var report *resultsv3.Report
+		Expect(report.GetID()).To(Equal(""))
+		Expect(report.GetAccount()).To(BeNil())
+		Expect(report.GetAccount().GetID()).To(Equal(""))
+
+		report = &resultsv3.Report{
+			ID:      core.StringPtr("report-1"),
+			Account: &resultsv3.Account{ID: core.StringPtr("acc-1")},
+		}
+		Expect(report.GetID()).To(Equal("report-1"))
+		Expect(report.GetAccount().GetID()).To(Equal("acc-1"))
+		Expect(report.GetAccount().GetName()).To(Equal(""))
+	})
+	It(`Keep the getters of declared fields apart from the additional properties`, func() {
+		var details *resultsv3.EvalDetails
+		Expect(details.GetProperties()).To(BeNil())
+
+		details = &resultsv3.EvalDetails{
+			Properties: []resultsv3.Property{{Property: core.StringPtr("encryption"), Operator: core.StringPtr("is_true")}},
+		}
+		details.SetAdditionalProperty("extra", "value")
+		Expect(details.GetProperties()).To(HaveLen(1))
+		Expect(details.GetProperties()[0].GetProperty()).To(Equal("encryption"))
+		Expect(details.GetAdditionalProperties()).To(Equal(map[string]interface{}{"extra": "value"}))
+		Expect(details.GetAdditionalProperty("extra")).To(Equal("value"))
+	})
+})
@@ -0,0 +1,461 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v2"
+)
+
+// Exit codes of a gate, as returned by GateExitCode.
+const (
+	GateExitCodePass  = 0
+	GateExitCodeFail  = 1
+	GateExitCodeError = 2
+)
+
+// GatePolicy : The conditions that the latest report of an attachment must meet for a pipeline to continue. A policy
+// is normally written in YAML and read with ParseGatePolicy, for example:
+//
+//	min_score_percent: 80
+//	critical_controls:
+//	  - AC-2
+//	  - SC-7
+//	no_new_failing_resources: true
+//	max_unable_to_perform: 3
+type GatePolicy struct {
+	// The lowest compliance score percent that passes. Not checked when nil.
+	MinScorePercent *int64 `yaml:"min_score_percent,omitempty" json:"min_score_percent,omitempty"`
+
+	// Controls that must not have any not compliant checks, by control ID or control path. A critical control that is
+	// not in the report fails the gate.
+	CriticalControls []string `yaml:"critical_controls,omitempty" json:"critical_controls,omitempty"`
+
+	// When true, resources that are not compliant in the report but were not in the previous report of the attachment,
+	// or were not failing in it, fail the gate. Passes when there is no previous report.
+	NoNewFailingResources bool `yaml:"no_new_failing_resources,omitempty" json:"no_new_failing_resources,omitempty"`
+
+	// The most controls that may be unable to perform. Not checked when nil, so unable to perform controls are
+	// tolerated.
+	MaxUnableToPerform *int64 `yaml:"max_unable_to_perform,omitempty" json:"max_unable_to_perform,omitempty"`
+}
+
+// ParseGatePolicy reads a GatePolicy from YAML, or JSON, which is a subset of YAML. Unknown keys are rejected so that a
+// misspelt condition is not silently ignored.
+func ParseGatePolicy(data []byte) (policy *GatePolicy, err error) {
+	policy = &GatePolicy{}
+	if err = yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("error parsing the gate policy: %s", err.Error())
+	}
+	if err = policy.Validate(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Validate checks that the values of the policy are in range.
+func (policy *GatePolicy) Validate() error {
+	if policy.MinScorePercent != nil && (*policy.MinScorePercent < 0 || *policy.MinScorePercent > 100) {
+		return fmt.Errorf("min_score_percent must be between 0 and 100, not %d", *policy.MinScorePercent)
+	}
+	if policy.MaxUnableToPerform != nil && *policy.MaxUnableToPerform < 0 {
+		return fmt.Errorf("max_unable_to_perform must not be negative, not %d", *policy.MaxUnableToPerform)
+	}
+	return nil
+}
+
+// GateCheck : The name of a condition of a GatePolicy.
+type GateCheck string
+
+// Constants associated with GateCheck.
+const (
+	GateCheck_MinScorePercent       GateCheck = "min_score_percent"
+	GateCheck_CriticalControls      GateCheck = "critical_controls"
+	GateCheck_NoNewFailingResources GateCheck = "no_new_failing_resources"
+	GateCheck_MaxUnableToPerform    GateCheck = "max_unable_to_perform"
+)
+
+// GateCheckResult : The outcome of one condition of a gate.
+type GateCheckResult struct {
+	Check   GateCheck `json:"check"`
+	Passed  bool      `json:"passed"`
+	Message string    `json:"message"`
+}
+
+// GateVerdict : The outcome of a gate.
+type GateVerdict struct {
+	// True when every check passed.
+	Passed bool `json:"passed"`
+
+	// The report that was evaluated.
+	ReportID string `json:"report_id"`
+
+	// The report that the evaluated report was compared to. Empty when there is none or no_new_failing_resources is
+	// not set.
+	PreviousReportID string `json:"previous_report_id,omitempty"`
+
+	// The outcome of every condition of the policy, in the order of the GatePolicy fields.
+	Checks []GateCheckResult `json:"checks"`
+}
+
+// Failures returns the checks that did not pass.
+func (verdict *GateVerdict) Failures() (failures []GateCheckResult) {
+	for _, check := range verdict.Checks {
+		if !check.Passed {
+			failures = append(failures, check)
+		}
+	}
+	return
+}
+
+// GateExitCode maps the outcome of EvaluateGate to a process exit code: GateExitCodeError when err is not nil,
+// GateExitCodeFail when the verdict did not pass and GateExitCodePass otherwise.
+func GateExitCode(verdict *GateVerdict, err error) int {
+	if err != nil || verdict == nil {
+		return GateExitCodeError
+	}
+	if !verdict.Passed {
+		return GateExitCodeFail
+	}
+	return GateExitCodePass
+}
+
+// EvaluateGateOptions : The EvaluateGate options.
+type EvaluateGateOptions struct {
+	// The ID of the attachment whose latest report is evaluated.
+	AttachmentID *string `validate:"required,ne="`
+
+	// The policy to evaluate.
+	Policy *GatePolicy `validate:"required"`
+
+	// The report to evaluate instead of the latest report of the attachment.
+	ReportID *string
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewEvaluateGateOptions : Instantiate EvaluateGateOptions
+func (*ResultsV3) NewEvaluateGateOptions(attachmentID string, policy *GatePolicy) *EvaluateGateOptions {
+	return &EvaluateGateOptions{
+		AttachmentID: core.StringPtr(attachmentID),
+		Policy:       policy,
+	}
+}
+
+// SetAttachmentID : Allow user to set AttachmentID
+func (_options *EvaluateGateOptions) SetAttachmentID(attachmentID string) *EvaluateGateOptions {
+	_options.AttachmentID = core.StringPtr(attachmentID)
+	return _options
+}
+
+// SetPolicy : Allow user to set Policy
+func (_options *EvaluateGateOptions) SetPolicy(policy *GatePolicy) *EvaluateGateOptions {
+	_options.Policy = policy
+	return _options
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *EvaluateGateOptions) SetReportID(reportID string) *EvaluateGateOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *EvaluateGateOptions) SetXCorrelationID(xCorrelationID string) *EvaluateGateOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *EvaluateGateOptions) SetHeaders(param map[string]string) *EvaluateGateOptions {
+	options.Headers = param
+	return options
+}
+
+// EvaluateGate : Evaluate a gate policy
+// Find the latest report of the attachment, or the given report, and check it against every condition of the policy.
+// Only the data that the policy needs is fetched. A verdict that did not pass is not an error; use GateExitCode to turn
+// the outcome into an exit code.
+func (results *ResultsV3) EvaluateGate(evaluateGateOptions *EvaluateGateOptions) (result *GateVerdict, err error) {
+	return results.EvaluateGateWithContext(context.Background(), evaluateGateOptions)
+}
+
+// EvaluateGateWithContext is an alternate form of the EvaluateGate method which supports a Context parameter
+func (results *ResultsV3) EvaluateGateWithContext(ctx context.Context, evaluateGateOptions *EvaluateGateOptions) (result *GateVerdict, err error) {
+	err = core.ValidateNotNil(evaluateGateOptions, "evaluateGateOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(evaluateGateOptions, "evaluateGateOptions")
+	if err != nil {
+		return
+	}
+	options := evaluateGateOptions
+	policy := options.Policy
+	if err = policy.Validate(); err != nil {
+		return
+	}
+
+	reportID, previousReportID, err := results.gateReports(ctx, options)
+	if err != nil {
+		return
+	}
+	verdict := &GateVerdict{
+		Passed:   true,
+		ReportID: reportID,
+	}
+	check := func(name GateCheck, passed bool, format string, a ...interface{}) {
+		verdict.Checks = append(verdict.Checks, GateCheckResult{
+			Check:   name,
+			Passed:  passed,
+			Message: fmt.Sprintf(format, a...),
+		})
+		verdict.Passed = verdict.Passed && passed
+	}
+
+	var summary *ReportSummary
+	if policy.MinScorePercent != nil || policy.MaxUnableToPerform != nil {
+		summary, _, err = results.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+			ReportID:       core.StringPtr(reportID),
+			XCorrelationID: options.XCorrelationID,
+			Headers:        options.Headers,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	if policy.MinScorePercent != nil {
+		percent := summary.GetScore().GetPercent()
+		check(GateCheck_MinScorePercent, percent >= *policy.MinScorePercent,
+			"the compliance score is %d%%, the minimum is %d%%", percent, *policy.MinScorePercent)
+	}
+
+	if len(policy.CriticalControls) > 0 {
+		var controls *GetReportControlsResponse
+		controls, _, err = results.GetReportControlsWithContext(ctx, &GetReportControlsOptions{
+			ReportID:       core.StringPtr(reportID),
+			XCorrelationID: options.XCorrelationID,
+			Headers:        options.Headers,
+		})
+		if err != nil {
+			return
+		}
+		var problems []string
+		for _, critical := range policy.CriticalControls {
+			control := findControl(controls.GetControls(), critical)
+			switch {
+			case control == nil:
+				problems = append(problems, fmt.Sprintf("%s is not in the report", critical))
+			case control.GetNotCompliantCount() > 0 || control.GetStatus() == ControlWithStats_Status_NotCompliant:
+				problems = append(problems, fmt.Sprintf("%s has %d not compliant checks", critical, control.GetNotCompliantCount()))
+			}
+		}
+		if len(problems) == 0 {
+			check(GateCheck_CriticalControls, true, "none of the %d critical controls fail", len(policy.CriticalControls))
+		} else {
+			check(GateCheck_CriticalControls, false, "critical controls fail: %s", strings.Join(problems, "; "))
+		}
+	}
+
+	if policy.NoNewFailingResources {
+		if previousReportID == "" {
+			check(GateCheck_NoNewFailingResources, true, "there is no previous report to compare to")
+		} else {
+			verdict.PreviousReportID = previousReportID
+			var current, previous map[string]string
+			current, err = results.failingResources(ctx, options, reportID)
+			if err != nil {
+				return
+			}
+			previous, err = results.failingResources(ctx, options, previousReportID)
+			if err != nil {
+				return
+			}
+			var added []string
+			for id, name := range current {
+				if _, ok := previous[id]; !ok {
+					added = append(added, name)
+				}
+			}
+			sort.Strings(added)
+			if len(added) == 0 {
+				check(GateCheck_NoNewFailingResources, true, "no resources started failing since report %s", previousReportID)
+			} else {
+				check(GateCheck_NoNewFailingResources, false, "%d resources started failing since report %s: %s",
+					len(added), previousReportID, strings.Join(added, ", "))
+			}
+		}
+	}
+
+	if policy.MaxUnableToPerform != nil {
+		count := summary.GetControls().GetUnableToPerformCount()
+		check(GateCheck_MaxUnableToPerform, count <= *policy.MaxUnableToPerform,
+			"%d controls are unable to perform, the maximum is %d", count, *policy.MaxUnableToPerform)
+	}
+
+	result = verdict
+	return
+}
+
+// gateReports returns the report to evaluate and, when the policy compares it with the report before it, the ID of
+// that report. The reports of the attachment are only listed for the comparison; they are scanned page by page and
+// only the reports that can still be the answer are kept. A given report without a scan time cannot be compared, so
+// it is an error.
+func (results *ResultsV3) gateReports(ctx context.Context, options *EvaluateGateOptions) (reportID string, previousReportID string, err error) {
+	if !options.Policy.NoNewFailingResources {
+		if options.ReportID != nil {
+			return *options.ReportID, "", nil
+		}
+		reportID, err = results.latestAttachmentReport(ctx, options)
+		return
+	}
+
+	// Only reports scanned before this time can be the previous report. Zero while the current report is not known.
+	var before time.Time
+	if options.ReportID != nil {
+		var report *Report
+		report, _, err = results.GetReportWithContext(ctx, &GetReportOptions{
+			ReportID:       options.ReportID,
+			XCorrelationID: options.XCorrelationID,
+			Headers:        options.Headers,
+		})
+		if err != nil {
+			return
+		}
+		var ok bool
+		if before, ok = reportTime(report); !ok {
+			err = fmt.Errorf("report %s has no scan time to find the report before it", *options.ReportID)
+			return
+		}
+		reportID = *options.ReportID
+	}
+
+	pager, err := results.NewReportsPager(&ListReportsOptions{
+		AttachmentID:   options.AttachmentID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	var newest, previous time.Time
+	for pager.HasNext() {
+		var page []Report
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		for i := range page {
+			t, ok := reportTime(&page[i])
+			if !ok || page[i].ID == nil || *page[i].ID == reportID {
+				continue
+			}
+			switch {
+			case !before.IsZero():
+				if t.Before(before) && (previousReportID == "" || t.After(previous)) {
+					previousReportID, previous = *page[i].ID, t
+				}
+			case reportID == "" || t.After(newest):
+				previousReportID, previous = reportID, newest
+				reportID, newest = *page[i].ID, t
+			case previousReportID == "" || t.After(previous):
+				previousReportID, previous = *page[i].ID, t
+			}
+		}
+	}
+	if reportID == "" {
+		err = fmt.Errorf("attachment %s has no reports", *options.AttachmentID)
+	}
+	return
+}
+
+// latestAttachmentReport returns the ID of the newest of the latest reports of the attachment.
+func (results *ResultsV3) latestAttachmentReport(ctx context.Context, options *EvaluateGateOptions) (reportID string, err error) {
+	latest, _, err := results.GetLatestReportsWithContext(ctx, &GetLatestReportsOptions{
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	var newest time.Time
+	for _, report := range latest.GetReports() {
+		if report.GetAttachment().GetID() != *options.AttachmentID || report.ID == nil {
+			continue
+		}
+		t, _ := reportTime(&report)
+		if reportID == "" || t.After(newest) {
+			reportID, newest = *report.ID, t
+		}
+	}
+	if reportID == "" {
+		err = fmt.Errorf("attachment %s has no reports", *options.AttachmentID)
+	}
+	return
+}
+
+// failingResources returns the names of the not compliant resources of a report by resource ID.
+func (results *ResultsV3) failingResources(ctx context.Context, options *EvaluateGateOptions, reportID string) (failing map[string]string, err error) {
+	pager, err := results.NewReportResourcesPager(&ListReportResourcesOptions{
+		ReportID:       core.StringPtr(reportID),
+		Status:         core.StringPtr(Resource_Status_NotCompliant),
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	resources, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	failing = make(map[string]string, len(resources))
+	for i := range resources {
+		resource := &resources[i]
+		if resource.ID == nil || resource.GetStatus() != Resource_Status_NotCompliant {
+			continue
+		}
+		name := resource.GetResourceName()
+		if name == "" {
+			name = *resource.ID
+		}
+		failing[*resource.ID] = name
+	}
+	return
+}
+
+// findControl returns the control with the given ID or control path.
+func findControl(controls []ControlWithStats, idOrPath string) *ControlWithStats {
+	for i := range controls {
+		if controls[i].GetID() == idOrPath || controls[i].GetControlPath() == idOrPath {
+			return &controls[i]
+		}
+	}
+	return nil
+}
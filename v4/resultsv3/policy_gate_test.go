@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 policy gate`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports": `{"reports": [
+				{"id": "r1", "scan_time": "2023-05-01T08:00:00Z", "attachment": {"id": "a1"}},
+				{"id": "r3", "scan_time": "2023-05-03T08:00:00Z", "attachment": {"id": "a1"}},
+				{"id": "r2", "scan_time": "2023-05-02T08:00:00Z", "attachment": {"id": "a1"}}
+			]}`,
+			"GET /reports/r3/summary": `{"report_id": "r3", "score": {"percent": 78}, "controls": {"unable_to_perform_count": 2}}`,
+			"GET /reports/r3/controls": `{"controls": [
+				{"id": "c1", "control_path": "AC-2", "status": "not_compliant", "not_compliant_count": 3},
+				{"id": "c2", "control_path": "SC-7", "status": "compliant", "compliant_count": 4}
+			]}`,
+			"GET /reports/r3/resources": `{"resources": [
+				{"id": "crn:a", "resource_name": "bucket-a", "status": "not_compliant"},
+				{"id": "crn:b", "resource_name": "bucket-b", "status": "not_compliant"}
+			]}`,
+			"GET /reports/latest": `{"reports": [
+				{"id": "r3", "scan_time": "2023-05-03T08:00:00Z", "attachment": {"id": "a1"}},
+				{"id": "x1", "scan_time": "2023-05-04T08:00:00Z", "attachment": {"id": "a2"}}
+			]}`,
+			"GET /reports/r2":           `{"id": "r2", "scan_time": "2023-05-02T08:00:00Z", "attachment": {"id": "a1"}}`,
+			"GET /reports/r2/resources": `{"resources": [{"id": "crn:a", "resource_name": "bucket-a", "status": "not_compliant"}]}`,
+			"GET /reports/r2/summary":   `{"report_id": "r2", "score": {"percent": 90}, "controls": {"unable_to_perform_count": 0}}`,
+			"GET /reports/r1/resources": `{"resources": [{"id": "crn:a", "resource_name": "bucket-a", "status": "not_compliant"}]}`,
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Parses a YAML policy`, func() {
+		policy, err := resultsv3.ParseGatePolicy([]byte("min_score_percent: 80\ncritical_controls: [AC-2, SC-7]\nno_new_failing_resources: true\nmax_unable_to_perform: 3\n"))
+		Expect(err).To(BeNil())
+		Expect(*policy.MinScorePercent).To(Equal(int64(80)))
+		Expect(policy.CriticalControls).To(Equal([]string{"AC-2", "SC-7"}))
+		Expect(policy.NoNewFailingResources).To(BeTrue())
+		Expect(*policy.MaxUnableToPerform).To(Equal(int64(3)))
+
+		_, err = resultsv3.ParseGatePolicy([]byte("min_score: 80\n"))
+		Expect(err).ToNot(BeNil())
+		_, err = resultsv3.ParseGatePolicy([]byte("min_score_percent: 180\n"))
+		Expect(err).To(MatchError("min_score_percent must be between 0 and 100, not 180"))
+	})
+	It(`Fails the latest report with the reasons`, func() {
+		policy, _ := resultsv3.ParseGatePolicy([]byte("min_score_percent: 80\ncritical_controls: [AC-2, SC-7, c9]\nno_new_failing_resources: true\nmax_unable_to_perform: 3\n"))
+		verdict, err := resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", policy))
+		Expect(err).To(BeNil())
+		Expect(verdict.Passed).To(BeFalse())
+		Expect(verdict.ReportID).To(Equal("r3"))
+		Expect(verdict.PreviousReportID).To(Equal("r2"))
+		Expect(verdict.Checks).To(Equal([]resultsv3.GateCheckResult{
+			{Check: resultsv3.GateCheck_MinScorePercent, Passed: false, Message: "the compliance score is 78%, the minimum is 80%"},
+			{Check: resultsv3.GateCheck_CriticalControls, Passed: false, Message: "critical controls fail: AC-2 has 3 not compliant checks; c9 is not in the report"},
+			{Check: resultsv3.GateCheck_NoNewFailingResources, Passed: false, Message: "1 resources started failing since report r2: bucket-b"},
+			{Check: resultsv3.GateCheck_MaxUnableToPerform, Passed: true, Message: "2 controls are unable to perform, the maximum is 3"},
+		}))
+		Expect(verdict.Failures()).To(HaveLen(3))
+		for _, request := range testServer.RequestsTo("GET /reports") {
+			Expect(request.Query.Get("attachment_id")).To(Equal("a1"))
+		}
+		Expect(resultsv3.GateExitCode(verdict, err)).To(Equal(resultsv3.GateExitCodeFail))
+	})
+	It(`Passes a given report and fetches only what the policy needs`, func() {
+		policy := &resultsv3.GatePolicy{MinScorePercent: core.Int64Ptr(85), NoNewFailingResources: true}
+		verdict, err := resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", policy).SetReportID("r2"))
+		Expect(err).To(BeNil())
+		Expect(verdict.Passed).To(BeTrue())
+		Expect(verdict.PreviousReportID).To(Equal("r1"))
+		Expect(resultsv3.GateExitCode(verdict, err)).To(Equal(resultsv3.GateExitCodePass))
+		Expect(testServer.Requests()).ToNot(ContainElement("GET /reports/r2/controls"))
+		Expect(testServer.Requests()).ToNot(ContainElement("GET /reports/r3/summary"))
+	})
+	It(`Uses the latest reports when the policy does not compare reports`, func() {
+		policy := &resultsv3.GatePolicy{MinScorePercent: core.Int64Ptr(70)}
+		verdict, err := resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", policy))
+		Expect(err).To(BeNil())
+		Expect(verdict.Passed).To(BeTrue())
+		Expect(verdict.ReportID).To(Equal("r3"))
+		Expect(verdict.PreviousReportID).To(BeEmpty())
+		Expect(testServer.Requests()).To(Equal([]string{"GET /reports/latest", "GET /reports/r3/summary"}))
+
+		_, err = resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a3", policy))
+		Expect(err).To(MatchError("attachment a3 has no reports"))
+	})
+	It(`Fails critical controls when the controls response is empty`, func() {
+		testServer.SetResponse("GET /reports/r3/controls", "")
+		verdict, err := resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", &resultsv3.GatePolicy{CriticalControls: []string{"AC-2"}}))
+		Expect(err).To(BeNil())
+		Expect(verdict.Passed).To(BeFalse())
+		Expect(verdict.Checks).To(Equal([]resultsv3.GateCheckResult{
+			{Check: resultsv3.GateCheck_CriticalControls, Passed: false, Message: "critical controls fail: AC-2 is not in the report"},
+		}))
+	})
+	It(`Maps errors to the error exit code`, func() {
+		Expect(resultsv3.GateExitCode(nil, errors.New("unauthorized"))).To(Equal(resultsv3.GateExitCodeError))
+
+		_, err := resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", &resultsv3.GatePolicy{MinScorePercent: core.Int64Ptr(1)}).SetReportID("r9"))
+		Expect(err).ToNot(BeNil())
+
+		testServer.SetResponse("GET /reports/r2", `{"id": "r2", "attachment": {"id": "a1"}}`)
+		_, err = resultsService.EvaluateGate(resultsService.NewEvaluateGateOptions("a1", &resultsv3.GatePolicy{NoNewFailingResources: true}).SetReportID("r2"))
+		Expect(err).To(MatchError("report r2 has no scan time to find the report before it"))
+	})
+})
@@ -0,0 +1,378 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ComplianceReportData : Everything that a compliance report is rendered from.
+type ComplianceReportData struct {
+	// The report.
+	Report *Report
+
+	// The summary of the report, including the top failed resources.
+	Summary *ReportSummary
+
+	// The controls of the report.
+	Controls []ControlWithStats
+
+	// The compliance stats of the controls of the reports of the same group over time, as returned by
+	// GetReportViolationsDrift.
+	Drift []ReportViolationDataPoint
+}
+
+// DriftChartPoint : One scan of the violations drift, as plotted by the HTML report.
+type DriftChartPoint struct {
+	ScanTime          string
+	TotalCount        int64
+	CompliantCount    int64
+	NotCompliantCount int64
+}
+
+// DriftChart returns the drift data points with their counts dereferenced.
+func (data *ComplianceReportData) DriftChart() (points []DriftChartPoint) {
+	for i := range data.Drift {
+		dataPoint := &data.Drift[i]
+		points = append(points, DriftChartPoint{
+			ScanTime:          dataPoint.GetScanTime(),
+			TotalCount:        dataPoint.GetControls().GetTotalCount(),
+			CompliantCount:    dataPoint.GetControls().GetCompliantCount(),
+			NotCompliantCount: dataPoint.GetControls().GetNotCompliantCount(),
+		})
+	}
+	return
+}
+
+// GetComplianceReportDataOptions : The GetComplianceReportData options.
+type GetComplianceReportDataOptions struct {
+	// The ID of the scan associated to a report.
+	ReportID *string `validate:"required,ne="`
+
+	// The duration of the violations drift, in days.
+	ScanTimeDuration *int64
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewGetComplianceReportDataOptions : Instantiate GetComplianceReportDataOptions
+func (*ResultsV3) NewGetComplianceReportDataOptions(reportID string) *GetComplianceReportDataOptions {
+	return &GetComplianceReportDataOptions{
+		ReportID: core.StringPtr(reportID),
+	}
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *GetComplianceReportDataOptions) SetReportID(reportID string) *GetComplianceReportDataOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetScanTimeDuration : Allow user to set ScanTimeDuration
+func (_options *GetComplianceReportDataOptions) SetScanTimeDuration(scanTimeDuration int64) *GetComplianceReportDataOptions {
+	_options.ScanTimeDuration = core.Int64Ptr(scanTimeDuration)
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *GetComplianceReportDataOptions) SetXCorrelationID(xCorrelationID string) *GetComplianceReportDataOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetComplianceReportDataOptions) SetHeaders(param map[string]string) *GetComplianceReportDataOptions {
+	options.Headers = param
+	return options
+}
+
+// GetComplianceReportData : Get the data of a compliance report
+// Get the report, its summary, its controls and its violations drift, ready to be rendered with a
+// ComplianceReportRenderer.
+func (results *ResultsV3) GetComplianceReportData(getComplianceReportDataOptions *GetComplianceReportDataOptions) (result *ComplianceReportData, err error) {
+	return results.GetComplianceReportDataWithContext(context.Background(), getComplianceReportDataOptions)
+}
+
+// GetComplianceReportDataWithContext is an alternate form of the GetComplianceReportData method which supports a Context parameter
+func (results *ResultsV3) GetComplianceReportDataWithContext(ctx context.Context, getComplianceReportDataOptions *GetComplianceReportDataOptions) (result *ComplianceReportData, err error) {
+	err = core.ValidateNotNil(getComplianceReportDataOptions, "getComplianceReportDataOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getComplianceReportDataOptions, "getComplianceReportDataOptions")
+	if err != nil {
+		return
+	}
+	options := getComplianceReportDataOptions
+
+	data := &ComplianceReportData{}
+	data.Report, _, err = results.GetReportWithContext(ctx, &GetReportOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	data.Summary, _, err = results.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	controls, _, err := results.GetReportControlsWithContext(ctx, &GetReportControlsOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	data.Controls = controls.GetControls()
+	drift, _, err := results.GetReportViolationsDriftWithContext(ctx, &GetReportViolationsDriftOptions{
+		ReportID:         options.ReportID,
+		ScanTimeDuration: options.ScanTimeDuration,
+		XCorrelationID:   options.XCorrelationID,
+		Headers:          options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	data.Drift = drift.GetDataPoints()
+	result = data
+	return
+}
+
+// DefaultComplianceReportMarkdownTemplate is the Markdown template of a new ComplianceReportRenderer. It can be used as
+// a starting point for a custom template.
+const DefaultComplianceReportMarkdownTemplate = `# Compliance report: {{md .Report.GetProfile.GetName}} {{md .Report.GetProfile.GetVersion}}
+
+| | |
+|---|---|
+| Report | {{md .Report.GetID}} |
+| Scan time | {{md .Report.GetScanTime}} |
+| Scope | {{md .Report.GetScope.GetID}} ({{md .Report.GetScope.GetType}}) |
+| Account | {{md .Report.GetAccount.GetName}} ({{md .Report.GetAccount.GetID}}) |
+
+## Score
+
+**{{.Summary.GetScore.GetPercent}}%** of the evaluations passed ({{.Summary.GetScore.GetPassed}} of {{.Summary.GetScore.GetTotalCount}}).
+
+| | Total | Compliant | Not compliant | Unable to perform | User evaluation required |
+|---|---|---|---|---|---|
+{{with .Summary.GetControls}}| Controls | {{.GetTotalCount}} | {{.GetCompliantCount}} | {{.GetNotCompliantCount}} | {{.GetUnableToPerformCount}} | {{.GetUserEvaluationRequiredCount}} |
+{{end}}{{with .Summary.GetResources}}| Resources | {{.GetTotalCount}} | {{.GetCompliantCount}} | {{.GetNotCompliantCount}} | {{.GetUnableToPerformCount}} | {{.GetUserEvaluationRequiredCount}} |
+{{end}}
+## Controls
+
+{{if .Controls}}| Control | Category | Status | Compliant | Not compliant | Unable to perform |
+|---|---|---|---|---|---|
+{{range .Controls}}| {{md .GetControlPath}} {{md .GetControlName}} | {{md .GetControlCategory}} | {{md .GetStatus}} | {{.GetCompliantCount}} | {{.GetNotCompliantCount}} | {{.GetUnableToPerformCount}} |
+{{end}}{{else}}No controls.
+{{end}}
+## Top failed resources
+
+{{with .Summary.GetResources.GetTopFailed}}| Resource | Service | Account | Failed | Total |
+|---|---|---|---|---|
+{{range .}}| {{md .GetName}} | {{md .GetService}} | {{md .GetAccount}} | {{.GetFailureCount}} | {{.GetTotalCount}} |
+{{end}}{{else}}No failed resources.
+{{end}}
+## Violations drift
+
+{{with .DriftChart}}| Scan time | Not compliant controls | Compliant controls | Total controls |
+|---|---|---|---|
+{{range .}}| {{md .ScanTime}} | {{.NotCompliantCount}} | {{.CompliantCount}} | {{.TotalCount}} |
+{{end}}{{else}}No earlier scans.
+{{end}}`
+
+// DefaultComplianceReportHTMLTemplate is the HTML template of a new ComplianceReportRenderer. The page has no
+// external resources; the drift chart is an inline SVG drawn by the driftChart function.
+const DefaultComplianceReportHTMLTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Compliance report: {{.Report.GetProfile.GetName}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #161616; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #c6c6c6; padding: 0.3em 0.6em; text-align: left; }
+th { background: #f4f4f4; }
+.score { font-size: 2.5em; font-weight: bold; }
+.compliant { color: #198038; }
+.not_compliant { color: #da1e28; }
+.unable_to_perform, .user_evaluation_required { color: #8a3800; }
+</style>
+</head>
+<body>
+<h1>Compliance report: {{.Report.GetProfile.GetName}} {{.Report.GetProfile.GetVersion}}</h1>
+<table>
+<tr><th>Report</th><td>{{.Report.GetID}}</td></tr>
+<tr><th>Scan time</th><td>{{.Report.GetScanTime}}</td></tr>
+<tr><th>Scope</th><td>{{.Report.GetScope.GetID}} ({{.Report.GetScope.GetType}})</td></tr>
+<tr><th>Account</th><td>{{.Report.GetAccount.GetName}} ({{.Report.GetAccount.GetID}})</td></tr>
+</table>
+
+<h2>Score</h2>
+<p><span class="score">{{.Summary.GetScore.GetPercent}}%</span> of the evaluations passed ({{.Summary.GetScore.GetPassed}} of {{.Summary.GetScore.GetTotalCount}}).</p>
+<table>
+<tr><th></th><th>Total</th><th>Compliant</th><th>Not compliant</th><th>Unable to perform</th><th>User evaluation required</th></tr>
+{{with .Summary.GetControls}}<tr><th>Controls</th><td>{{.GetTotalCount}}</td><td>{{.GetCompliantCount}}</td><td>{{.GetNotCompliantCount}}</td><td>{{.GetUnableToPerformCount}}</td><td>{{.GetUserEvaluationRequiredCount}}</td></tr>
+{{end}}{{with .Summary.GetResources}}<tr><th>Resources</th><td>{{.GetTotalCount}}</td><td>{{.GetCompliantCount}}</td><td>{{.GetNotCompliantCount}}</td><td>{{.GetUnableToPerformCount}}</td><td>{{.GetUserEvaluationRequiredCount}}</td></tr>
+{{end}}</table>
+
+<h2>Controls</h2>
+{{if .Controls}}<table>
+<tr><th>Control</th><th>Category</th><th>Status</th><th>Compliant</th><th>Not compliant</th><th>Unable to perform</th></tr>
+{{range .Controls}}<tr><td>{{.GetControlPath}} {{.GetControlName}}</td><td>{{.GetControlCategory}}</td><td class="{{.GetStatus}}">{{.GetStatus}}</td><td>{{.GetCompliantCount}}</td><td>{{.GetNotCompliantCount}}</td><td>{{.GetUnableToPerformCount}}</td></tr>
+{{end}}</table>
+{{else}}<p>No controls.</p>
+{{end}}
+<h2>Top failed resources</h2>
+{{with .Summary.GetResources.GetTopFailed}}<table>
+<tr><th>Resource</th><th>Service</th><th>Account</th><th>Failed</th><th>Total</th></tr>
+{{range .}}<tr><td>{{.GetName}}</td><td>{{.GetService}}</td><td>{{.GetAccount}}</td><td>{{.GetFailureCount}}</td><td>{{.GetTotalCount}}</td></tr>
+{{end}}</table>
+{{else}}<p>No failed resources.</p>
+{{end}}
+<h2>Violations drift</h2>
+{{with .DriftChart}}{{driftChart .}}{{else}}<p>No earlier scans.</p>{{end}}
+</body>
+</html>
+`
+
+// ComplianceReportRenderer : Renders ComplianceReportData as Markdown or HTML with Go templates.
+// Besides the methods of the data, templates can use the md function, which escapes text for a Markdown table cell,
+// and the driftChart function, which draws the points of DriftChart as an SVG line chart.
+type ComplianceReportRenderer struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+// NewComplianceReportRenderer : Instantiate ComplianceReportRenderer with the default templates
+func NewComplianceReportRenderer() *ComplianceReportRenderer {
+	renderer := &ComplianceReportRenderer{}
+	if err := renderer.SetMarkdownTemplate(DefaultComplianceReportMarkdownTemplate); err != nil {
+		panic(err)
+	}
+	if err := renderer.SetHTMLTemplate(DefaultComplianceReportHTMLTemplate); err != nil {
+		panic(err)
+	}
+	return renderer
+}
+
+// SetMarkdownTemplate parses text as the Markdown template, with text/template.
+func (renderer *ComplianceReportRenderer) SetMarkdownTemplate(text string) error {
+	markdown, err := texttemplate.New("markdown").Funcs(texttemplate.FuncMap{
+		"md":         escapeMarkdownCell,
+		"driftChart": driftChartSVG,
+	}).Parse(text)
+	if err != nil {
+		return err
+	}
+	renderer.markdown = markdown
+	return nil
+}
+
+// SetHTMLTemplate parses text as the HTML template, with html/template.
+func (renderer *ComplianceReportRenderer) SetHTMLTemplate(text string) error {
+	html, err := htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+		"md":         escapeMarkdownCell,
+		"driftChart": driftChartSVG,
+	}).Parse(text)
+	if err != nil {
+		return err
+	}
+	renderer.html = html
+	return nil
+}
+
+// RenderMarkdown writes the report as Markdown.
+func (renderer *ComplianceReportRenderer) RenderMarkdown(w io.Writer, data *ComplianceReportData) error {
+	return renderer.markdown.Execute(w, data)
+}
+
+// RenderHTML writes the report as an HTML page.
+func (renderer *ComplianceReportRenderer) RenderHTML(w io.Writer, data *ComplianceReportData) error {
+	return renderer.html.Execute(w, data)
+}
+
+// escapeMarkdownCell escapes the characters that would end a Markdown table cell or row.
+func escapeMarkdownCell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ").Replace(text)
+}
+
+// driftChartSVG draws the number of compliant and not compliant controls of every scan as two lines.
+func driftChartSVG(points []DriftChartPoint) htmltemplate.HTML {
+	const width, height, padding = 600, 200, 30
+	var max int64 = 1
+	for _, point := range points {
+		if point.TotalCount > max {
+			max = point.TotalCount
+		}
+		if point.CompliantCount > max {
+			max = point.CompliantCount
+		}
+		if point.NotCompliantCount > max {
+			max = point.NotCompliantCount
+		}
+	}
+	x := func(i int) float64 {
+		if len(points) < 2 {
+			return width / 2
+		}
+		return padding + float64(i)*(width-2*padding)/float64(len(points)-1)
+	}
+	y := func(count int64) float64 {
+		return height - padding - float64(count)*(height-2*padding)/float64(max)
+	}
+	line := func(count func(point DriftChartPoint) int64) string {
+		coordinates := make([]string, len(points))
+		for i, point := range points {
+			coordinates[i] = fmt.Sprintf("%.1f,%.1f", x(i), y(count(point)))
+		}
+		return strings.Join(coordinates, " ")
+	}
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	fmt.Fprintf(&svg, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#8d8d8d"/>`, padding, height-padding, width-padding, height-padding)
+	fmt.Fprintf(&svg, `<text x="%d" y="%d" font-size="10">%d</text>`, 2, padding, max)
+	fmt.Fprintf(&svg, `<polyline fill="none" stroke="#198038" stroke-width="2" points="%s"><title>Compliant controls</title></polyline>`,
+		line(func(point DriftChartPoint) int64 { return point.CompliantCount }))
+	fmt.Fprintf(&svg, `<polyline fill="none" stroke="#da1e28" stroke-width="2" points="%s"><title>Not compliant controls</title></polyline>`,
+		line(func(point DriftChartPoint) int64 { return point.NotCompliantCount }))
+	if len(points) > 0 {
+		fmt.Fprintf(&svg, `<text x="%d" y="%d" font-size="10">%s</text>`, padding, height-8, htmltemplate.HTMLEscapeString(points[0].ScanTime))
+		fmt.Fprintf(&svg, `<text x="%d" y="%d" font-size="10" text-anchor="end">%s</text>`, width-padding, height-8, htmltemplate.HTMLEscapeString(points[len(points)-1].ScanTime))
+	}
+	svg.WriteString(`</svg>`)
+	return htmltemplate.HTML(svg.String())
+}
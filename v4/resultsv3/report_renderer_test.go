@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"bytes"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 compliance report renderer`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports/r1": `{"id": "r1", "scan_time": "2023-05-02T08:00:00Z", "profile": {"id": "p1", "name": "FS Cloud", "version": "1.0"},
+				"scope": {"id": "s1", "type": "account"}, "account": {"id": "acc-1", "name": "prod"}}`,
+			"GET /reports/r1/summary": `{"report_id": "r1", "score": {"passed": 3, "total_count": 4, "percent": 75},
+				"controls": {"total_count": 2, "compliant_count": 1, "not_compliant_count": 1},
+				"resources": {"total_count": 5, "not_compliant_count": 1, "top_failed": [{"name": "bucket|a", "service": "cloud-object-storage", "account": "acc-1", "failure_count": 2, "total_count": 3}]}}`,
+			"GET /reports/r1/controls": `{"report_id": "r1", "controls": [
+				{"control_path": "AC-2", "control_name": "Account <Management>", "control_category": "Access Control", "status": "not_compliant", "compliant_count": 1, "not_compliant_count": 2},
+				{"control_path": "SC-7", "control_name": "Boundary Protection", "status": "compliant", "compliant_count": 4}
+			]}`,
+			"GET /reports/r1/violations_drift": `{"report_id": "r1", "data_points": [
+				{"report_id": "r0", "scan_time": "2023-05-01T08:00:00Z", "controls": {"total_count": 2, "compliant_count": 0, "not_compliant_count": 2}},
+				{"report_id": "r1", "scan_time": "2023-05-02T08:00:00Z", "controls": {"total_count": 2, "compliant_count": 1, "not_compliant_count": 1}}
+			]}`,
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Renders the report as Markdown`, func() {
+		data, err := resultsService.GetComplianceReportData(resultsService.NewGetComplianceReportDataOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(data.Controls).To(HaveLen(2))
+		Expect(data.DriftChart()).To(HaveLen(2))
+
+		var buf bytes.Buffer
+		Expect(resultsv3.NewComplianceReportRenderer().RenderMarkdown(&buf, data)).To(Succeed())
+		markdown := buf.String()
+		Expect(markdown).To(HavePrefix("# Compliance report: FS Cloud 1.0\n"))
+		Expect(markdown).To(ContainSubstring("**75%** of the evaluations passed (3 of 4)."))
+		Expect(markdown).To(ContainSubstring("| Controls | 2 | 1 | 1 | 0 | 0 |\n"))
+		Expect(markdown).To(ContainSubstring("| AC-2 Account <Management> | Access Control | not_compliant | 1 | 2 | 0 |\n"))
+		Expect(markdown).To(ContainSubstring(`| bucket\|a | cloud-object-storage | acc-1 | 2 | 3 |`))
+		Expect(markdown).To(ContainSubstring("| 2023-05-01T08:00:00Z | 2 | 0 | 2 |\n"))
+	})
+	It(`Renders the report as self-contained HTML`, func() {
+		data, err := resultsService.GetComplianceReportData(resultsService.NewGetComplianceReportDataOptions("r1"))
+		Expect(err).To(BeNil())
+
+		var buf bytes.Buffer
+		Expect(resultsv3.NewComplianceReportRenderer().RenderHTML(&buf, data)).To(Succeed())
+		html := buf.String()
+		Expect(html).To(ContainSubstring("<title>Compliance report: FS Cloud</title>"))
+		Expect(html).To(ContainSubstring(`<td class="not_compliant">not_compliant</td>`))
+		Expect(html).To(ContainSubstring("Account &lt;Management&gt;"))
+		Expect(html).To(ContainSubstring(`<svg xmlns="http://www.w3.org/2000/svg"`))
+		Expect(html).To(ContainSubstring(`points="30.0,30.0 570.0,100.0"`))
+		Expect(html).ToNot(ContainSubstring("src="))
+	})
+	It(`Accepts empty controls and drift responses`, func() {
+		testServer.SetResponse("GET /reports/r1/controls", "")
+		testServer.SetResponse("GET /reports/r1/violations_drift", "")
+		data, err := resultsService.GetComplianceReportData(resultsService.NewGetComplianceReportDataOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(data.Controls).To(BeEmpty())
+		Expect(data.Drift).To(BeEmpty())
+	})
+	It(`Renders custom templates and empty data`, func() {
+		renderer := resultsv3.NewComplianceReportRenderer()
+		Expect(renderer.SetMarkdownTemplate(`{{.Report.GetID}}: {{.Summary.GetScore.GetPercent}}%`)).To(Succeed())
+		var buf bytes.Buffer
+		Expect(renderer.RenderMarkdown(&buf, &resultsv3.ComplianceReportData{
+			Report:  &resultsv3.Report{ID: core.StringPtr("r9")},
+			Summary: &resultsv3.ReportSummary{Score: &resultsv3.ComplianceScore{Percent: core.Int64Ptr(80)}},
+		})).To(Succeed())
+		Expect(buf.String()).To(Equal("r9: 80%"))
+
+		Expect(renderer.SetHTMLTemplate(`{{.Report.GetID`)).ToNot(Succeed())
+
+		buf.Reset()
+		Expect(resultsv3.NewComplianceReportRenderer().RenderHTML(&buf, &resultsv3.ComplianceReportData{})).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("<p>No controls.</p>"))
+		Expect(buf.String()).To(ContainSubstring("<p>No earlier scans.</p>"))
+	})
+})
@@ -0,0 +1,305 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults of WaitForReportOptions.
+const (
+	DefaultWaitForReportPollInterval = 30 * time.Second
+	DefaultWaitForReportStablePolls  = 3
+)
+
+// WaitForReportOptions : The WaitForReport options.
+type WaitForReportOptions struct {
+	// The ID of the attachment that was scanned.
+	AttachmentID *string `validate:"required,ne="`
+
+	// Only a report that was scanned after this time is waited for. The time is compared with the scan times that the
+	// service records, so it must be on the service clock: a time.Now() taken just before the scan was triggered can be
+	// ahead of the service clock, and the report of that scan is then skipped until the wait times out. Prefer
+	// AfterReportID; otherwise use a time from the service, such as the Date header of the response that triggered the
+	// scan, less a second.
+	After time.Time
+
+	// Only a report that was scanned after this report, which must be a report of the attachment, is waited for. When
+	// neither After nor AfterReportID is set, the newest report of the attachment at the first poll is used. Set it to
+	// the newest report of the attachment before the scan is triggered; it does not depend on any clock.
+	AfterReportID *string
+
+	// The time between polls. Defaults to DefaultWaitForReportPollInterval.
+	PollInterval time.Duration
+
+	// The number of consecutive polls whose evaluation counts must be the same before the report is complete. Defaults
+	// to DefaultWaitForReportStablePolls.
+	StablePolls int
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewWaitForReportOptions : Instantiate WaitForReportOptions
+func (*ResultsV3) NewWaitForReportOptions(attachmentID string) *WaitForReportOptions {
+	return &WaitForReportOptions{
+		AttachmentID: core.StringPtr(attachmentID),
+	}
+}
+
+// SetAttachmentID : Allow user to set AttachmentID
+func (_options *WaitForReportOptions) SetAttachmentID(attachmentID string) *WaitForReportOptions {
+	_options.AttachmentID = core.StringPtr(attachmentID)
+	return _options
+}
+
+// SetAfter : Allow user to set After
+func (_options *WaitForReportOptions) SetAfter(after time.Time) *WaitForReportOptions {
+	_options.After = after
+	return _options
+}
+
+// SetAfterReportID : Allow user to set AfterReportID
+func (_options *WaitForReportOptions) SetAfterReportID(afterReportID string) *WaitForReportOptions {
+	_options.AfterReportID = core.StringPtr(afterReportID)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForReportOptions) SetPollInterval(pollInterval time.Duration) *WaitForReportOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetStablePolls : Allow user to set StablePolls
+func (_options *WaitForReportOptions) SetStablePolls(stablePolls int) *WaitForReportOptions {
+	_options.StablePolls = stablePolls
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *WaitForReportOptions) SetXCorrelationID(xCorrelationID string) *WaitForReportOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForReportOptions) SetHeaders(param map[string]string) *WaitForReportOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForReportResult : The complete report that WaitForReport waited for.
+type WaitForReportResult struct {
+	// The new report.
+	Report *Report `json:"report"`
+
+	// The summary of the report after its evaluation counts stabilised.
+	Summary *ReportSummary `json:"summary"`
+
+	// The number of polls that were made.
+	Polls int `json:"polls"`
+}
+
+// WaitForReport : Wait for the next report of an attachment
+// Poll the reports of the attachment until one that was scanned after the given time or report appears, then poll its
+// summary until the evaluation counts stay the same for StablePolls polls. WaitForReport does not return until the
+// report is complete; use WaitForReportWithContext with a deadline to bound the wait.
+//
+// To wait for the report of a scan, remember the newest report of the attachment before the scan is triggered:
+//
+//	latest, _, err := results.GetLatestReports(results.NewGetLatestReportsOptions())
+//	...
+//	options := results.NewWaitForReportOptions(attachmentID)
+//	for i := range latest.Reports {
+//		if latest.Reports[i].GetAttachment().GetID() == attachmentID {
+//			options.SetAfterReportID(latest.Reports[i].GetID())
+//		}
+//	}
+//	// Trigger the scan, then wait for its report.
+//	result, err := results.WaitForReportWithContext(ctx, options)
+func (results *ResultsV3) WaitForReport(waitForReportOptions *WaitForReportOptions) (result *WaitForReportResult, err error) {
+	return results.WaitForReportWithContext(context.Background(), waitForReportOptions)
+}
+
+// WaitForReportWithContext is an alternate form of the WaitForReport method which supports a Context parameter. The
+// wait stops with the context error when the context is cancelled or its deadline passes.
+func (results *ResultsV3) WaitForReportWithContext(ctx context.Context, waitForReportOptions *WaitForReportOptions) (result *WaitForReportResult, err error) {
+	err = core.ValidateNotNil(waitForReportOptions, "waitForReportOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(waitForReportOptions, "waitForReportOptions")
+	if err != nil {
+		return
+	}
+	options := waitForReportOptions
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = DefaultWaitForReportPollInterval
+	}
+	stablePolls := options.StablePolls
+	if stablePolls <= 0 {
+		stablePolls = DefaultWaitForReportStablePolls
+	}
+
+	result = &WaitForReportResult{}
+	after := options.After
+	afterReportID := ""
+	if options.AfterReportID != nil {
+		afterReportID = *options.AfterReportID
+	}
+	useNewest := after.IsZero() && afterReportID == ""
+
+	var last *EvalStats
+	stable := 0
+	for {
+		result.Polls++
+		if result.Report == nil {
+			var reports []Report
+			reports, err = results.attachmentReports(ctx, options)
+			if err != nil {
+				return nil, waitError(ctx, err)
+			}
+			if result.Polls == 1 && afterReportID != "" && !hasReport(reports, afterReportID) {
+				return nil, fmt.Errorf("report %s is not a report of attachment %s", afterReportID, *options.AttachmentID)
+			}
+			if useNewest {
+				// The reference is the newest report that exists before the wait.
+				if newest := newestReport(reports, time.Time{}, ""); newest != nil {
+					after, _ = reportTime(newest)
+					afterReportID = *newest.ID
+				}
+				useNewest = false
+			} else {
+				result.Report = newestReport(reports, after, afterReportID)
+			}
+		}
+
+		if result.Report != nil {
+			var summary *ReportSummary
+			var response *core.DetailedResponse
+			summary, response, err = results.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+				ReportID:       result.Report.ID,
+				XCorrelationID: options.XCorrelationID,
+				Headers:        options.Headers,
+			})
+			switch {
+			case err == nil:
+				if last != nil && sameEvalStats(last, summary.GetEvaluations()) {
+					stable++
+				} else {
+					stable = 1
+				}
+				last = summary.GetEvaluations()
+				result.Summary = summary
+				if stable >= stablePolls {
+					return result, nil
+				}
+			case response != nil && response.StatusCode == http.StatusNotFound:
+				// The report is listed before its summary is available.
+				err = nil
+			default:
+				return nil, waitError(ctx, err)
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// waitError returns the context error instead of err when a request failed because the context ended.
+func waitError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// attachmentReports lists every report of the attachment.
+func (results *ResultsV3) attachmentReports(ctx context.Context, options *WaitForReportOptions) (reports []Report, err error) {
+	pager, err := results.NewReportsPager(&ListReportsOptions{
+		AttachmentID:   options.AttachmentID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// newestReport returns the newest of the reports that were scanned after the given time and are newer than the report
+// with the given ID, or nil when there is none.
+func newestReport(reports []Report, after time.Time, afterReportID string) (newest *Report) {
+	if afterReportID != "" {
+		for i := range reports {
+			if reports[i].GetID() == afterReportID {
+				if t, ok := reportTime(&reports[i]); ok && t.After(after) {
+					after = t
+				}
+				break
+			}
+		}
+	}
+	var newestTime time.Time
+	for i := range reports {
+		report := &reports[i]
+		t, ok := reportTime(report)
+		if !ok || report.ID == nil || *report.ID == afterReportID || !t.After(after) {
+			continue
+		}
+		if newest == nil || t.After(newestTime) {
+			newest, newestTime = report, t
+		}
+	}
+	return
+}
+
+// hasReport reports whether a report with the given ID is in the list.
+func hasReport(reports []Report, reportID string) bool {
+	for i := range reports {
+		if reports[i].GetID() == reportID {
+			return true
+		}
+	}
+	return false
+}
+
+// sameEvalStats reports whether two evaluation stats have the same counts.
+func sameEvalStats(a *EvalStats, b *EvalStats) bool {
+	return a.GetTotalCount() == b.GetTotalCount() &&
+		a.GetPassCount() == b.GetPassCount() &&
+		a.GetFailureCount() == b.GetFailureCount() &&
+		a.GetErrorCount() == b.GetErrorCount() &&
+		a.GetCompletedCount() == b.GetCompletedCount()
+}
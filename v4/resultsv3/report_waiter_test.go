@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 report waiter`, func() {
+	var testServer *httptest.Server
+	var resultsService *resultsv3.ResultsV3
+	var mutex sync.Mutex
+	var reportPolls int
+	var summaryPolls int
+
+	const oldReport = `{"id": "r1", "scan_time": "2023-05-01T08:00:00Z", "attachment": {"id": "a1"}}`
+	const newReport = `{"id": "r2", "scan_time": "2023-05-02T08:00:00Z", "attachment": {"id": "a1"}}`
+
+	BeforeEach(func() {
+		reportPolls, summaryPolls = 0, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			Expect(req.URL.Query().Get("attachment_id")).To(Or(Equal(""), Equal("a1")))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/reports":
+				reportPolls++
+				// The new report is listed from the third poll on.
+				if reportPolls < 3 {
+					fmt.Fprintf(res, `{"reports": [%s]}`, oldReport)
+				} else {
+					fmt.Fprintf(res, `{"reports": [%s, %s]}`, newReport, oldReport)
+				}
+			case "/reports/r2/summary":
+				summaryPolls++
+				// The summary is missing at first, then its counts grow until the fourth poll.
+				if summaryPolls == 1 {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+					return
+				}
+				completed := summaryPolls * 10
+				if completed > 40 {
+					completed = 40
+				}
+				fmt.Fprintf(res, `{"report_id": "r2", "evaluations": {"total_count": 40, "completed_count": %d, "pass_count": %d}}`, completed, completed)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Waits for a newer report and for its counts to stabilise`, func() {
+		options := resultsService.NewWaitForReportOptions("a1").
+			SetAfter(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)).
+			SetPollInterval(time.Millisecond).
+			SetStablePolls(2)
+		result, err := resultsService.WaitForReport(options)
+		Expect(err).To(BeNil())
+		Expect(result.Report.GetID()).To(Equal("r2"))
+		Expect(result.Summary.GetEvaluations().GetCompletedCount()).To(Equal(int64(40)))
+		Expect(reportPolls).To(Equal(3))
+		Expect(summaryPolls).To(Equal(5))
+		Expect(result.Polls).To(Equal(7))
+	})
+	It(`Waits for a report after a given or the newest report`, func() {
+		options := resultsService.NewWaitForReportOptions("a1").SetAfterReportID("r1").SetPollInterval(time.Millisecond)
+		result, err := resultsService.WaitForReport(options)
+		Expect(err).To(BeNil())
+		Expect(result.Report.GetID()).To(Equal("r2"))
+
+		reportPolls, summaryPolls = 0, 0
+		result, err = resultsService.WaitForReport(resultsService.NewWaitForReportOptions("a1").SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(result.Report.GetID()).To(Equal("r2"))
+
+		_, err = resultsService.WaitForReport(resultsService.NewWaitForReportOptions("a1").SetAfterReportID("r9"))
+		Expect(err).To(MatchError("report r9 is not a report of attachment a1"))
+	})
+	It(`Stops at the context deadline`, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		options := resultsService.NewWaitForReportOptions("a1").SetAfterReportID("r2").SetPollInterval(5 * time.Millisecond)
+		reportPolls = 10
+		_, err := resultsService.WaitForReportWithContext(ctx, options)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+
+		_, err = resultsService.WaitForReport(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,285 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceView : A resource of a report together with the evaluations of it that did not pass.
+type ResourceView struct {
+	// The resource, with its evaluation counts.
+	Resource *Resource
+
+	// The account that owns the resource.
+	Account *Account
+
+	// The evaluations of the resource whose status is one of the requested statuses.
+	Findings []ResourceFinding
+
+	// The tags of the report. The service does not return tags per resource, so every view of a report has the same
+	// tags.
+	Tags *Tags
+}
+
+// ResourceFinding : An evaluation of a resource.
+type ResourceFinding struct {
+	// The control ID.
+	ControlID string
+
+	// The component ID.
+	ComponentID string
+
+	// The control specification assessment.
+	Assessment *Assessment
+
+	// The allowed values of an evaluation status.
+	Status string
+
+	// The reason for the evaluation failure.
+	Reason string
+
+	// The evaluation properties, with their expected and found values.
+	Properties []Property
+
+	// The evaluation that the finding was taken from.
+	Evaluation *Evaluation
+}
+
+// GetReportResourceViewsOptions : The GetReportResourceViews options.
+type GetReportResourceViewsOptions struct {
+	// The ID of the scan associated to a report.
+	ReportID *string `validate:"required,ne="`
+
+	// The ID of the resource. When set only that resource is viewed, and only its evaluations are listed.
+	ResourceID *string
+
+	// The name of the resource.
+	ResourceName *string
+
+	// The ID of the account owning a resource.
+	AccountID *string
+
+	// The ID of component.
+	ComponentID *string
+
+	// A compliance status value of the resource.
+	Status *string
+
+	// The evaluation statuses that are reported as findings. Defaults to Evaluation_Status_Failure.
+	EvaluationStatuses []string
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewGetReportResourceViewsOptions : Instantiate GetReportResourceViewsOptions
+func (*ResultsV3) NewGetReportResourceViewsOptions(reportID string) *GetReportResourceViewsOptions {
+	return &GetReportResourceViewsOptions{
+		ReportID: core.StringPtr(reportID),
+	}
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *GetReportResourceViewsOptions) SetReportID(reportID string) *GetReportResourceViewsOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetResourceID : Allow user to set ResourceID
+func (_options *GetReportResourceViewsOptions) SetResourceID(resourceID string) *GetReportResourceViewsOptions {
+	_options.ResourceID = core.StringPtr(resourceID)
+	return _options
+}
+
+// SetResourceName : Allow user to set ResourceName
+func (_options *GetReportResourceViewsOptions) SetResourceName(resourceName string) *GetReportResourceViewsOptions {
+	_options.ResourceName = core.StringPtr(resourceName)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *GetReportResourceViewsOptions) SetAccountID(accountID string) *GetReportResourceViewsOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// SetComponentID : Allow user to set ComponentID
+func (_options *GetReportResourceViewsOptions) SetComponentID(componentID string) *GetReportResourceViewsOptions {
+	_options.ComponentID = core.StringPtr(componentID)
+	return _options
+}
+
+// SetStatus : Allow user to set Status
+func (_options *GetReportResourceViewsOptions) SetStatus(status string) *GetReportResourceViewsOptions {
+	_options.Status = core.StringPtr(status)
+	return _options
+}
+
+// SetEvaluationStatuses : Allow user to set EvaluationStatuses
+func (_options *GetReportResourceViewsOptions) SetEvaluationStatuses(evaluationStatuses []string) *GetReportResourceViewsOptions {
+	_options.EvaluationStatuses = evaluationStatuses
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *GetReportResourceViewsOptions) SetXCorrelationID(xCorrelationID string) *GetReportResourceViewsOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetReportResourceViewsOptions) SetHeaders(param map[string]string) *GetReportResourceViewsOptions {
+	options.Headers = param
+	return options
+}
+
+// GetReportResourceViews : Get the resources of a report with their findings
+// List the resources of a report and the evaluations of the report, and join every evaluation to the resource that it
+// targets by resource CRN, or by target ID when the target has no CRN. Evaluations of resources that are not listed,
+// because of the resource filters, are left out. The report tags are fetched once and set on every view.
+func (results *ResultsV3) GetReportResourceViews(getReportResourceViewsOptions *GetReportResourceViewsOptions) (result []ResourceView, err error) {
+	return results.GetReportResourceViewsWithContext(context.Background(), getReportResourceViewsOptions)
+}
+
+// GetReportResourceViewsWithContext is an alternate form of the GetReportResourceViews method which supports a Context parameter
+func (results *ResultsV3) GetReportResourceViewsWithContext(ctx context.Context, getReportResourceViewsOptions *GetReportResourceViewsOptions) (result []ResourceView, err error) {
+	err = core.ValidateNotNil(getReportResourceViewsOptions, "getReportResourceViewsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getReportResourceViewsOptions, "getReportResourceViewsOptions")
+	if err != nil {
+		return
+	}
+	options := getReportResourceViewsOptions
+
+	resourcesPager, err := results.NewReportResourcesPager(&ListReportResourcesOptions{
+		ReportID:       options.ReportID,
+		ID:             options.ResourceID,
+		ResourceName:   options.ResourceName,
+		AccountID:      options.AccountID,
+		ComponentID:    options.ComponentID,
+		Status:         options.Status,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	resources, err := resourcesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	if len(resources) == 0 {
+		return
+	}
+
+	statuses := options.EvaluationStatuses
+	if len(statuses) == 0 {
+		statuses = []string{Evaluation_Status_Failure}
+	}
+	listReportEvaluationsOptions := &ListReportEvaluationsOptions{
+		ReportID:       options.ReportID,
+		TargetID:       options.ResourceID,
+		ComponentID:    options.ComponentID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	}
+	if len(statuses) == 1 {
+		listReportEvaluationsOptions.Status = core.StringPtr(statuses[0])
+	}
+	evaluationsPager, err := results.NewReportEvaluationsPager(listReportEvaluationsOptions)
+	if err != nil {
+		return
+	}
+	evaluations, err := evaluationsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	tags, _, err := results.GetReportTagsWithContext(ctx, &GetReportTagsOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	result = make([]ResourceView, len(resources))
+	viewsByID := make(map[string]*ResourceView, len(resources))
+	for i := range resources {
+		resource := &resources[i]
+		result[i] = ResourceView{
+			Resource: resource,
+			Account:  resource.Account,
+			Tags:     tags.GetTags(),
+		}
+		if resource.ID != nil {
+			viewsByID[*resource.ID] = &result[i]
+		}
+	}
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+		if !containsString(statuses, evaluation.GetStatus()) {
+			continue
+		}
+		view, ok := viewsByID[evaluationResourceID(evaluation)]
+		if !ok {
+			continue
+		}
+		var properties []Property
+		if evaluation.Details != nil {
+			properties = evaluation.Details.Properties
+		}
+		view.Findings = append(view.Findings, ResourceFinding{
+			ControlID:   evaluation.GetControlID(),
+			ComponentID: evaluation.GetComponentID(),
+			Assessment:  evaluation.Assessment,
+			Status:      evaluation.GetStatus(),
+			Reason:      evaluation.GetReason(),
+			Properties:  properties,
+			Evaluation:  evaluation,
+		})
+	}
+	return
+}
+
+// evaluationResourceID returns the CRN of the resource that an evaluation targets, or the target ID when the target
+// has no CRN.
+func evaluationResourceID(evaluation *Evaluation) string {
+	if crn := evaluation.GetTarget().GetResourceCrn(); crn != "" {
+		return crn
+	}
+	return evaluation.GetTarget().GetID()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 resource views`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports/r1/resources": `{"resources": [
+				{"id": "crn:bucket-a", "resource_name": "bucket-a", "status": "not_compliant", "failure_count": 2, "account": {"id": "acc-1", "name": "prod"}},
+				{"id": "crn:bucket-b", "resource_name": "bucket-b", "status": "compliant", "failure_count": 0, "account": {"id": "acc-2"}}
+			]}`,
+			"GET /reports/r1/evaluations": `{"evaluations": [
+				{"control_id": "c1", "status": "failure", "reason": "public access", "assessment": {"assessment_id": "rule-1"},
+					"target": {"id": "t1", "resource_crn": "crn:bucket-a"},
+					"details": {"properties": [{"property": "public_access", "operator": "is_false", "expected_value": false, "found_value": true}]}},
+				{"control_id": "c2", "status": "failure", "reason": "no encryption", "target": {"id": "crn:bucket-a"}},
+				{"control_id": "c3", "status": "pass", "target": {"resource_crn": "crn:bucket-b"}},
+				{"control_id": "c4", "status": "error", "target": {"resource_crn": "crn:bucket-b"}},
+				{"control_id": "c5", "status": "failure", "target": {"resource_crn": "crn:other"}}
+			]}`,
+			"GET /reports/r1/tags": `{"report_id": "r1", "tags": {"user": ["team:storage"]}}`,
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Joins the failing evaluations, account and tags to every resource`, func() {
+		views, err := resultsService.GetReportResourceViews(resultsService.NewGetReportResourceViewsOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(views).To(HaveLen(2))
+		evaluationRequests := testServer.RequestsTo("GET /reports/r1/evaluations")
+		Expect(evaluationRequests).To(HaveLen(1))
+		Expect(evaluationRequests[0].Query.Get("status")).To(Equal("failure"))
+		Expect(evaluationRequests[0].Query.Get("target_id")).To(Equal(""))
+
+		bucketA := views[0]
+		Expect(*bucketA.Resource.ResourceName).To(Equal("bucket-a"))
+		Expect(*bucketA.Account.Name).To(Equal("prod"))
+		Expect(bucketA.Tags.User).To(Equal([]string{"team:storage"}))
+		Expect(bucketA.Findings).To(HaveLen(2))
+		Expect(bucketA.Findings[0].ControlID).To(Equal("c1"))
+		Expect(bucketA.Findings[0].Reason).To(Equal("public access"))
+		Expect(*bucketA.Findings[0].Assessment.AssessmentID).To(Equal("rule-1"))
+		Expect(*bucketA.Findings[0].Properties[0].Property).To(Equal("public_access"))
+		Expect(bucketA.Findings[0].Properties[0].FoundValue).To(Equal(true))
+		Expect(bucketA.Findings[1].ControlID).To(Equal("c2"))
+
+		Expect(views[1].Findings).To(BeEmpty())
+		Expect(views[1].Tags.User).To(Equal([]string{"team:storage"}))
+	})
+	It(`Reports the requested evaluation statuses`, func() {
+		views, err := resultsService.GetReportResourceViews(resultsService.NewGetReportResourceViewsOptions("r1").
+			SetEvaluationStatuses([]string{resultsv3.Evaluation_Status_Failure, resultsv3.Evaluation_Status_Error}))
+		Expect(err).To(BeNil())
+		evaluationRequests := testServer.RequestsTo("GET /reports/r1/evaluations")
+		Expect(evaluationRequests).To(HaveLen(1))
+		Expect(evaluationRequests[0].Query.Get("status")).To(Equal(""))
+		Expect(views[0].Findings).To(HaveLen(2))
+		Expect(views[1].Findings).To(HaveLen(1))
+		Expect(views[1].Findings[0].Status).To(Equal("error"))
+	})
+	It(`Lists only the evaluations of the requested resource`, func() {
+		_, err := resultsService.GetReportResourceViews(resultsService.NewGetReportResourceViewsOptions("r1").SetResourceID("crn:bucket-a"))
+		Expect(err).To(BeNil())
+		resourceRequests := testServer.RequestsTo("GET /reports/r1/resources")
+		Expect(resourceRequests[0].Query.Get("id")).To(Equal("crn:bucket-a"))
+		evaluationRequests := testServer.RequestsTo("GET /reports/r1/evaluations")
+		Expect(evaluationRequests).To(HaveLen(1))
+		Expect(evaluationRequests[0].Query.Get("target_id")).To(Equal("crn:bucket-a"))
+	})
+})
@@ -1064,6 +1064,76 @@ type Account struct {
 
 	// The account type.
 	Type *string `json:"type,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Account
+func (o *Account) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Account
+func (o *Account) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Account
+func (o *Account) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Account
+func (o *Account) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Account
+func (o *Account) MarshalJSON() (buffer []byte, err error) {
+	type model Account
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Account is nil
+func (o *Account) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Account is nil
+func (o *Account) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Account is nil
+func (o *Account) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
 }
 
 // UnmarshalAccount unmarshals an instance of Account from the specified map of raw messages.
@@ -1081,6 +1151,10 @@ func UnmarshalAccount(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1104,6 +1178,100 @@ type Assessment struct {
 
 	// The list of parameters of this assessment.
 	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Assessment
+func (o *Assessment) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Assessment
+func (o *Assessment) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Assessment
+func (o *Assessment) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Assessment
+func (o *Assessment) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Assessment
+func (o *Assessment) MarshalJSON() (buffer []byte, err error) {
+	type model Assessment
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetAssessmentID returns the value of AssessmentID, or the zero value when AssessmentID or the Assessment is nil
+func (o *Assessment) GetAssessmentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AssessmentID)
+}
+
+// GetAssessmentType returns the value of AssessmentType, or the zero value when AssessmentType or the Assessment is nil
+func (o *Assessment) GetAssessmentType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AssessmentType)
+}
+
+// GetAssessmentMethod returns the value of AssessmentMethod, or the zero value when AssessmentMethod or the Assessment is nil
+func (o *Assessment) GetAssessmentMethod() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AssessmentMethod)
+}
+
+// GetAssessmentDescription returns the value of AssessmentDescription, or the zero value when AssessmentDescription or the Assessment is nil
+func (o *Assessment) GetAssessmentDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AssessmentDescription)
+}
+
+// GetParameterCount returns the value of ParameterCount, or the zero value when ParameterCount or the Assessment is nil
+func (o *Assessment) GetParameterCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ParameterCount)
+}
+
+// GetParameters returns Parameters, or nil when the Assessment is nil
+func (o *Assessment) GetParameters() []Parameter {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
 }
 
 // UnmarshalAssessment unmarshals an instance of Assessment from the specified map of raw messages.
@@ -1133,6 +1301,10 @@ func UnmarshalAssessment(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "assessment_id", "assessment_type", "assessment_method", "assessment_description", "parameter_count", "parameters")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1141,6 +1313,60 @@ func UnmarshalAssessment(m map[string]json.RawMessage, result interface{}) (err
 type Attachment struct {
 	// The attachment ID.
 	ID *string `json:"id,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Attachment
+func (o *Attachment) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Attachment
+func (o *Attachment) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Attachment
+func (o *Attachment) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Attachment
+func (o *Attachment) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Attachment
+func (o *Attachment) MarshalJSON() (buffer []byte, err error) {
+	type model Attachment
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Attachment is nil
+func (o *Attachment) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
 }
 
 // UnmarshalAttachment unmarshals an instance of Attachment from the specified map of raw messages.
@@ -1150,6 +1376,10 @@ func UnmarshalAttachment(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1164,6 +1394,76 @@ type ComplianceScore struct {
 
 	// The percentage of successful evaluations.
 	Percent *int64 `json:"percent,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ComplianceScore
+func (o *ComplianceScore) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ComplianceScore
+func (o *ComplianceScore) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ComplianceScore
+func (o *ComplianceScore) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ComplianceScore
+func (o *ComplianceScore) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ComplianceScore
+func (o *ComplianceScore) MarshalJSON() (buffer []byte, err error) {
+	type model ComplianceScore
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetPassed returns the value of Passed, or the zero value when Passed or the ComplianceScore is nil
+func (o *ComplianceScore) GetPassed() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Passed)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ComplianceScore is nil
+func (o *ComplianceScore) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetPercent returns the value of Percent, or the zero value when Percent or the ComplianceScore is nil
+func (o *ComplianceScore) GetPercent() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Percent)
 }
 
 // UnmarshalComplianceScore unmarshals an instance of ComplianceScore from the specified map of raw messages.
@@ -1181,6 +1481,10 @@ func UnmarshalComplianceScore(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "passed", "total_count", "percent")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1204,6 +1508,9 @@ type ComplianceStats struct {
 
 	// The number of checks that require a user evaluation.
 	UserEvaluationRequiredCount *int64 `json:"user_evaluation_required_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ComplianceStats.Status property.
@@ -1215,6 +1522,97 @@ const (
 	ComplianceStats_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ComplianceStats
+func (o *ComplianceStats) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ComplianceStats
+func (o *ComplianceStats) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ComplianceStats
+func (o *ComplianceStats) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ComplianceStats
+func (o *ComplianceStats) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ComplianceStats
+func (o *ComplianceStats) MarshalJSON() (buffer []byte, err error) {
+	type model ComplianceStats
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ComplianceStats is nil
+func (o *ComplianceStats) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ComplianceStats is nil
+func (o *ComplianceStats) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetCompliantCount returns the value of CompliantCount, or the zero value when CompliantCount or the ComplianceStats is nil
+func (o *ComplianceStats) GetCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompliantCount)
+}
+
+// GetNotCompliantCount returns the value of NotCompliantCount, or the zero value when NotCompliantCount or the ComplianceStats is nil
+func (o *ComplianceStats) GetNotCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotCompliantCount)
+}
+
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the ComplianceStats is nil
+func (o *ComplianceStats) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
+
+// GetUserEvaluationRequiredCount returns the value of UserEvaluationRequiredCount, or the zero value when UserEvaluationRequiredCount or the ComplianceStats is nil
+func (o *ComplianceStats) GetUserEvaluationRequiredCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UserEvaluationRequiredCount)
+}
+
 // UnmarshalComplianceStats unmarshals an instance of ComplianceStats from the specified map of raw messages.
 func UnmarshalComplianceStats(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ComplianceStats)
@@ -1242,6 +1640,10 @@ func UnmarshalComplianceStats(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "status", "total_count", "compliant_count", "not_compliant_count", "unable_to_perform_count", "user_evaluation_required_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1283,6 +1685,9 @@ type ControlSpecificationWithStats struct {
 
 	// The number of checks that require a user evaluation.
 	UserEvaluationRequiredCount *int64 `json:"user_evaluation_required_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ControlSpecificationWithStats.Status property.
@@ -1294,6 +1699,145 @@ const (
 	ControlSpecificationWithStats_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ControlSpecificationWithStats
+func (o *ControlSpecificationWithStats) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ControlSpecificationWithStats
+func (o *ControlSpecificationWithStats) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ControlSpecificationWithStats
+func (o *ControlSpecificationWithStats) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ControlSpecificationWithStats
+func (o *ControlSpecificationWithStats) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ControlSpecificationWithStats
+func (o *ControlSpecificationWithStats) MarshalJSON() (buffer []byte, err error) {
+	type model ControlSpecificationWithStats
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetComponentID returns the value of ComponentID, or the zero value when ComponentID or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetComponentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ComponentID)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetEnvironment returns the value of Environment, or the zero value when Environment or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetEnvironment() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Environment)
+}
+
+// GetResponsibility returns the value of Responsibility, or the zero value when Responsibility or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetResponsibility() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Responsibility)
+}
+
+// GetAssessments returns Assessments, or nil when the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetAssessments() []Assessment {
+	if o == nil {
+		return nil
+	}
+	return o.Assessments
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetCompliantCount returns the value of CompliantCount, or the zero value when CompliantCount or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompliantCount)
+}
+
+// GetNotCompliantCount returns the value of NotCompliantCount, or the zero value when NotCompliantCount or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetNotCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotCompliantCount)
+}
+
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
+
+// GetUserEvaluationRequiredCount returns the value of UserEvaluationRequiredCount, or the zero value when UserEvaluationRequiredCount or the ControlSpecificationWithStats is nil
+func (o *ControlSpecificationWithStats) GetUserEvaluationRequiredCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UserEvaluationRequiredCount)
+}
+
 // UnmarshalControlSpecificationWithStats unmarshals an instance of ControlSpecificationWithStats from the specified map of raw messages.
 func UnmarshalControlSpecificationWithStats(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ControlSpecificationWithStats)
@@ -1345,6 +1889,10 @@ func UnmarshalControlSpecificationWithStats(m map[string]json.RawMessage, result
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "component_id", "description", "environment", "responsibility", "assessments", "status", "total_count", "compliant_count", "not_compliant_count", "unable_to_perform_count", "user_evaluation_required_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1392,6 +1940,9 @@ type ControlWithStats struct {
 
 	// The number of checks that require a user evaluation.
 	UserEvaluationRequiredCount *int64 `json:"user_evaluation_required_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ControlWithStats.Status property.
@@ -1403,22 +1954,177 @@ const (
 	ControlWithStats_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
-// UnmarshalControlWithStats unmarshals an instance of ControlWithStats from the specified map of raw messages.
-func UnmarshalControlWithStats(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(ControlWithStats)
-	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
-	if err != nil {
-		return
-	}
-	err = core.UnmarshalPrimitive(m, "control_library_id", &obj.ControlLibraryID)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ControlWithStats
+func (o *ControlWithStats) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	err = core.UnmarshalPrimitive(m, "control_library_version", &obj.ControlLibraryVersion)
-	if err != nil {
-		return
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ControlWithStats
+func (o *ControlWithStats) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
 	}
-	err = core.UnmarshalPrimitive(m, "control_name", &obj.ControlName)
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ControlWithStats
+func (o *ControlWithStats) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ControlWithStats
+func (o *ControlWithStats) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ControlWithStats
+func (o *ControlWithStats) MarshalJSON() (buffer []byte, err error) {
+	type model ControlWithStats
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ControlWithStats is nil
+func (o *ControlWithStats) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetControlLibraryID returns the value of ControlLibraryID, or the zero value when ControlLibraryID or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlLibraryID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlLibraryID)
+}
+
+// GetControlLibraryVersion returns the value of ControlLibraryVersion, or the zero value when ControlLibraryVersion or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlLibraryVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlLibraryVersion)
+}
+
+// GetControlName returns the value of ControlName, or the zero value when ControlName or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlName)
+}
+
+// GetControlDescription returns the value of ControlDescription, or the zero value when ControlDescription or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlDescription)
+}
+
+// GetControlCategory returns the value of ControlCategory, or the zero value when ControlCategory or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlCategory() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlCategory)
+}
+
+// GetControlPath returns the value of ControlPath, or the zero value when ControlPath or the ControlWithStats is nil
+func (o *ControlWithStats) GetControlPath() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlPath)
+}
+
+// GetControlSpecifications returns ControlSpecifications, or nil when the ControlWithStats is nil
+func (o *ControlWithStats) GetControlSpecifications() []ControlSpecificationWithStats {
+	if o == nil {
+		return nil
+	}
+	return o.ControlSpecifications
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ControlWithStats is nil
+func (o *ControlWithStats) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ControlWithStats is nil
+func (o *ControlWithStats) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetCompliantCount returns the value of CompliantCount, or the zero value when CompliantCount or the ControlWithStats is nil
+func (o *ControlWithStats) GetCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompliantCount)
+}
+
+// GetNotCompliantCount returns the value of NotCompliantCount, or the zero value when NotCompliantCount or the ControlWithStats is nil
+func (o *ControlWithStats) GetNotCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotCompliantCount)
+}
+
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the ControlWithStats is nil
+func (o *ControlWithStats) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
+
+// GetUserEvaluationRequiredCount returns the value of UserEvaluationRequiredCount, or the zero value when UserEvaluationRequiredCount or the ControlWithStats is nil
+func (o *ControlWithStats) GetUserEvaluationRequiredCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UserEvaluationRequiredCount)
+}
+
+// UnmarshalControlWithStats unmarshals an instance of ControlWithStats from the specified map of raw messages.
+func UnmarshalControlWithStats(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ControlWithStats)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "control_library_id", &obj.ControlLibraryID)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "control_library_version", &obj.ControlLibraryVersion)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "control_name", &obj.ControlName)
 	if err != nil {
 		return
 	}
@@ -1462,6 +2168,10 @@ func UnmarshalControlWithStats(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "control_library_id", "control_library_version", "control_name", "control_description", "control_category", "control_path", "control_specifications", "status", "total_count", "compliant_count", "not_compliant_count", "unable_to_perform_count", "user_evaluation_required_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1470,6 +2180,60 @@ func UnmarshalControlWithStats(m map[string]json.RawMessage, result interface{})
 type EvalDetails struct {
 	// The evaluation properties.
 	Properties []Property `json:"properties,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EvalDetails
+func (o *EvalDetails) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EvalDetails
+func (o *EvalDetails) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EvalDetails
+func (o *EvalDetails) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EvalDetails
+func (o *EvalDetails) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EvalDetails
+func (o *EvalDetails) MarshalJSON() (buffer []byte, err error) {
+	type model EvalDetails
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProperties returns Properties, or nil when the EvalDetails is nil
+func (o *EvalDetails) GetProperties() []Property {
+	if o == nil {
+		return nil
+	}
+	return o.Properties
 }
 
 // UnmarshalEvalDetails unmarshals an instance of EvalDetails from the specified map of raw messages.
@@ -1479,6 +2243,10 @@ func UnmarshalEvalDetails(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "properties")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1502,6 +2270,9 @@ type EvalStats struct {
 
 	// The total number of completed evaluations.
 	CompletedCount *int64 `json:"completed_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the EvalStats.Status property.
@@ -1513,6 +2284,97 @@ const (
 	EvalStats_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EvalStats
+func (o *EvalStats) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EvalStats
+func (o *EvalStats) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EvalStats
+func (o *EvalStats) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EvalStats
+func (o *EvalStats) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EvalStats
+func (o *EvalStats) MarshalJSON() (buffer []byte, err error) {
+	type model EvalStats
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the EvalStats is nil
+func (o *EvalStats) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the EvalStats is nil
+func (o *EvalStats) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetPassCount returns the value of PassCount, or the zero value when PassCount or the EvalStats is nil
+func (o *EvalStats) GetPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.PassCount)
+}
+
+// GetFailureCount returns the value of FailureCount, or the zero value when FailureCount or the EvalStats is nil
+func (o *EvalStats) GetFailureCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.FailureCount)
+}
+
+// GetErrorCount returns the value of ErrorCount, or the zero value when ErrorCount or the EvalStats is nil
+func (o *EvalStats) GetErrorCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ErrorCount)
+}
+
+// GetCompletedCount returns the value of CompletedCount, or the zero value when CompletedCount or the EvalStats is nil
+func (o *EvalStats) GetCompletedCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompletedCount)
+}
+
 // UnmarshalEvalStats unmarshals an instance of EvalStats from the specified map of raw messages.
 func UnmarshalEvalStats(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(EvalStats)
@@ -1540,6 +2402,10 @@ func UnmarshalEvalStats(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "status", "total_count", "pass_count", "failure_count", "error_count", "completed_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1575,6 +2441,9 @@ type Evaluation struct {
 
 	// The evaluation details.
 	Details *EvalDetails `json:"details,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Evaluation.Status property.
@@ -1586,6 +2455,129 @@ const (
 	Evaluation_Status_Skipped = "skipped"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Evaluation
+func (o *Evaluation) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Evaluation
+func (o *Evaluation) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Evaluation
+func (o *Evaluation) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Evaluation
+func (o *Evaluation) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Evaluation
+func (o *Evaluation) MarshalJSON() (buffer []byte, err error) {
+	type model Evaluation
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the Evaluation is nil
+func (o *Evaluation) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the Evaluation is nil
+func (o *Evaluation) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetControlID returns the value of ControlID, or the zero value when ControlID or the Evaluation is nil
+func (o *Evaluation) GetControlID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ControlID)
+}
+
+// GetComponentID returns the value of ComponentID, or the zero value when ComponentID or the Evaluation is nil
+func (o *Evaluation) GetComponentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ComponentID)
+}
+
+// GetAssessment returns Assessment, or nil when the Evaluation is nil
+func (o *Evaluation) GetAssessment() *Assessment {
+	if o == nil {
+		return nil
+	}
+	return o.Assessment
+}
+
+// GetEvaluateTime returns the value of EvaluateTime, or the zero value when EvaluateTime or the Evaluation is nil
+func (o *Evaluation) GetEvaluateTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.EvaluateTime)
+}
+
+// GetTarget returns Target, or nil when the Evaluation is nil
+func (o *Evaluation) GetTarget() *Target {
+	if o == nil {
+		return nil
+	}
+	return o.Target
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Evaluation is nil
+func (o *Evaluation) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetReason returns the value of Reason, or the zero value when Reason or the Evaluation is nil
+func (o *Evaluation) GetReason() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Reason)
+}
+
+// GetDetails returns Details, or nil when the Evaluation is nil
+func (o *Evaluation) GetDetails() *EvalDetails {
+	if o == nil {
+		return nil
+	}
+	return o.Details
+}
+
 // UnmarshalEvaluation unmarshals an instance of Evaluation from the specified map of raw messages.
 func UnmarshalEvaluation(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Evaluation)
@@ -1629,6 +2621,10 @@ func UnmarshalEvaluation(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "home_account_id", "report_id", "control_id", "component_id", "assessment", "evaluate_time", "target", "status", "reason", "details")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1658,6 +2654,116 @@ type EvaluationPage struct {
 
 	// The list of evaluations that are on the page.
 	Evaluations []Evaluation `json:"evaluations,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of EvaluationPage
+func (o *EvaluationPage) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of EvaluationPage
+func (o *EvaluationPage) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of EvaluationPage
+func (o *EvaluationPage) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of EvaluationPage
+func (o *EvaluationPage) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of EvaluationPage
+func (o *EvaluationPage) MarshalJSON() (buffer []byte, err error) {
+	type model EvaluationPage
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the EvaluationPage is nil
+func (o *EvaluationPage) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the EvaluationPage is nil
+func (o *EvaluationPage) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetStart returns the value of Start, or the zero value when Start or the EvaluationPage is nil
+func (o *EvaluationPage) GetStart() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Start)
+}
+
+// GetFirst returns First, or nil when the EvaluationPage is nil
+func (o *EvaluationPage) GetFirst() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetNext returns Next, or nil when the EvaluationPage is nil
+func (o *EvaluationPage) GetNext() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the EvaluationPage is nil
+func (o *EvaluationPage) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the EvaluationPage is nil
+func (o *EvaluationPage) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetEvaluations returns Evaluations, or nil when the EvaluationPage is nil
+func (o *EvaluationPage) GetEvaluations() []Evaluation {
+	if o == nil {
+		return nil
+	}
+	return o.Evaluations
 }
 
 // UnmarshalEvaluationPage unmarshals an instance of EvaluationPage from the specified map of raw messages.
@@ -1695,6 +2801,10 @@ func UnmarshalEvaluationPage(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "total_count", "limit", "start", "first", "next", "home_account_id", "report_id", "evaluations")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1765,6 +2875,92 @@ type GetLatestReportsResponse struct {
 
 	// The list of reports.
 	Reports []Report `json:"reports,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetLatestReportsResponse
+func (o *GetLatestReportsResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetLatestReportsResponse
+func (o *GetLatestReportsResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetLatestReportsResponse
+func (o *GetLatestReportsResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetLatestReportsResponse
+func (o *GetLatestReportsResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetLatestReportsResponse
+func (o *GetLatestReportsResponse) MarshalJSON() (buffer []byte, err error) {
+	type model GetLatestReportsResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the GetLatestReportsResponse is nil
+func (o *GetLatestReportsResponse) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetControlsSummary returns ControlsSummary, or nil when the GetLatestReportsResponse is nil
+func (o *GetLatestReportsResponse) GetControlsSummary() *ComplianceStats {
+	if o == nil {
+		return nil
+	}
+	return o.ControlsSummary
+}
+
+// GetEvaluationsSummary returns EvaluationsSummary, or nil when the GetLatestReportsResponse is nil
+func (o *GetLatestReportsResponse) GetEvaluationsSummary() *EvalStats {
+	if o == nil {
+		return nil
+	}
+	return o.EvaluationsSummary
+}
+
+// GetScore returns Score, or nil when the GetLatestReportsResponse is nil
+func (o *GetLatestReportsResponse) GetScore() *ComplianceScore {
+	if o == nil {
+		return nil
+	}
+	return o.Score
+}
+
+// GetReports returns Reports, or nil when the GetLatestReportsResponse is nil
+func (o *GetLatestReportsResponse) GetReports() []Report {
+	if o == nil {
+		return nil
+	}
+	return o.Reports
 }
 
 // UnmarshalGetLatestReportsResponse unmarshals an instance of GetLatestReportsResponse from the specified map of raw messages.
@@ -1790,6 +2986,10 @@ func UnmarshalGetLatestReportsResponse(m map[string]json.RawMessage, result inte
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "home_account_id", "controls_summary", "evaluations_summary", "score", "reports")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1801,6 +3001,68 @@ type GetProfilesResponse struct {
 
 	// The list of profile groups.
 	Profiles []Profile `json:"profiles,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetProfilesResponse
+func (o *GetProfilesResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetProfilesResponse
+func (o *GetProfilesResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetProfilesResponse
+func (o *GetProfilesResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetProfilesResponse
+func (o *GetProfilesResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetProfilesResponse
+func (o *GetProfilesResponse) MarshalJSON() (buffer []byte, err error) {
+	type model GetProfilesResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the GetProfilesResponse is nil
+func (o *GetProfilesResponse) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetProfiles returns Profiles, or nil when the GetProfilesResponse is nil
+func (o *GetProfilesResponse) GetProfiles() []Profile {
+	if o == nil {
+		return nil
+	}
+	return o.Profiles
 }
 
 // UnmarshalGetProfilesResponse unmarshals an instance of GetProfilesResponse from the specified map of raw messages.
@@ -1814,6 +3076,10 @@ func UnmarshalGetProfilesResponse(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "home_account_id", "profiles")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1949,6 +3215,9 @@ type GetReportControlsResponse struct {
 
 	// The list of controls that are in the report.
 	Controls []ControlWithStats `json:"controls,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the GetReportControlsResponse.Status property.
@@ -1960,6 +3229,121 @@ const (
 	GetReportControlsResponse_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetReportControlsResponse
+func (o *GetReportControlsResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetReportControlsResponse
+func (o *GetReportControlsResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetReportControlsResponse
+func (o *GetReportControlsResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetReportControlsResponse
+func (o *GetReportControlsResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetReportControlsResponse
+func (o *GetReportControlsResponse) MarshalJSON() (buffer []byte, err error) {
+	type model GetReportControlsResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetCompliantCount returns the value of CompliantCount, or the zero value when CompliantCount or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompliantCount)
+}
+
+// GetNotCompliantCount returns the value of NotCompliantCount, or the zero value when NotCompliantCount or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetNotCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotCompliantCount)
+}
+
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
+
+// GetUserEvaluationRequiredCount returns the value of UserEvaluationRequiredCount, or the zero value when UserEvaluationRequiredCount or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetUserEvaluationRequiredCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UserEvaluationRequiredCount)
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetControls returns Controls, or nil when the GetReportControlsResponse is nil
+func (o *GetReportControlsResponse) GetControls() []ControlWithStats {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
+}
+
 // UnmarshalGetReportControlsResponse unmarshals an instance of GetReportControlsResponse from the specified map of raw messages.
 func UnmarshalGetReportControlsResponse(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(GetReportControlsResponse)
@@ -1999,6 +3383,10 @@ func UnmarshalGetReportControlsResponse(m map[string]json.RawMessage, result int
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "status", "total_count", "compliant_count", "not_compliant_count", "unable_to_perform_count", "user_evaluation_required_count", "home_account_id", "report_id", "controls")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2275,6 +3663,76 @@ type GetReportViolationsDriftResult struct {
 
 	// The list of report violations data points.
 	DataPoints []ReportViolationDataPoint `json:"data_points,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetReportViolationsDriftResult
+func (o *GetReportViolationsDriftResult) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetReportViolationsDriftResult
+func (o *GetReportViolationsDriftResult) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetReportViolationsDriftResult
+func (o *GetReportViolationsDriftResult) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetReportViolationsDriftResult
+func (o *GetReportViolationsDriftResult) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetReportViolationsDriftResult
+func (o *GetReportViolationsDriftResult) MarshalJSON() (buffer []byte, err error) {
+	type model GetReportViolationsDriftResult
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the GetReportViolationsDriftResult is nil
+func (o *GetReportViolationsDriftResult) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the GetReportViolationsDriftResult is nil
+func (o *GetReportViolationsDriftResult) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetDataPoints returns DataPoints, or nil when the GetReportViolationsDriftResult is nil
+func (o *GetReportViolationsDriftResult) GetDataPoints() []ReportViolationDataPoint {
+	if o == nil {
+		return nil
+	}
+	return o.DataPoints
 }
 
 // UnmarshalGetReportViolationsDriftResult unmarshals an instance of GetReportViolationsDriftResult from the specified map of raw messages.
@@ -2292,6 +3750,10 @@ func UnmarshalGetReportViolationsDriftResult(m map[string]json.RawMessage, resul
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "home_account_id", "report_id", "data_points")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2368,6 +3830,68 @@ type GetScopesResponse struct {
 
 	// The list of scopes.
 	Scopes []Scope `json:"scopes,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetScopesResponse
+func (o *GetScopesResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetScopesResponse
+func (o *GetScopesResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetScopesResponse
+func (o *GetScopesResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetScopesResponse
+func (o *GetScopesResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetScopesResponse
+func (o *GetScopesResponse) MarshalJSON() (buffer []byte, err error) {
+	type model GetScopesResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the GetScopesResponse is nil
+func (o *GetScopesResponse) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetScopes returns Scopes, or nil when the GetScopesResponse is nil
+func (o *GetScopesResponse) GetScopes() []Scope {
+	if o == nil {
+		return nil
+	}
+	return o.Scopes
 }
 
 // UnmarshalGetScopesResponse unmarshals an instance of GetScopesResponse from the specified map of raw messages.
@@ -2381,6 +3905,10 @@ func UnmarshalGetScopesResponse(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "home_account_id", "scopes")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2392,6 +3920,68 @@ type GetTagsResponse struct {
 
 	// The collection of different types of tags.
 	Tags *Tags `json:"tags,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of GetTagsResponse
+func (o *GetTagsResponse) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of GetTagsResponse
+func (o *GetTagsResponse) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of GetTagsResponse
+func (o *GetTagsResponse) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of GetTagsResponse
+func (o *GetTagsResponse) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of GetTagsResponse
+func (o *GetTagsResponse) MarshalJSON() (buffer []byte, err error) {
+	type model GetTagsResponse
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the GetTagsResponse is nil
+func (o *GetTagsResponse) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetTags returns Tags, or nil when the GetTagsResponse is nil
+func (o *GetTagsResponse) GetTags() *Tags {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
 }
 
 // UnmarshalGetTagsResponse unmarshals an instance of GetTagsResponse from the specified map of raw messages.
@@ -2405,6 +3995,10 @@ func UnmarshalGetTagsResponse(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "report_id", "tags")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2743,6 +4337,60 @@ func (options *ListReportsOptions) SetHeaders(param map[string]string) *ListRepo
 type PageHRef struct {
 	// A URL for the first and next page.
 	Href *string `json:"href" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of PageHRef
+func (o *PageHRef) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of PageHRef
+func (o *PageHRef) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of PageHRef
+func (o *PageHRef) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of PageHRef
+func (o *PageHRef) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of PageHRef
+func (o *PageHRef) MarshalJSON() (buffer []byte, err error) {
+	type model PageHRef
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetHref returns the value of Href, or the zero value when Href or the PageHRef is nil
+func (o *PageHRef) GetHref() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Href)
 }
 
 // UnmarshalPageHRef unmarshals an instance of PageHRef from the specified map of raw messages.
@@ -2752,6 +4400,10 @@ func UnmarshalPageHRef(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "href")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2769,14 +4421,92 @@ type Parameter struct {
 
 	// A property's value.
 	ParameterValue interface{} `json:"parameter_value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalParameter unmarshals an instance of Parameter from the specified map of raw messages.
-func UnmarshalParameter(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(Parameter)
-	err = core.UnmarshalPrimitive(m, "parameter_name", &obj.ParameterName)
-	if err != nil {
-		return
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Parameter
+func (o *Parameter) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Parameter
+func (o *Parameter) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Parameter
+func (o *Parameter) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Parameter
+func (o *Parameter) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Parameter
+func (o *Parameter) MarshalJSON() (buffer []byte, err error) {
+	type model Parameter
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetParameterName returns the value of ParameterName, or the zero value when ParameterName or the Parameter is nil
+func (o *Parameter) GetParameterName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ParameterName)
+}
+
+// GetParameterDisplayName returns the value of ParameterDisplayName, or the zero value when ParameterDisplayName or the Parameter is nil
+func (o *Parameter) GetParameterDisplayName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ParameterDisplayName)
+}
+
+// GetParameterType returns the value of ParameterType, or the zero value when ParameterType or the Parameter is nil
+func (o *Parameter) GetParameterType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ParameterType)
+}
+
+// GetParameterValue returns ParameterValue, or nil when the Parameter is nil
+func (o *Parameter) GetParameterValue() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ParameterValue
+}
+
+// UnmarshalParameter unmarshals an instance of Parameter from the specified map of raw messages.
+func UnmarshalParameter(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Parameter)
+	err = core.UnmarshalPrimitive(m, "parameter_name", &obj.ParameterName)
+	if err != nil {
+		return
 	}
 	err = core.UnmarshalPrimitive(m, "parameter_display_name", &obj.ParameterDisplayName)
 	if err != nil {
@@ -2790,6 +4520,10 @@ func UnmarshalParameter(m map[string]json.RawMessage, result interface{}) (err e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "parameter_name", "parameter_display_name", "parameter_type", "parameter_value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2804,6 +4538,76 @@ type Profile struct {
 
 	// The profile version.
 	Version *string `json:"version,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Profile
+func (o *Profile) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Profile
+func (o *Profile) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Profile
+func (o *Profile) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Profile
+func (o *Profile) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Profile
+func (o *Profile) MarshalJSON() (buffer []byte, err error) {
+	type model Profile
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Profile is nil
+func (o *Profile) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetName returns the value of Name, or the zero value when Name or the Profile is nil
+func (o *Profile) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetVersion returns the value of Version, or the zero value when Version or the Profile is nil
+func (o *Profile) GetVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Version)
 }
 
 // UnmarshalProfile unmarshals an instance of Profile from the specified map of raw messages.
@@ -2821,6 +4625,10 @@ func UnmarshalProfile(m map[string]json.RawMessage, result interface{}) (err err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "name", "version")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2841,6 +4649,92 @@ type Property struct {
 
 	// A property's value.
 	FoundValue interface{} `json:"found_value,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Property
+func (o *Property) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Property
+func (o *Property) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Property
+func (o *Property) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Property
+func (o *Property) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Property
+func (o *Property) MarshalJSON() (buffer []byte, err error) {
+	type model Property
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetProperty returns the value of Property, or the zero value when Property or the Property is nil
+func (o *Property) GetProperty() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Property)
+}
+
+// GetPropertyDescription returns the value of PropertyDescription, or the zero value when PropertyDescription or the Property is nil
+func (o *Property) GetPropertyDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.PropertyDescription)
+}
+
+// GetOperator returns the value of Operator, or the zero value when Operator or the Property is nil
+func (o *Property) GetOperator() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Operator)
+}
+
+// GetExpectedValue returns ExpectedValue, or nil when the Property is nil
+func (o *Property) GetExpectedValue() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.ExpectedValue
+}
+
+// GetFoundValue returns FoundValue, or nil when the Property is nil
+func (o *Property) GetFoundValue() interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.FoundValue
 }
 
 // UnmarshalProperty unmarshals an instance of Property from the specified map of raw messages.
@@ -2866,6 +4760,10 @@ func UnmarshalProperty(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "property", "property_description", "operator", "expected_value", "found_value")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2904,6 +4802,140 @@ type Report struct {
 
 	// The attachment that is associated with a report.
 	Attachment *Attachment `json:"attachment,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Report
+func (o *Report) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Report
+func (o *Report) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Report
+func (o *Report) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Report
+func (o *Report) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Report
+func (o *Report) MarshalJSON() (buffer []byte, err error) {
+	type model Report
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Report is nil
+func (o *Report) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetGroupID returns the value of GroupID, or the zero value when GroupID or the Report is nil
+func (o *Report) GetGroupID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.GroupID)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Report is nil
+func (o *Report) GetCreatedAt() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetScanTime returns the value of ScanTime, or the zero value when ScanTime or the Report is nil
+func (o *Report) GetScanTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanTime)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Report is nil
+func (o *Report) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetCosObject returns the value of CosObject, or the zero value when CosObject or the Report is nil
+func (o *Report) GetCosObject() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CosObject)
+}
+
+// GetInstanceID returns the value of InstanceID, or the zero value when InstanceID or the Report is nil
+func (o *Report) GetInstanceID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.InstanceID)
+}
+
+// GetAccount returns Account, or nil when the Report is nil
+func (o *Report) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+// GetProfile returns Profile, or nil when the Report is nil
+func (o *Report) GetProfile() *Profile {
+	if o == nil {
+		return nil
+	}
+	return o.Profile
+}
+
+// GetScope returns Scope, or nil when the Report is nil
+func (o *Report) GetScope() *Scope {
+	if o == nil {
+		return nil
+	}
+	return o.Scope
+}
+
+// GetAttachment returns Attachment, or nil when the Report is nil
+func (o *Report) GetAttachment() *Attachment {
+	if o == nil {
+		return nil
+	}
+	return o.Attachment
 }
 
 // UnmarshalReport unmarshals an instance of Report from the specified map of raw messages.
@@ -2953,6 +4985,10 @@ func UnmarshalReport(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "group_id", "created_at", "scan_time", "type", "cos_object", "instance_id", "account", "profile", "scope", "attachment")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2979,6 +5015,108 @@ type ReportPage struct {
 
 	// The list of reports that are on the page.
 	Reports []Report `json:"reports,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ReportPage
+func (o *ReportPage) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ReportPage
+func (o *ReportPage) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ReportPage
+func (o *ReportPage) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ReportPage
+func (o *ReportPage) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ReportPage
+func (o *ReportPage) MarshalJSON() (buffer []byte, err error) {
+	type model ReportPage
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ReportPage is nil
+func (o *ReportPage) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ReportPage is nil
+func (o *ReportPage) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetStart returns the value of Start, or the zero value when Start or the ReportPage is nil
+func (o *ReportPage) GetStart() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Start)
+}
+
+// GetFirst returns First, or nil when the ReportPage is nil
+func (o *ReportPage) GetFirst() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetNext returns Next, or nil when the ReportPage is nil
+func (o *ReportPage) GetNext() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the ReportPage is nil
+func (o *ReportPage) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReports returns Reports, or nil when the ReportPage is nil
+func (o *ReportPage) GetReports() []Report {
+	if o == nil {
+		return nil
+	}
+	return o.Reports
 }
 
 // UnmarshalReportPage unmarshals an instance of ReportPage from the specified map of raw messages.
@@ -3012,6 +5150,10 @@ func UnmarshalReportPage(m map[string]json.RawMessage, result interface{}) (err
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "total_count", "limit", "start", "first", "next", "home_account_id", "reports")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3050,6 +5192,108 @@ type ReportSummary struct {
 
 	// The resource summary.
 	Resources *ResourceSummary `json:"resources,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ReportSummary
+func (o *ReportSummary) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ReportSummary
+func (o *ReportSummary) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ReportSummary
+func (o *ReportSummary) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ReportSummary
+func (o *ReportSummary) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ReportSummary
+func (o *ReportSummary) MarshalJSON() (buffer []byte, err error) {
+	type model ReportSummary
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the ReportSummary is nil
+func (o *ReportSummary) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetIsntanceID returns the value of IsntanceID, or the zero value when IsntanceID or the ReportSummary is nil
+func (o *ReportSummary) GetIsntanceID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.IsntanceID)
+}
+
+// GetAccount returns Account, or nil when the ReportSummary is nil
+func (o *ReportSummary) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+// GetScore returns Score, or nil when the ReportSummary is nil
+func (o *ReportSummary) GetScore() *ComplianceScore {
+	if o == nil {
+		return nil
+	}
+	return o.Score
+}
+
+// GetControls returns Controls, or nil when the ReportSummary is nil
+func (o *ReportSummary) GetControls() *ComplianceStats {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
+}
+
+// GetEvaluations returns Evaluations, or nil when the ReportSummary is nil
+func (o *ReportSummary) GetEvaluations() *EvalStats {
+	if o == nil {
+		return nil
+	}
+	return o.Evaluations
+}
+
+// GetResources returns Resources, or nil when the ReportSummary is nil
+func (o *ReportSummary) GetResources() *ResourceSummary {
+	if o == nil {
+		return nil
+	}
+	return o.Resources
 }
 
 // UnmarshalReportSummary unmarshals an instance of ReportSummary from the specified map of raw messages.
@@ -3083,6 +5327,10 @@ func UnmarshalReportSummary(m map[string]json.RawMessage, result interface{}) (e
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "report_id", "isntance_id", "account", "score", "controls", "evaluations", "resources")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3100,6 +5348,84 @@ type ReportViolationDataPoint struct {
 
 	// The compliance stats.
 	Controls *ComplianceStats `json:"controls,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ReportViolationDataPoint
+func (o *ReportViolationDataPoint) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ReportViolationDataPoint
+func (o *ReportViolationDataPoint) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ReportViolationDataPoint
+func (o *ReportViolationDataPoint) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ReportViolationDataPoint
+func (o *ReportViolationDataPoint) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ReportViolationDataPoint
+func (o *ReportViolationDataPoint) MarshalJSON() (buffer []byte, err error) {
+	type model ReportViolationDataPoint
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the ReportViolationDataPoint is nil
+func (o *ReportViolationDataPoint) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetReportGroupID returns the value of ReportGroupID, or the zero value when ReportGroupID or the ReportViolationDataPoint is nil
+func (o *ReportViolationDataPoint) GetReportGroupID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportGroupID)
+}
+
+// GetScanTime returns the value of ScanTime, or the zero value when ScanTime or the ReportViolationDataPoint is nil
+func (o *ReportViolationDataPoint) GetScanTime() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ScanTime)
+}
+
+// GetControls returns Controls, or nil when the ReportViolationDataPoint is nil
+func (o *ReportViolationDataPoint) GetControls() *ComplianceStats {
+	if o == nil {
+		return nil
+	}
+	return o.Controls
 }
 
 // UnmarshalReportViolationDataPoint unmarshals an instance of ReportViolationDataPoint from the specified map of raw messages.
@@ -3121,6 +5447,10 @@ func UnmarshalReportViolationDataPoint(m map[string]json.RawMessage, result inte
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "report_id", "report_group_id", "scan_time", "controls")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3162,6 +5492,9 @@ type Resource struct {
 
 	// The total number of completed evaluations.
 	CompletedCount *int64 `json:"completed_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the Resource.Status property.
@@ -3173,6 +5506,145 @@ const (
 	Resource_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Resource
+func (o *Resource) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Resource
+func (o *Resource) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Resource
+func (o *Resource) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Resource
+func (o *Resource) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Resource
+func (o *Resource) MarshalJSON() (buffer []byte, err error) {
+	type model Resource
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the Resource is nil
+func (o *Resource) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Resource is nil
+func (o *Resource) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetResourceName returns the value of ResourceName, or the zero value when ResourceName or the Resource is nil
+func (o *Resource) GetResourceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceName)
+}
+
+// GetComponentID returns the value of ComponentID, or the zero value when ComponentID or the Resource is nil
+func (o *Resource) GetComponentID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ComponentID)
+}
+
+// GetEnvironment returns the value of Environment, or the zero value when Environment or the Resource is nil
+func (o *Resource) GetEnvironment() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Environment)
+}
+
+// GetAccount returns Account, or nil when the Resource is nil
+func (o *Resource) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+	return o.Account
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the Resource is nil
+func (o *Resource) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the Resource is nil
+func (o *Resource) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetPassCount returns the value of PassCount, or the zero value when PassCount or the Resource is nil
+func (o *Resource) GetPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.PassCount)
+}
+
+// GetFailureCount returns the value of FailureCount, or the zero value when FailureCount or the Resource is nil
+func (o *Resource) GetFailureCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.FailureCount)
+}
+
+// GetErrorCount returns the value of ErrorCount, or the zero value when ErrorCount or the Resource is nil
+func (o *Resource) GetErrorCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ErrorCount)
+}
+
+// GetCompletedCount returns the value of CompletedCount, or the zero value when CompletedCount or the Resource is nil
+func (o *Resource) GetCompletedCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompletedCount)
+}
+
 // UnmarshalResource unmarshals an instance of Resource from the specified map of raw messages.
 func UnmarshalResource(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(Resource)
@@ -3224,6 +5696,10 @@ func UnmarshalResource(m map[string]json.RawMessage, result interface{}) (err er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "report_id", "id", "resource_name", "component_id", "environment", "account", "status", "total_count", "pass_count", "failure_count", "error_count", "completed_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3253,6 +5729,116 @@ type ResourcePage struct {
 
 	// The list of resource evaluation summaries that are on the page.
 	Resources []Resource `json:"resources,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourcePage
+func (o *ResourcePage) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourcePage
+func (o *ResourcePage) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourcePage
+func (o *ResourcePage) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourcePage
+func (o *ResourcePage) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourcePage
+func (o *ResourcePage) MarshalJSON() (buffer []byte, err error) {
+	type model ResourcePage
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ResourcePage is nil
+func (o *ResourcePage) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetLimit returns the value of Limit, or the zero value when Limit or the ResourcePage is nil
+func (o *ResourcePage) GetLimit() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.Limit)
+}
+
+// GetStart returns the value of Start, or the zero value when Start or the ResourcePage is nil
+func (o *ResourcePage) GetStart() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Start)
+}
+
+// GetFirst returns First, or nil when the ResourcePage is nil
+func (o *ResourcePage) GetFirst() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.First
+}
+
+// GetNext returns Next, or nil when the ResourcePage is nil
+func (o *ResourcePage) GetNext() *PageHRef {
+	if o == nil {
+		return nil
+	}
+	return o.Next
+}
+
+// GetHomeAccountID returns the value of HomeAccountID, or the zero value when HomeAccountID or the ResourcePage is nil
+func (o *ResourcePage) GetHomeAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.HomeAccountID)
+}
+
+// GetReportID returns the value of ReportID, or the zero value when ReportID or the ResourcePage is nil
+func (o *ResourcePage) GetReportID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ReportID)
+}
+
+// GetResources returns Resources, or nil when the ResourcePage is nil
+func (o *ResourcePage) GetResources() []Resource {
+	if o == nil {
+		return nil
+	}
+	return o.Resources
 }
 
 // UnmarshalResourcePage unmarshals an instance of ResourcePage from the specified map of raw messages.
@@ -3290,6 +5876,10 @@ func UnmarshalResourcePage(m map[string]json.RawMessage, result interface{}) (er
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "total_count", "limit", "start", "first", "next", "home_account_id", "report_id", "resources")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3311,33 +5901,135 @@ type ResourceSummary struct {
 	// The allowed values of an aggregated status for controls, specifications, assessments, and resources.
 	Status *string `json:"status,omitempty"`
 
-	// The total number of checks.
-	TotalCount *int64 `json:"total_count,omitempty"`
+	// The total number of checks.
+	TotalCount *int64 `json:"total_count,omitempty"`
+
+	// The number of compliant checks.
+	CompliantCount *int64 `json:"compliant_count,omitempty"`
+
+	// The number of checks that are not compliant.
+	NotCompliantCount *int64 `json:"not_compliant_count,omitempty"`
+
+	// The number of checks that are unable to perform.
+	UnableToPerformCount *int64 `json:"unable_to_perform_count,omitempty"`
+
+	// The number of checks that require a user evaluation.
+	UserEvaluationRequiredCount *int64 `json:"user_evaluation_required_count,omitempty"`
+
+	// The top 10 resources that have the most failures.
+	TopFailed []ResourceSummaryItem `json:"top_failed,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// Constants associated with the ResourceSummary.Status property.
+// The allowed values of an aggregated status for controls, specifications, assessments, and resources.
+const (
+	ResourceSummary_Status_Compliant              = "compliant"
+	ResourceSummary_Status_NotCompliant           = "not_compliant"
+	ResourceSummary_Status_UnableToPerform        = "unable_to_perform"
+	ResourceSummary_Status_UserEvaluationRequired = "user_evaluation_required"
+)
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceSummary
+func (o *ResourceSummary) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceSummary
+func (o *ResourceSummary) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceSummary
+func (o *ResourceSummary) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceSummary
+func (o *ResourceSummary) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceSummary
+func (o *ResourceSummary) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceSummary
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ResourceSummary is nil
+func (o *ResourceSummary) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
 
-	// The number of compliant checks.
-	CompliantCount *int64 `json:"compliant_count,omitempty"`
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ResourceSummary is nil
+func (o *ResourceSummary) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
 
-	// The number of checks that are not compliant.
-	NotCompliantCount *int64 `json:"not_compliant_count,omitempty"`
+// GetCompliantCount returns the value of CompliantCount, or the zero value when CompliantCount or the ResourceSummary is nil
+func (o *ResourceSummary) GetCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompliantCount)
+}
 
-	// The number of checks that are unable to perform.
-	UnableToPerformCount *int64 `json:"unable_to_perform_count,omitempty"`
+// GetNotCompliantCount returns the value of NotCompliantCount, or the zero value when NotCompliantCount or the ResourceSummary is nil
+func (o *ResourceSummary) GetNotCompliantCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.NotCompliantCount)
+}
 
-	// The number of checks that require a user evaluation.
-	UserEvaluationRequiredCount *int64 `json:"user_evaluation_required_count,omitempty"`
+// GetUnableToPerformCount returns the value of UnableToPerformCount, or the zero value when UnableToPerformCount or the ResourceSummary is nil
+func (o *ResourceSummary) GetUnableToPerformCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UnableToPerformCount)
+}
 
-	// The top 10 resources that have the most failures.
-	TopFailed []ResourceSummaryItem `json:"top_failed,omitempty"`
+// GetUserEvaluationRequiredCount returns the value of UserEvaluationRequiredCount, or the zero value when UserEvaluationRequiredCount or the ResourceSummary is nil
+func (o *ResourceSummary) GetUserEvaluationRequiredCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.UserEvaluationRequiredCount)
 }
 
-// Constants associated with the ResourceSummary.Status property.
-// The allowed values of an aggregated status for controls, specifications, assessments, and resources.
-const (
-	ResourceSummary_Status_Compliant              = "compliant"
-	ResourceSummary_Status_NotCompliant           = "not_compliant"
-	ResourceSummary_Status_UnableToPerform        = "unable_to_perform"
-	ResourceSummary_Status_UserEvaluationRequired = "user_evaluation_required"
-)
+// GetTopFailed returns TopFailed, or nil when the ResourceSummary is nil
+func (o *ResourceSummary) GetTopFailed() []ResourceSummaryItem {
+	if o == nil {
+		return nil
+	}
+	return o.TopFailed
+}
 
 // UnmarshalResourceSummary unmarshals an instance of ResourceSummary from the specified map of raw messages.
 func UnmarshalResourceSummary(m map[string]json.RawMessage, result interface{}) (err error) {
@@ -3370,6 +6062,10 @@ func UnmarshalResourceSummary(m map[string]json.RawMessage, result interface{})
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "status", "total_count", "compliant_count", "not_compliant_count", "unable_to_perform_count", "user_evaluation_required_count", "top_failed")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3408,6 +6104,9 @@ type ResourceSummaryItem struct {
 
 	// The total number of completed evaluations.
 	CompletedCount *int64 `json:"completed_count,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ResourceSummaryItem.Status property.
@@ -3419,6 +6118,137 @@ const (
 	ResourceSummaryItem_Status_UserEvaluationRequired = "user_evaluation_required"
 )
 
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of ResourceSummaryItem
+func (o *ResourceSummaryItem) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of ResourceSummaryItem
+func (o *ResourceSummaryItem) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of ResourceSummaryItem
+func (o *ResourceSummaryItem) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of ResourceSummaryItem
+func (o *ResourceSummaryItem) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ResourceSummaryItem
+func (o *ResourceSummaryItem) MarshalJSON() (buffer []byte, err error) {
+	type model ResourceSummaryItem
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetName returns the value of Name, or the zero value when Name or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Name)
+}
+
+// GetID returns the value of ID, or the zero value when ID or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetService returns the value of Service, or the zero value when Service or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetService() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Service)
+}
+
+// GetTags returns Tags, or nil when the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetTags() *Tags {
+	if o == nil {
+		return nil
+	}
+	return o.Tags
+}
+
+// GetAccount returns the value of Account, or the zero value when Account or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetAccount() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Account)
+}
+
+// GetStatus returns the value of Status, or the zero value when Status or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetStatus() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Status)
+}
+
+// GetTotalCount returns the value of TotalCount, or the zero value when TotalCount or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetTotalCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.TotalCount)
+}
+
+// GetPassCount returns the value of PassCount, or the zero value when PassCount or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetPassCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.PassCount)
+}
+
+// GetFailureCount returns the value of FailureCount, or the zero value when FailureCount or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetFailureCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.FailureCount)
+}
+
+// GetErrorCount returns the value of ErrorCount, or the zero value when ErrorCount or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetErrorCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.ErrorCount)
+}
+
+// GetCompletedCount returns the value of CompletedCount, or the zero value when CompletedCount or the ResourceSummaryItem is nil
+func (o *ResourceSummaryItem) GetCompletedCount() int64 {
+	if o == nil {
+		return 0
+	}
+	return common.Deref(o.CompletedCount)
+}
+
 // UnmarshalResourceSummaryItem unmarshals an instance of ResourceSummaryItem from the specified map of raw messages.
 func UnmarshalResourceSummaryItem(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ResourceSummaryItem)
@@ -3466,6 +6296,10 @@ func UnmarshalResourceSummaryItem(m map[string]json.RawMessage, result interface
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "name", "id", "service", "tags", "account", "status", "total_count", "pass_count", "failure_count", "error_count", "completed_count")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3501,6 +6335,132 @@ type Rule struct {
 
 	// The rule labels.
 	Labels []string `json:"labels,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Rule
+func (o *Rule) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Rule
+func (o *Rule) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Rule
+func (o *Rule) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Rule
+func (o *Rule) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Rule
+func (o *Rule) MarshalJSON() (buffer []byte, err error) {
+	type model Rule
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Rule is nil
+func (o *Rule) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Rule is nil
+func (o *Rule) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
+}
+
+// GetDescription returns the value of Description, or the zero value when Description or the Rule is nil
+func (o *Rule) GetDescription() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Description)
+}
+
+// GetVersion returns the value of Version, or the zero value when Version or the Rule is nil
+func (o *Rule) GetVersion() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Version)
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the Rule is nil
+func (o *Rule) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetCreatedAt returns the value of CreatedAt, or the zero value when CreatedAt or the Rule is nil
+func (o *Rule) GetCreatedAt() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedAt)
+}
+
+// GetCreatedBy returns the value of CreatedBy, or the zero value when CreatedBy or the Rule is nil
+func (o *Rule) GetCreatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.CreatedBy)
+}
+
+// GetUpdatedAt returns the value of UpdatedAt, or the zero value when UpdatedAt or the Rule is nil
+func (o *Rule) GetUpdatedAt() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedAt)
+}
+
+// GetUpdatedBy returns the value of UpdatedBy, or the zero value when UpdatedBy or the Rule is nil
+func (o *Rule) GetUpdatedBy() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.UpdatedBy)
+}
+
+// GetLabels returns Labels, or nil when the Rule is nil
+func (o *Rule) GetLabels() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Labels
 }
 
 // UnmarshalRule unmarshals an instance of Rule from the specified map of raw messages.
@@ -3546,6 +6506,10 @@ func UnmarshalRule(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "type", "description", "version", "account_id", "created_at", "created_by", "updated_at", "updated_by", "labels")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3557,6 +6521,68 @@ type Scope struct {
 
 	// The scope type.
 	Type *string `json:"type,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Scope
+func (o *Scope) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Scope
+func (o *Scope) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Scope
+func (o *Scope) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Scope
+func (o *Scope) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Scope
+func (o *Scope) MarshalJSON() (buffer []byte, err error) {
+	type model Scope
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Scope is nil
+func (o *Scope) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetType returns the value of Type, or the zero value when Type or the Scope is nil
+func (o *Scope) GetType() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.Type)
 }
 
 // UnmarshalScope unmarshals an instance of Scope from the specified map of raw messages.
@@ -3570,6 +6596,10 @@ func UnmarshalScope(m map[string]json.RawMessage, result interface{}) (err error
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "type")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3584,6 +6614,76 @@ type Tags struct {
 
 	// The collection of service tags.
 	Service []string `json:"service,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Tags
+func (o *Tags) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Tags
+func (o *Tags) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Tags
+func (o *Tags) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Tags
+func (o *Tags) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Tags
+func (o *Tags) MarshalJSON() (buffer []byte, err error) {
+	type model Tags
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetUser returns User, or nil when the Tags is nil
+func (o *Tags) GetUser() []string {
+	if o == nil {
+		return nil
+	}
+	return o.User
+}
+
+// GetAccess returns Access, or nil when the Tags is nil
+func (o *Tags) GetAccess() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Access
+}
+
+// GetService returns Service, or nil when the Tags is nil
+func (o *Tags) GetService() []string {
+	if o == nil {
+		return nil
+	}
+	return o.Service
 }
 
 // UnmarshalTags unmarshals an instance of Tags from the specified map of raw messages.
@@ -3601,6 +6701,10 @@ func UnmarshalTags(m map[string]json.RawMessage, result interface{}) (err error)
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "user", "access", "service")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3621,6 +6725,92 @@ type Target struct {
 
 	// The target service name.
 	ServiceName *string `json:"service_name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetAdditionalProperty allows the user to set an arbitrary property on an instance of Target
+func (o *Target) SetAdditionalProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetAdditionalProperties allows the user to set a map of arbitrary properties on an instance of Target
+func (o *Target) SetAdditionalProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetAdditionalProperty allows the user to retrieve an arbitrary property from an instance of Target
+func (o *Target) GetAdditionalProperty(key string) interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties[key]
+}
+
+// GetAdditionalProperties allows the user to retrieve the map of arbitrary properties from an instance of Target
+func (o *Target) GetAdditionalProperties() map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Target
+func (o *Target) MarshalJSON() (buffer []byte, err error) {
+	type model Target
+	buffer, err = json.Marshal((*model)(o))
+	if err != nil {
+		return
+	}
+	buffer, err = common.MarshalAdditionalProperties(buffer, o.additionalProperties)
+	return
+}
+
+// GetID returns the value of ID, or the zero value when ID or the Target is nil
+func (o *Target) GetID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ID)
+}
+
+// GetAccountID returns the value of AccountID, or the zero value when AccountID or the Target is nil
+func (o *Target) GetAccountID() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.AccountID)
+}
+
+// GetResourceCrn returns the value of ResourceCrn, or the zero value when ResourceCrn or the Target is nil
+func (o *Target) GetResourceCrn() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceCrn)
+}
+
+// GetResourceName returns the value of ResourceName, or the zero value when ResourceName or the Target is nil
+func (o *Target) GetResourceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ResourceName)
+}
+
+// GetServiceName returns the value of ServiceName, or the zero value when ServiceName or the Target is nil
+func (o *Target) GetServiceName() string {
+	if o == nil {
+		return ""
+	}
+	return common.Deref(o.ServiceName)
 }
 
 // UnmarshalTarget unmarshals an instance of Target from the specified map of raw messages.
@@ -3646,6 +6836,10 @@ func UnmarshalTarget(m map[string]json.RawMessage, result interface{}) (err erro
 	if err != nil {
 		return
 	}
+	obj.additionalProperties, err = common.UnmarshalAdditionalProperties(m, "id", "account_id", "resource_crn", "resource_name", "service_name")
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -0,0 +1,400 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultRuleEnrichmentConcurrency is the number of rules that EnrichReportEvaluations fetches at the same time by
+// default.
+const DefaultRuleEnrichmentConcurrency = 4
+
+// RuleCache : Rules by report ID and rule ID. The rules of a report do not change once it is created, so entries do
+// not expire. Implementations must be safe for concurrent use.
+type RuleCache interface {
+	// Get returns the cached rule, or false when the rule is not cached.
+	Get(reportID string, ruleID string) (rule *Rule, ok bool)
+
+	// Put caches a rule.
+	Put(reportID string, ruleID string, rule *Rule) error
+}
+
+// MemoryRuleCache : A RuleCache that keeps the rules in memory.
+type MemoryRuleCache struct {
+	mutex sync.Mutex
+	rules map[[2]string]*Rule
+}
+
+// NewMemoryRuleCache : Instantiate MemoryRuleCache
+func NewMemoryRuleCache() *MemoryRuleCache {
+	return &MemoryRuleCache{
+		rules: make(map[[2]string]*Rule),
+	}
+}
+
+// Get returns the cached rule.
+func (cache *MemoryRuleCache) Get(reportID string, ruleID string) (rule *Rule, ok bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	rule, ok = cache.rules[[2]string{reportID, ruleID}]
+	return
+}
+
+// Put caches a rule.
+func (cache *MemoryRuleCache) Put(reportID string, ruleID string, rule *Rule) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.rules == nil {
+		cache.rules = make(map[[2]string]*Rule)
+	}
+	cache.rules[[2]string{reportID, ruleID}] = rule
+	return nil
+}
+
+// Len returns the number of cached rules.
+func (cache *MemoryRuleCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return len(cache.rules)
+}
+
+// DiskRuleCache : A RuleCache that keeps every rule in a JSON file, at <dir>/<report ID>/<rule ID>.json, so that the
+// rules survive between runs. A file that cannot be read is treated as not cached.
+type DiskRuleCache struct {
+	dir string
+}
+
+// NewDiskRuleCache : Instantiate DiskRuleCache, creating the directory when it does not exist
+func NewDiskRuleCache(dir string) (*DiskRuleCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskRuleCache{dir: dir}, nil
+}
+
+// path returns the file of a rule. The IDs are escaped so that they cannot contain a separator, and the report IDs
+// "", "." and "..", which would name the cache directory or its parent, are rejected.
+func (cache *DiskRuleCache) path(reportID string, ruleID string) (string, error) {
+	if reportID == "" || reportID == "." || reportID == ".." {
+		return "", fmt.Errorf("report ID %q cannot be cached on disk", reportID)
+	}
+	return filepath.Join(cache.dir, url.PathEscape(reportID), url.PathEscape(ruleID)+".json"), nil
+}
+
+// Get returns the cached rule.
+func (cache *DiskRuleCache) Get(reportID string, ruleID string) (rule *Rule, ok bool) {
+	path, err := cache.path(reportID, ruleID)
+	if err != nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var rawResponse map[string]json.RawMessage
+	if err = json.Unmarshal(data, &rawResponse); err != nil {
+		return nil, false
+	}
+	if err = core.UnmarshalModel(rawResponse, "", &rule, UnmarshalRule); err != nil {
+		return nil, false
+	}
+	return rule, true
+}
+
+// Put caches a rule. The file is written to a temporary file first so that a reader never sees a partial rule.
+func (cache *DiskRuleCache) Put(reportID string, ruleID string, rule *Rule) error {
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	path, err := cache.path(reportID, ruleID)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), ".rule-*")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+	}
+	return err
+}
+
+// EnrichReportEvaluationsOptions : The EnrichReportEvaluations options.
+type EnrichReportEvaluationsOptions struct {
+	// The ID of the scan that is associated with a report.
+	ReportID *string `validate:"required,ne="`
+
+	// The evaluations to enrich. When nil, every evaluation of the report is listed and enriched.
+	Evaluations []Evaluation
+
+	// The number of rules that are fetched at the same time. Defaults to DefaultRuleEnrichmentConcurrency.
+	Concurrency int
+
+	// Caches the rules between calls. Rules are only deduplicated within the call when it is nil.
+	Cache RuleCache
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewEnrichReportEvaluationsOptions : Instantiate EnrichReportEvaluationsOptions
+func (*ResultsV3) NewEnrichReportEvaluationsOptions(reportID string) *EnrichReportEvaluationsOptions {
+	return &EnrichReportEvaluationsOptions{
+		ReportID: core.StringPtr(reportID),
+	}
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *EnrichReportEvaluationsOptions) SetReportID(reportID string) *EnrichReportEvaluationsOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetEvaluations : Allow user to set Evaluations
+func (_options *EnrichReportEvaluationsOptions) SetEvaluations(evaluations []Evaluation) *EnrichReportEvaluationsOptions {
+	_options.Evaluations = evaluations
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *EnrichReportEvaluationsOptions) SetConcurrency(concurrency int) *EnrichReportEvaluationsOptions {
+	_options.Concurrency = concurrency
+	return _options
+}
+
+// SetCache : Allow user to set Cache
+func (_options *EnrichReportEvaluationsOptions) SetCache(cache RuleCache) *EnrichReportEvaluationsOptions {
+	_options.Cache = cache
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *EnrichReportEvaluationsOptions) SetXCorrelationID(xCorrelationID string) *EnrichReportEvaluationsOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *EnrichReportEvaluationsOptions) SetHeaders(param map[string]string) *EnrichReportEvaluationsOptions {
+	options.Headers = param
+	return options
+}
+
+// EnrichedEvaluation : An evaluation and the rule of its assessment.
+type EnrichedEvaluation struct {
+	Evaluation *Evaluation `json:"evaluation"`
+
+	// The rule of the assessment, or nil when the assessment is not a rule of the report.
+	Rule *Rule `json:"rule,omitempty"`
+}
+
+// EnrichedEvaluations : The evaluations of a report with their rules.
+type EnrichedEvaluations struct {
+	// The evaluations, in the order in which they were given or listed.
+	Evaluations []EnrichedEvaluation `json:"evaluations"`
+
+	// The rules by rule ID.
+	Rules map[string]*Rule `json:"rules"`
+
+	// The number of rules that were fetched with GetReportRule.
+	FetchedCount int `json:"fetched_count"`
+
+	// The number of rules that were found in the cache.
+	CachedCount int `json:"cached_count"`
+}
+
+// EnrichReportEvaluations : Enrich evaluations with their rules
+// Resolve the rule of the assessment of every evaluation of a report. Every rule is fetched once with GetReportRule,
+// at most Concurrency at a time, unless it is in the cache. An assessment whose rule is not found is left without a
+// rule.
+func (results *ResultsV3) EnrichReportEvaluations(enrichReportEvaluationsOptions *EnrichReportEvaluationsOptions) (result *EnrichedEvaluations, err error) {
+	return results.EnrichReportEvaluationsWithContext(context.Background(), enrichReportEvaluationsOptions)
+}
+
+// EnrichReportEvaluationsWithContext is an alternate form of the EnrichReportEvaluations method which supports a Context parameter
+func (results *ResultsV3) EnrichReportEvaluationsWithContext(ctx context.Context, enrichReportEvaluationsOptions *EnrichReportEvaluationsOptions) (result *EnrichedEvaluations, err error) {
+	err = core.ValidateNotNil(enrichReportEvaluationsOptions, "enrichReportEvaluationsOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(enrichReportEvaluationsOptions, "enrichReportEvaluationsOptions")
+	if err != nil {
+		return
+	}
+	options := enrichReportEvaluationsOptions
+	reportID := *options.ReportID
+
+	evaluations := options.Evaluations
+	if evaluations == nil {
+		var pager *ReportEvaluationsPager
+		pager, err = results.NewReportEvaluationsPager(&ListReportEvaluationsOptions{
+			ReportID:       options.ReportID,
+			XCorrelationID: options.XCorrelationID,
+			Headers:        options.Headers,
+		})
+		if err != nil {
+			return
+		}
+		evaluations, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			return
+		}
+	}
+
+	result = &EnrichedEvaluations{
+		Rules: make(map[string]*Rule),
+	}
+	var missing []string
+	for i := range evaluations {
+		ruleID := evaluations[i].GetAssessment().GetAssessmentID()
+		if ruleID == "" {
+			continue
+		}
+		if _, ok := result.Rules[ruleID]; ok {
+			continue
+		}
+		var rule *Rule
+		if options.Cache != nil {
+			if cached, ok := options.Cache.Get(reportID, ruleID); ok {
+				rule = cached
+				result.CachedCount++
+			}
+		}
+		result.Rules[ruleID] = rule
+		if rule == nil {
+			missing = append(missing, ruleID)
+		}
+	}
+	sort.Strings(missing)
+
+	fetched, err := results.fetchRules(ctx, options, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, ruleID := range missing {
+		rule := fetched[ruleID]
+		if rule == nil {
+			delete(result.Rules, ruleID)
+			continue
+		}
+		result.Rules[ruleID] = rule
+		result.FetchedCount++
+		if options.Cache != nil {
+			if err = options.Cache.Put(reportID, ruleID, rule); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result.Evaluations = make([]EnrichedEvaluation, len(evaluations))
+	for i := range evaluations {
+		result.Evaluations[i] = EnrichedEvaluation{
+			Evaluation: &evaluations[i],
+			Rule:       result.Rules[evaluations[i].GetAssessment().GetAssessmentID()],
+		}
+	}
+	return
+}
+
+// fetchRules gets the rules with at most options.Concurrency requests at a time. A rule that is not found is left out
+// of the result. The first error stops the remaining requests and is returned.
+func (results *ResultsV3) fetchRules(ctx context.Context, options *EnrichReportEvaluationsOptions, ruleIDs []string) (rules map[string]*Rule, err error) {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRuleEnrichmentConcurrency
+	}
+	if concurrency > len(ruleIDs) {
+		concurrency = len(ruleIDs)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	rules = make(map[string]*Rule, len(ruleIDs))
+	queue := make(chan string)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ruleID := range queue {
+				rule, response, getErr := results.GetReportRuleWithContext(ctx, &GetReportRuleOptions{
+					ReportID:       options.ReportID,
+					RuleID:         core.StringPtr(ruleID),
+					XCorrelationID: options.XCorrelationID,
+					Headers:        options.Headers,
+				})
+				mutex.Lock()
+				switch {
+				case getErr == nil:
+					rules[ruleID] = rule
+				case response != nil && response.StatusCode == http.StatusNotFound:
+					// The assessment is not a rule, for example a manual assessment.
+				case err == nil:
+					err = getErr
+					cancel()
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+send:
+	for _, ruleID := range ruleIDs {
+		select {
+		case queue <- ruleID:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(queue)
+	wg.Wait()
+	if err == nil && ctx.Err() != nil {
+		// The parent context ended.
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 rule enrichment`, func() {
+	var testServer *httptest.Server
+	var resultsService *resultsv3.ResultsV3
+	var mutex sync.Mutex
+	var ruleRequests map[string]int
+	var inFlight, maxInFlight int
+
+	BeforeEach(func() {
+		ruleRequests = make(map[string]int)
+		inFlight, maxInFlight = 0, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			path := req.URL.EscapedPath()
+			res.Header().Set("Content-type", "application/json")
+			if path == "/reports/r1/evaluations" {
+				var evaluations []string
+				for i := 0; i < 12; i++ {
+					evaluations = append(evaluations, fmt.Sprintf(`{"control_id": "c1", "status": "failure", "assessment": {"assessment_id": "rule-%d"}}`, i%6))
+				}
+				evaluations = append(evaluations, `{"control_id": "c2", "status": "pass", "assessment": {"assessment_id": "manual-1"}}`, `{"control_id": "c3", "status": "pass"}`)
+				fmt.Fprintf(res, `{"evaluations": [%s]}`, strings.Join(evaluations, ","))
+				return
+			}
+			ruleID := strings.TrimPrefix(path, "/reports/r1/rules/")
+			mutex.Lock()
+			ruleRequests[ruleID]++
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mutex.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mutex.Lock()
+			inFlight--
+			mutex.Unlock()
+			switch {
+			case strings.HasPrefix(ruleID, "rule-"):
+				fmt.Fprintf(res, `{"id": "%s", "type": "system_defined", "description": "Check %s", "version": "1.0.0", "labels": ["cos"]}`, ruleID, ruleID)
+			case ruleID == "broken":
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"message": "internal error"}]}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Fetches every rule once with bounded concurrency`, func() {
+		cache := resultsv3.NewMemoryRuleCache()
+		options := resultsService.NewEnrichReportEvaluationsOptions("r1").SetConcurrency(2).SetCache(cache)
+		result, err := resultsService.EnrichReportEvaluations(options)
+		Expect(err).To(BeNil())
+		Expect(result.Evaluations).To(HaveLen(14))
+		Expect(result.Evaluations[7].Rule.GetDescription()).To(Equal("Check rule-1"))
+		Expect(result.Evaluations[12].Rule).To(BeNil())
+		Expect(result.Evaluations[13].Rule).To(BeNil())
+		Expect(result.Rules).To(HaveLen(6))
+		Expect(result.FetchedCount).To(Equal(6))
+		Expect(result.CachedCount).To(Equal(0))
+		Expect(ruleRequests).To(HaveLen(7))
+		for _, count := range ruleRequests {
+			Expect(count).To(Equal(1))
+		}
+		Expect(maxInFlight).To(Equal(2))
+		Expect(cache.Len()).To(Equal(6))
+
+		result, err = resultsService.EnrichReportEvaluations(options)
+		Expect(err).To(BeNil())
+		Expect(result.CachedCount).To(Equal(6))
+		Expect(result.FetchedCount).To(Equal(0))
+		Expect(ruleRequests["rule-0"]).To(Equal(1))
+		Expect(ruleRequests["manual-1"]).To(Equal(2))
+	})
+	It(`Caches rules on disk between runs`, func() {
+		dir, err := os.MkdirTemp("", "rules")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+
+		evaluations := []resultsv3.Evaluation{
+			{Assessment: &resultsv3.Assessment{AssessmentID: core.StringPtr("rule-a/../b")}},
+			{Assessment: &resultsv3.Assessment{AssessmentID: core.StringPtr("rule-c")}},
+		}
+		cache, err := resultsv3.NewDiskRuleCache(dir)
+		Expect(err).To(BeNil())
+		options := resultsService.NewEnrichReportEvaluationsOptions("r1").SetEvaluations(evaluations).SetCache(cache)
+		result, err := resultsService.EnrichReportEvaluations(options)
+		Expect(err).To(BeNil())
+		Expect(result.FetchedCount).To(Equal(2))
+
+		cache, err = resultsv3.NewDiskRuleCache(dir)
+		Expect(err).To(BeNil())
+		rule, ok := cache.Get("r1", "rule-c")
+		Expect(ok).To(BeTrue())
+		Expect(rule.GetLabels()).To(Equal([]string{"cos"}))
+		_, ok = cache.Get("r2", "rule-c")
+		Expect(ok).To(BeFalse())
+
+		result, err = resultsService.EnrichReportEvaluations(options.SetCache(cache))
+		Expect(err).To(BeNil())
+		Expect(result.CachedCount).To(Equal(2))
+		Expect(result.Evaluations[0].Rule.GetVersion()).To(Equal("1.0.0"))
+		Expect(ruleRequests).To(HaveLen(2))
+	})
+	It(`Keeps the disk cache inside its directory`, func() {
+		parent, err := os.MkdirTemp("", "rules")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(parent)
+		dir := filepath.Join(parent, "cache")
+
+		cache, err := resultsv3.NewDiskRuleCache(dir)
+		Expect(err).To(BeNil())
+		rule := &resultsv3.Rule{ID: core.StringPtr("rule-c")}
+		for _, reportID := range []string{"", ".", ".."} {
+			Expect(cache.Put(reportID, "rule-c", rule)).ToNot(Succeed())
+			_, ok := cache.Get(reportID, "rule-c")
+			Expect(ok).To(BeFalse())
+		}
+		Expect(cache.Put("../r1", "rule-c", rule)).To(Succeed())
+		entries, err := os.ReadDir(parent)
+		Expect(err).To(BeNil())
+		Expect(entries).To(HaveLen(1))
+	})
+	It(`Returns the first error`, func() {
+		evaluations := []resultsv3.Evaluation{
+			{Assessment: &resultsv3.Assessment{AssessmentID: core.StringPtr("rule-1")}},
+			{Assessment: &resultsv3.Assessment{AssessmentID: core.StringPtr("broken")}},
+		}
+		_, err := resultsService.EnrichReportEvaluations(resultsService.NewEnrichReportEvaluationsOptions("r1").SetEvaluations(evaluations))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("internal error"))
+
+		_, err = resultsService.EnrichReportEvaluations(resultsService.NewEnrichReportEvaluationsOptions(""))
+		Expect(err).ToNot(BeNil())
+	})
+})
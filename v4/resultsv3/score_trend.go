@@ -0,0 +1,411 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ReportSummaryCache : Report summaries by report ID. A report does not change once it is created, so entries do not
+// expire. A ReportSummaryCache is safe for concurrent use and can be shared between calls to GetScoreTrend.
+type ReportSummaryCache struct {
+	mutex     sync.Mutex
+	summaries map[string]*ReportSummary
+}
+
+// NewReportSummaryCache : Instantiate ReportSummaryCache
+func NewReportSummaryCache() *ReportSummaryCache {
+	return &ReportSummaryCache{
+		summaries: make(map[string]*ReportSummary),
+	}
+}
+
+// Get returns the cached summary of a report.
+func (cache *ReportSummaryCache) Get(reportID string) (summary *ReportSummary, ok bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	summary, ok = cache.summaries[reportID]
+	return
+}
+
+// Put caches the summary of a report.
+func (cache *ReportSummaryCache) Put(reportID string, summary *ReportSummary) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.summaries == nil {
+		cache.summaries = make(map[string]*ReportSummary)
+	}
+	cache.summaries[reportID] = summary
+}
+
+// Len returns the number of cached summaries.
+func (cache *ReportSummaryCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return len(cache.summaries)
+}
+
+// GetScoreTrendOptions : The GetScoreTrend options.
+type GetScoreTrendOptions struct {
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// The ID of the attachment.
+	AttachmentID *string
+
+	// The report group id.
+	GroupID *string
+
+	// The ID of the profile.
+	ProfileID *string
+
+	// The ID of the scope.
+	ScopeID *string
+
+	// The type of the scan.
+	Type *string
+
+	// Reports scanned before this time are left out. Zero when the range has no start.
+	From time.Time
+
+	// Reports scanned after this time are left out. Zero when the range has no end.
+	To time.Time
+
+	// How the reports of a series are grouped into points. Defaults to one point per report.
+	Bucket *string
+
+	// Summaries are read from and added to this cache. When nil every summary is fetched.
+	Cache *ReportSummaryCache
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// Constants associated with the GetScoreTrendOptions.Bucket property.
+// How the reports of a series are grouped into points. Days and weeks are in UTC and weeks start on Monday.
+const (
+	GetScoreTrendOptions_Bucket_Day    = "day"
+	GetScoreTrendOptions_Bucket_Report = "report"
+	GetScoreTrendOptions_Bucket_Week   = "week"
+)
+
+// NewGetScoreTrendOptions : Instantiate GetScoreTrendOptions
+func (*ResultsV3) NewGetScoreTrendOptions() *GetScoreTrendOptions {
+	return &GetScoreTrendOptions{}
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *GetScoreTrendOptions) SetXCorrelationID(xCorrelationID string) *GetScoreTrendOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetAttachmentID : Allow user to set AttachmentID
+func (_options *GetScoreTrendOptions) SetAttachmentID(attachmentID string) *GetScoreTrendOptions {
+	_options.AttachmentID = core.StringPtr(attachmentID)
+	return _options
+}
+
+// SetGroupID : Allow user to set GroupID
+func (_options *GetScoreTrendOptions) SetGroupID(groupID string) *GetScoreTrendOptions {
+	_options.GroupID = core.StringPtr(groupID)
+	return _options
+}
+
+// SetProfileID : Allow user to set ProfileID
+func (_options *GetScoreTrendOptions) SetProfileID(profileID string) *GetScoreTrendOptions {
+	_options.ProfileID = core.StringPtr(profileID)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *GetScoreTrendOptions) SetScopeID(scopeID string) *GetScoreTrendOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetType : Allow user to set Type
+func (_options *GetScoreTrendOptions) SetType(typeVar string) *GetScoreTrendOptions {
+	_options.Type = core.StringPtr(typeVar)
+	return _options
+}
+
+// SetDateRange : Allow user to set From and To
+func (_options *GetScoreTrendOptions) SetDateRange(from time.Time, to time.Time) *GetScoreTrendOptions {
+	_options.From = from
+	_options.To = to
+	return _options
+}
+
+// SetBucket : Allow user to set Bucket
+func (_options *GetScoreTrendOptions) SetBucket(bucket string) *GetScoreTrendOptions {
+	_options.Bucket = core.StringPtr(bucket)
+	return _options
+}
+
+// SetCache : Allow user to set Cache
+func (_options *GetScoreTrendOptions) SetCache(cache *ReportSummaryCache) *GetScoreTrendOptions {
+	_options.Cache = cache
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetScoreTrendOptions) SetHeaders(param map[string]string) *GetScoreTrendOptions {
+	options.Headers = param
+	return options
+}
+
+// ScoreTrend : The compliance trend of every attachment, profile and scope combination that has reports in the range.
+type ScoreTrend struct {
+	// The series, ordered by attachment ID, profile ID and scope ID.
+	Series []ScoreTrendSeries
+}
+
+// ScoreTrendSeries : The compliance trend of one attachment, profile and scope combination.
+type ScoreTrendSeries struct {
+	AttachmentID string
+	ProfileID    string
+	ProfileName  string
+	ScopeID      string
+
+	// The points, oldest first.
+	Points []ScoreTrendPoint
+}
+
+// ScoreTrendPoint : The compliance of one report, or of the latest report in a bucket.
+type ScoreTrendPoint struct {
+	// The scan time of the report, or the start of the bucket.
+	Time time.Time
+
+	// The scan time of the report that the point was taken from.
+	ScanTime time.Time
+
+	// The ID of the report that the point was taken from.
+	ReportID string
+
+	// The number of reports in the bucket. Only the latest of them is summarized.
+	ReportCount int
+
+	// The compliance score.
+	Score *ComplianceScore
+
+	// The compliance stats of the controls.
+	Controls *ComplianceStats
+
+	// The evaluation stats.
+	Evaluations *EvalStats
+}
+
+// GetScoreTrend : Get the compliance trend of reports
+// List the reports that match the filters and fall in the date range, group them per attachment, profile and scope,
+// and summarize one report per point. A report is placed by its scan time, or by its creation time when it has no scan
+// time; reports with neither are left out. When several reports fall in one bucket the latest of them is used, because
+// the counts of repeated scans of the same target cannot be added up.
+func (results *ResultsV3) GetScoreTrend(getScoreTrendOptions *GetScoreTrendOptions) (result *ScoreTrend, err error) {
+	return results.GetScoreTrendWithContext(context.Background(), getScoreTrendOptions)
+}
+
+// GetScoreTrendWithContext is an alternate form of the GetScoreTrend method which supports a Context parameter
+func (results *ResultsV3) GetScoreTrendWithContext(ctx context.Context, getScoreTrendOptions *GetScoreTrendOptions) (result *ScoreTrend, err error) {
+	err = core.ValidateNotNil(getScoreTrendOptions, "getScoreTrendOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getScoreTrendOptions, "getScoreTrendOptions")
+	if err != nil {
+		return
+	}
+	bucket := GetScoreTrendOptions_Bucket_Report
+	if getScoreTrendOptions.Bucket != nil {
+		bucket = *getScoreTrendOptions.Bucket
+	}
+	switch bucket {
+	case GetScoreTrendOptions_Bucket_Report, GetScoreTrendOptions_Bucket_Day, GetScoreTrendOptions_Bucket_Week:
+	default:
+		err = fmt.Errorf("unknown bucket '%s'", bucket)
+		return
+	}
+
+	pager, err := results.NewReportsPager(&ListReportsOptions{
+		XCorrelationID: getScoreTrendOptions.XCorrelationID,
+		AttachmentID:   getScoreTrendOptions.AttachmentID,
+		GroupID:        getScoreTrendOptions.GroupID,
+		ProfileID:      getScoreTrendOptions.ProfileID,
+		ScopeID:        getScoreTrendOptions.ScopeID,
+		Type:           getScoreTrendOptions.Type,
+		Headers:        getScoreTrendOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	reports, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	seriesByKey := make(map[scoreTrendKey]*ScoreTrendSeries)
+	var keys []scoreTrendKey
+	for i := range reports {
+		report := &reports[i]
+		if report.ID == nil {
+			continue
+		}
+		scanTime, ok := reportTime(report)
+		if !ok || !inDateRange(scanTime, getScoreTrendOptions.From, getScoreTrendOptions.To) {
+			continue
+		}
+		key := scoreTrendKey{
+			attachmentID: report.GetAttachment().GetID(),
+			profileID:    report.GetProfile().GetID(),
+			scopeID:      report.GetScope().GetID(),
+		}
+		series, ok := seriesByKey[key]
+		if !ok {
+			series = &ScoreTrendSeries{
+				AttachmentID: key.attachmentID,
+				ProfileID:    key.profileID,
+				ProfileName:  report.GetProfile().GetName(),
+				ScopeID:      key.scopeID,
+			}
+			seriesByKey[key] = series
+			keys = append(keys, key)
+		}
+		series.Points = append(series.Points, ScoreTrendPoint{
+			Time:        bucketStart(scanTime, bucket),
+			ScanTime:    scanTime,
+			ReportID:    *report.ID,
+			ReportCount: 1,
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].less(keys[j])
+	})
+
+	result = &ScoreTrend{}
+	for _, key := range keys {
+		series := seriesByKey[key]
+		series.Points = mergeBuckets(series.Points, bucket)
+		for i := range series.Points {
+			point := &series.Points[i]
+			var summary *ReportSummary
+			summary, err = results.getReportSummaryCached(ctx, getScoreTrendOptions, point.ReportID)
+			if err != nil {
+				result = nil
+				return
+			}
+			point.Score = summary.GetScore()
+			point.Controls = summary.GetControls()
+			point.Evaluations = summary.GetEvaluations()
+		}
+		result.Series = append(result.Series, *series)
+	}
+	return
+}
+
+func (results *ResultsV3) getReportSummaryCached(ctx context.Context, getScoreTrendOptions *GetScoreTrendOptions, reportID string) (summary *ReportSummary, err error) {
+	cache := getScoreTrendOptions.Cache
+	if cache != nil {
+		if cached, ok := cache.Get(reportID); ok {
+			return cached, nil
+		}
+	}
+	summary, _, err = results.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+		ReportID:       core.StringPtr(reportID),
+		XCorrelationID: getScoreTrendOptions.XCorrelationID,
+		Headers:        getScoreTrendOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	if cache != nil {
+		cache.Put(reportID, summary)
+	}
+	return
+}
+
+type scoreTrendKey struct {
+	attachmentID string
+	profileID    string
+	scopeID      string
+}
+
+func (key scoreTrendKey) less(other scoreTrendKey) bool {
+	if key.attachmentID != other.attachmentID {
+		return key.attachmentID < other.attachmentID
+	}
+	if key.profileID != other.profileID {
+		return key.profileID < other.profileID
+	}
+	return key.scopeID < other.scopeID
+}
+
+// reportTime returns the scan time of a report, or its creation time when the scan time is missing or malformed.
+func reportTime(report *Report) (time.Time, bool) {
+	for _, value := range []*string{report.ScanTime, report.CreatedAt} {
+		if value == nil {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, *value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func inDateRange(t time.Time, from time.Time, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || !t.After(to))
+}
+
+// bucketStart returns the start of the bucket that t falls in.
+func bucketStart(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case GetScoreTrendOptions_Bucket_Day:
+		t = t.UTC()
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case GetScoreTrendOptions_Bucket_Week:
+		day := bucketStart(t, GetScoreTrendOptions_Bucket_Day)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return t
+}
+
+// mergeBuckets sorts points by scan time and, unless every report is its own point, keeps the latest point of every
+// bucket, counting the reports it replaces.
+func mergeBuckets(points []ScoreTrendPoint, bucket string) (merged []ScoreTrendPoint) {
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].ScanTime.Before(points[j].ScanTime)
+	})
+	if bucket == GetScoreTrendOptions_Bucket_Report {
+		return points
+	}
+	for _, point := range points {
+		if n := len(merged); n > 0 && merged[n-1].Time.Equal(point.Time) {
+			point.ReportCount += merged[n-1].ReportCount
+			merged[n-1] = point
+			continue
+		}
+		merged = append(merged, point)
+	}
+	return
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 score trend`, func() {
+	var testServer *httptest.Server
+	var resultsService *resultsv3.ResultsV3
+	var requestCounts map[string]int
+	var responses map[string]string
+
+	BeforeEach(func() {
+		requestCounts = make(map[string]int)
+		responses = map[string]string{
+			"GET /reports": `{"reports": [
+				{"id": "r3", "scan_time": "2023-05-02T08:00:00Z", "profile": {"id": "p1", "name": "FS Cloud"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+				{"id": "r1", "scan_time": "2023-05-01T08:00:00Z", "profile": {"id": "p1", "name": "FS Cloud"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+				{"id": "r2", "scan_time": "2023-05-01T20:00:00Z", "profile": {"id": "p1", "name": "FS Cloud"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+				{"id": "r4", "created_at": "2023-05-08T08:00:00Z", "profile": {"id": "p0"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+				{"id": "r5", "scan_time": "2023-04-01T08:00:00Z", "profile": {"id": "p1"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}},
+				{"id": "r6", "profile": {"id": "p1"}, "scope": {"id": "s1"}, "attachment": {"id": "a1"}}
+			]}`,
+		}
+		for i, percent := range []int{0, 50, 60, 70, 80, 90, 100} {
+			responses[fmt.Sprintf("GET /reports/r%d/summary", i)] = fmt.Sprintf(`{"report_id": "r%d", "score": {"passed": %d, "total_count": 100, "percent": %d},
+				"controls": {"status": "not_compliant", "total_count": 10, "compliant_count": %d}, "evaluations": {"total_count": 100, "pass_count": %d}}`, i, percent, percent, percent/10, percent)
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			path := req.URL.EscapedPath()
+			requestCounts[path]++
+			body, ok := responses[req.Method+" "+path]
+			if ok && body == "" {
+				res.WriteHeader(204)
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			if !ok {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Builds one series per attachment, profile and scope with a point per report`, func() {
+		from := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+		trend, err := resultsService.GetScoreTrend(resultsService.NewGetScoreTrendOptions().
+			SetDateRange(from, time.Time{}))
+		Expect(err).To(BeNil())
+		Expect(trend.Series).To(HaveLen(2))
+
+		Expect(trend.Series[0].ProfileID).To(Equal("p0"))
+		Expect(trend.Series[0].Points).To(HaveLen(1))
+		Expect(trend.Series[0].Points[0].ReportID).To(Equal("r4"))
+
+		series := trend.Series[1]
+		Expect(series.ProfileName).To(Equal("FS Cloud"))
+		Expect(series.Points).To(HaveLen(3))
+		Expect(series.Points[0].ReportID).To(Equal("r1"))
+		Expect(series.Points[0].Time).To(Equal(time.Date(2023, 5, 1, 8, 0, 0, 0, time.UTC)))
+		Expect(*series.Points[0].Score.Percent).To(Equal(int64(50)))
+		Expect(*series.Points[1].Controls.CompliantCount).To(Equal(int64(6)))
+		Expect(*series.Points[2].Evaluations.PassCount).To(Equal(int64(70)))
+		Expect(requestCounts["/reports/r5/summary"]).To(Equal(0))
+	})
+	It(`Keeps the latest report of every bucket`, func() {
+		trend, err := resultsService.GetScoreTrend(resultsService.NewGetScoreTrendOptions().
+			SetProfileID("p1").
+			SetBucket(resultsv3.GetScoreTrendOptions_Bucket_Day))
+		Expect(err).To(BeNil())
+		points := trend.Series[1].Points
+		Expect(points).To(HaveLen(3))
+		Expect(points[1].Time).To(Equal(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(points[1].ReportID).To(Equal("r2"))
+		Expect(points[1].ReportCount).To(Equal(2))
+		Expect(requestCounts["/reports/r1/summary"]).To(Equal(0))
+
+		trend, err = resultsService.GetScoreTrend(resultsService.NewGetScoreTrendOptions().
+			SetBucket(resultsv3.GetScoreTrendOptions_Bucket_Week))
+		Expect(err).To(BeNil())
+		points = trend.Series[1].Points
+		Expect(points).To(HaveLen(2))
+		Expect(points[1].Time).To(Equal(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(points[1].ReportID).To(Equal("r3"))
+		Expect(points[1].ReportCount).To(Equal(3))
+
+		_, err = resultsService.GetScoreTrend(resultsService.NewGetScoreTrendOptions().SetBucket("month"))
+		Expect(err).To(MatchError("unknown bucket 'month'"))
+	})
+	It(`Accepts an empty summary response`, func() {
+		responses["GET /reports/r4/summary"] = ""
+		trend, err := resultsService.GetScoreTrend(resultsService.NewGetScoreTrendOptions())
+		Expect(err).To(BeNil())
+		Expect(trend.Series[0].Points[0].ReportID).To(Equal("r4"))
+		Expect(trend.Series[0].Points[0].Score).To(BeNil())
+	})
+	It(`Reads summaries from the cache`, func() {
+		cache := resultsv3.NewReportSummaryCache()
+		options := resultsService.NewGetScoreTrendOptions().SetCache(cache)
+		_, err := resultsService.GetScoreTrend(options)
+		Expect(err).To(BeNil())
+		Expect(cache.Len()).To(Equal(5))
+		_, err = resultsService.GetScoreTrend(options)
+		Expect(err).To(BeNil())
+		Expect(requestCounts["/reports/r1/summary"]).To(Equal(1))
+		Expect(requestCounts["/reports"]).To(Equal(2))
+	})
+})
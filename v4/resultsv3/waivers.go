@@ -0,0 +1,548 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v2"
+)
+
+// Waiver : An accepted risk. A waiver matches the failing evaluations that have its assessment ID, control ID and
+// target; a matcher that is not set matches every evaluation, but at least one must be set.
+type Waiver struct {
+	// The ID of the waiver, unique in its WaiverSet.
+	ID string `yaml:"id" json:"id"`
+
+	// The assessment ID of the evaluations.
+	AssessmentID string `yaml:"assessment_id,omitempty" json:"assessment_id,omitempty"`
+
+	// The control ID of the evaluations.
+	ControlID string `yaml:"control_id,omitempty" json:"control_id,omitempty"`
+
+	// A pattern that the target ID or the resource CRN of the evaluations must match. A '*' matches any sequence of
+	// characters and a '?' matches any single character.
+	Target string `yaml:"target,omitempty" json:"target,omitempty"`
+
+	// Why the risk is accepted.
+	Justification string `yaml:"justification" json:"justification"`
+
+	// When the waiver stops applying, as an RFC 3339 time or a date. A date waives through the end of that day in UTC.
+	// The waiver never expires when it is empty.
+	Expires string `yaml:"expires,omitempty" json:"expires,omitempty"`
+
+	target    *regexp.Regexp
+	expiresAt time.Time
+}
+
+// WaiverSet : The waivers of a waiver file. A waiver file is normally written in YAML and read with ParseWaivers, for
+// example:
+//
+//	waivers:
+//	  - id: public-website
+//	    assessment_id: rule-7c3a
+//	    target: "crn:v1:bluemix:public:cloud-object-storage:global:a/*:website-*"
+//	    justification: The bucket serves the public website.
+//	    expires: 2023-12-31
+type WaiverSet struct {
+	Waivers []Waiver `yaml:"waivers" json:"waivers"`
+}
+
+// ParseWaivers reads a WaiverSet from YAML, or JSON, which is a subset of YAML. Unknown keys are rejected so that a
+// misspelt matcher does not widen a waiver.
+func ParseWaivers(data []byte) (set *WaiverSet, err error) {
+	set = &WaiverSet{}
+	if err = yaml.UnmarshalStrict(data, set); err != nil {
+		return nil, fmt.Errorf("error parsing the waivers: %s", err.Error())
+	}
+	if err = set.Validate(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Validate checks the waivers and prepares them for matching. It is called by ParseWaivers and NewApplier.
+func (set *WaiverSet) Validate() error {
+	ids := make(map[string]bool, len(set.Waivers))
+	for i := range set.Waivers {
+		waiver := &set.Waivers[i]
+		switch {
+		case waiver.ID == "":
+			return fmt.Errorf("waiver %d has no id", i+1)
+		case ids[waiver.ID]:
+			return fmt.Errorf("waiver id '%s' is not unique", waiver.ID)
+		case waiver.Justification == "":
+			return fmt.Errorf("waiver '%s' has no justification", waiver.ID)
+		case waiver.AssessmentID == "" && waiver.ControlID == "" && waiver.Target == "":
+			return fmt.Errorf("waiver '%s' must set at least one of assessment_id, control_id and target", waiver.ID)
+		}
+		ids[waiver.ID] = true
+
+		waiver.target = nil
+		if waiver.Target != "" {
+			pattern := regexp.QuoteMeta(waiver.Target)
+			pattern = strings.ReplaceAll(pattern, `\*`, `.*`)
+			pattern = strings.ReplaceAll(pattern, `\?`, `.`)
+			waiver.target = regexp.MustCompile("^" + pattern + "$")
+		}
+
+		waiver.expiresAt = time.Time{}
+		if waiver.Expires != "" {
+			if t, err := time.Parse(time.RFC3339, waiver.Expires); err == nil {
+				waiver.expiresAt = t
+			} else if t, err := time.Parse("2006-01-02", waiver.Expires); err == nil {
+				waiver.expiresAt = t.AddDate(0, 0, 1)
+			} else {
+				return fmt.Errorf("waiver '%s' expires '%s', which is neither an RFC 3339 time nor a date", waiver.ID, waiver.Expires)
+			}
+		}
+	}
+	return nil
+}
+
+// Expired reports whether the waiver no longer applies at the given time.
+func (waiver *Waiver) Expired(now time.Time) bool {
+	return !waiver.expiresAt.IsZero() && !now.Before(waiver.expiresAt)
+}
+
+// Matches reports whether a validated waiver matches an evaluation, regardless of its status and of its expiry.
+func (waiver *Waiver) Matches(evaluation *Evaluation) bool {
+	if waiver.AssessmentID != "" && waiver.AssessmentID != evaluation.GetAssessment().GetAssessmentID() {
+		return false
+	}
+	if waiver.ControlID != "" && waiver.ControlID != evaluation.GetControlID() {
+		return false
+	}
+	if waiver.target != nil {
+		target := evaluation.GetTarget()
+		if !waiver.target.MatchString(target.GetID()) && !waiver.target.MatchString(target.GetResourceCrn()) {
+			return false
+		}
+	}
+	return true
+}
+
+// WaiverApplier : Applies the waivers of a WaiverSet at a point in time and records which waivers were used.
+// Create one with WaiverSet.NewApplier.
+type WaiverApplier struct {
+	set  *WaiverSet
+	now  time.Time
+	used map[string]bool
+}
+
+// NewApplier validates the set and returns an applier that waives evaluations with the waivers that have not expired
+// at the given time.
+func (set *WaiverSet) NewApplier(now time.Time) (*WaiverApplier, error) {
+	if err := set.Validate(); err != nil {
+		return nil, err
+	}
+	applier := &WaiverApplier{
+		set:  set,
+		now:  now,
+		used: make(map[string]bool),
+	}
+	return applier, nil
+}
+
+// Waive returns the first waiver that has not expired and matches the evaluation, or nil when the evaluation is not
+// waived. Only failing evaluations are waived.
+func (applier *WaiverApplier) Waive(evaluation *Evaluation) *Waiver {
+	if evaluation.GetStatus() != Evaluation_Status_Failure {
+		return nil
+	}
+	for i := range applier.set.Waivers {
+		waiver := &applier.set.Waivers[i]
+		if !waiver.Expired(applier.now) && waiver.Matches(evaluation) {
+			applier.used[waiver.ID] = true
+			return waiver
+		}
+	}
+	return nil
+}
+
+// WaivedEvaluation : An evaluation and the waiver that applies to it.
+type WaivedEvaluation struct {
+	Evaluation *Evaluation `json:"evaluation"`
+
+	// The waiver of the evaluation, or nil when it is not waived.
+	Waiver *Waiver `json:"waiver,omitempty"`
+}
+
+// ApplyToEvaluations annotates every evaluation with its waiver.
+func (applier *WaiverApplier) ApplyToEvaluations(evaluations []Evaluation) []WaivedEvaluation {
+	result := make([]WaivedEvaluation, len(evaluations))
+	for i := range evaluations {
+		result[i] = WaivedEvaluation{
+			Evaluation: &evaluations[i],
+			Waiver:     applier.Waive(&evaluations[i]),
+		}
+	}
+	return result
+}
+
+// FilterEvaluations returns the evaluations that are not waived.
+func (applier *WaiverApplier) FilterEvaluations(evaluations []Evaluation) (result []Evaluation) {
+	for i := range evaluations {
+		if applier.Waive(&evaluations[i]) == nil {
+			result = append(result, evaluations[i])
+		}
+	}
+	return
+}
+
+// WaivedResource : A resource whose counts and status are recomputed with its waived evaluations counted as passed.
+type WaivedResource struct {
+	// A copy of the resource with the recomputed counts and status.
+	Resource Resource `json:"resource"`
+
+	// The status of the resource before the waivers were applied.
+	OriginalStatus string `json:"original_status,omitempty"`
+
+	// The number of failing evaluations of the resource that are waived.
+	WaivedCount int64 `json:"waived_count"`
+
+	// The IDs of the waivers that apply to the resource.
+	WaiverIDs []string `json:"waiver_ids,omitempty"`
+}
+
+// ApplyToResources recomputes the resources with the waivers that apply to the given evaluations of their report.
+// Evaluations are matched to resources by resource CRN, or target ID when the target has no CRN.
+func (applier *WaiverApplier) ApplyToResources(resources []Resource, evaluations []Evaluation) []WaivedResource {
+	waived := make(map[string]*WaivedResource)
+	for i := range evaluations {
+		if waiver := applier.Waive(&evaluations[i]); waiver != nil {
+			id := evaluationResourceID(&evaluations[i])
+			entry := waived[id]
+			if entry == nil {
+				entry = &WaivedResource{}
+				waived[id] = entry
+			}
+			entry.WaivedCount++
+			if !containsString(entry.WaiverIDs, waiver.ID) {
+				entry.WaiverIDs = append(entry.WaiverIDs, waiver.ID)
+			}
+		}
+	}
+
+	result := make([]WaivedResource, len(resources))
+	for i, resource := range resources {
+		result[i] = WaivedResource{
+			Resource:       resource,
+			OriginalStatus: resource.GetStatus(),
+		}
+		entry := waived[resource.GetID()]
+		if entry == nil {
+			continue
+		}
+		result[i].WaivedCount = entry.WaivedCount
+		result[i].WaiverIDs = entry.WaiverIDs
+		failures := resource.GetFailureCount() - entry.WaivedCount
+		if failures < 0 {
+			failures = 0
+		}
+		recomputed := &result[i].Resource
+		recomputed.FailureCount = core.Int64Ptr(failures)
+		recomputed.PassCount = core.Int64Ptr(resource.GetPassCount() + resource.GetFailureCount() - failures)
+		if failures == 0 && resource.GetStatus() == Resource_Status_NotCompliant {
+			if resource.GetErrorCount() > 0 {
+				recomputed.Status = core.StringPtr(Resource_Status_UnableToPerform)
+			} else {
+				recomputed.Status = core.StringPtr(Resource_Status_Compliant)
+			}
+		}
+	}
+	return result
+}
+
+// WaivedControl : A control whose check counts and status are recomputed with its waived evaluations counted as
+// passed. A check, which is an assessment of the control, becomes compliant when every failing evaluation of it is
+// waived.
+type WaivedControl struct {
+	// A copy of the control with the recomputed counts and status.
+	Control ControlWithStats `json:"control"`
+
+	// The status of the control before the waivers were applied.
+	OriginalStatus string `json:"original_status,omitempty"`
+
+	// The number of not compliant checks of the control that became compliant.
+	WaivedCount int64 `json:"waived_count"`
+
+	// The IDs of the waivers that apply to the control.
+	WaiverIDs []string `json:"waiver_ids,omitempty"`
+}
+
+// ApplyToControls recomputes the controls with the waivers that apply to the given evaluations of their report, and
+// returns the compliance stats of the recomputed controls.
+func (applier *WaiverApplier) ApplyToControls(controls []ControlWithStats, evaluations []Evaluation) (result []WaivedControl, stats *ComplianceStats) {
+	type check struct {
+		controlID    string
+		assessmentID string
+	}
+	failing := make(map[check]bool)
+	waiverIDs := make(map[string][]string)
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+		if evaluation.GetStatus() != Evaluation_Status_Failure {
+			continue
+		}
+		key := check{evaluation.GetControlID(), evaluation.GetAssessment().GetAssessmentID()}
+		waiver := applier.Waive(evaluation)
+		if waiver == nil {
+			failing[key] = true
+			continue
+		}
+		if _, ok := failing[key]; !ok {
+			failing[key] = false
+		}
+		if !containsString(waiverIDs[key.controlID], waiver.ID) {
+			waiverIDs[key.controlID] = append(waiverIDs[key.controlID], waiver.ID)
+		}
+	}
+	waivedChecks := make(map[string]int64)
+	for key, stillFailing := range failing {
+		if !stillFailing {
+			waivedChecks[key.controlID]++
+		}
+	}
+
+	var compliant, notCompliant, unableToPerform, userEvaluationRequired int64
+	status := ""
+	result = make([]WaivedControl, len(controls))
+	for i, control := range controls {
+		result[i] = WaivedControl{
+			Control:        control,
+			OriginalStatus: control.GetStatus(),
+			WaiverIDs:      waiverIDs[control.GetID()],
+		}
+		if waived := waivedChecks[control.GetID()]; waived > 0 {
+			if waived > control.GetNotCompliantCount() {
+				waived = control.GetNotCompliantCount()
+			}
+			result[i].WaivedCount = waived
+			recomputed := &result[i].Control
+			recomputed.NotCompliantCount = core.Int64Ptr(control.GetNotCompliantCount() - waived)
+			recomputed.CompliantCount = core.Int64Ptr(control.GetCompliantCount() + waived)
+			if control.GetStatus() == ControlWithStats_Status_NotCompliant && *recomputed.NotCompliantCount == 0 {
+				switch {
+				case control.GetUnableToPerformCount() > 0:
+					recomputed.Status = core.StringPtr(ControlWithStats_Status_UnableToPerform)
+				case control.GetUserEvaluationRequiredCount() > 0:
+					recomputed.Status = core.StringPtr(ControlWithStats_Status_UserEvaluationRequired)
+				default:
+					recomputed.Status = core.StringPtr(ControlWithStats_Status_Compliant)
+				}
+			}
+		}
+
+		controlStatus := result[i].Control.GetStatus()
+		switch controlStatus {
+		case ControlWithStats_Status_Compliant:
+			compliant++
+		case ControlWithStats_Status_NotCompliant:
+			notCompliant++
+		case ControlWithStats_Status_UnableToPerform:
+			unableToPerform++
+		case ControlWithStats_Status_UserEvaluationRequired:
+			userEvaluationRequired++
+		}
+		if controlStatusRank[controlStatus] > controlStatusRank[status] {
+			status = controlStatus
+		}
+	}
+	stats = &ComplianceStats{
+		TotalCount:                  core.Int64Ptr(int64(len(controls))),
+		CompliantCount:              core.Int64Ptr(compliant),
+		NotCompliantCount:           core.Int64Ptr(notCompliant),
+		UnableToPerformCount:        core.Int64Ptr(unableToPerform),
+		UserEvaluationRequiredCount: core.Int64Ptr(userEvaluationRequired),
+	}
+	if status != "" {
+		stats.Status = core.StringPtr(status)
+	}
+	return
+}
+
+// WaiverUsage : The waivers that need attention after they were applied.
+type WaiverUsage struct {
+	// The waivers that have expired.
+	Expired []Waiver `json:"expired,omitempty"`
+
+	// The waivers that have not expired but did not match any failing evaluation.
+	Unused []Waiver `json:"unused,omitempty"`
+}
+
+// Usage returns the expired waivers and the waivers that the applier has not used so far.
+func (applier *WaiverApplier) Usage() *WaiverUsage {
+	usage := &WaiverUsage{}
+	for _, waiver := range applier.set.Waivers {
+		switch {
+		case waiver.Expired(applier.now):
+			usage.Expired = append(usage.Expired, waiver)
+		case !applier.used[waiver.ID]:
+			usage.Unused = append(usage.Unused, waiver)
+		}
+	}
+	return usage
+}
+
+// ApplyReportWaiversOptions : The ApplyReportWaivers options.
+type ApplyReportWaiversOptions struct {
+	// The ID of the scan that is associated with a report.
+	ReportID *string `validate:"required,ne="`
+
+	// The waivers to apply.
+	Waivers *WaiverSet `validate:"required"`
+
+	// The time at which the expiry of the waivers is checked. Defaults to the current time.
+	Now time.Time
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewApplyReportWaiversOptions : Instantiate ApplyReportWaiversOptions
+func (*ResultsV3) NewApplyReportWaiversOptions(reportID string, waivers *WaiverSet) *ApplyReportWaiversOptions {
+	return &ApplyReportWaiversOptions{
+		ReportID: core.StringPtr(reportID),
+		Waivers:  waivers,
+	}
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *ApplyReportWaiversOptions) SetReportID(reportID string) *ApplyReportWaiversOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetWaivers : Allow user to set Waivers
+func (_options *ApplyReportWaiversOptions) SetWaivers(waivers *WaiverSet) *ApplyReportWaiversOptions {
+	_options.Waivers = waivers
+	return _options
+}
+
+// SetNow : Allow user to set Now
+func (_options *ApplyReportWaiversOptions) SetNow(now time.Time) *ApplyReportWaiversOptions {
+	_options.Now = now
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *ApplyReportWaiversOptions) SetXCorrelationID(xCorrelationID string) *ApplyReportWaiversOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyReportWaiversOptions) SetHeaders(param map[string]string) *ApplyReportWaiversOptions {
+	options.Headers = param
+	return options
+}
+
+// WaivedReport : The results of a report with waivers applied.
+type WaivedReport struct {
+	// The evaluations of the report, annotated with their waivers.
+	Evaluations []WaivedEvaluation `json:"evaluations"`
+
+	// The resources of the report, recomputed.
+	Resources []WaivedResource `json:"resources"`
+
+	// The controls of the report, recomputed.
+	Controls []WaivedControl `json:"controls"`
+
+	// The compliance stats of the recomputed controls.
+	ControlStats *ComplianceStats `json:"control_stats"`
+
+	// The expired and unused waivers.
+	Usage *WaiverUsage `json:"usage"`
+}
+
+// ApplyReportWaivers : Apply waivers to a report
+// Fetch the evaluations, resources and controls of a report and apply the waivers to them.
+func (results *ResultsV3) ApplyReportWaivers(applyReportWaiversOptions *ApplyReportWaiversOptions) (result *WaivedReport, err error) {
+	return results.ApplyReportWaiversWithContext(context.Background(), applyReportWaiversOptions)
+}
+
+// ApplyReportWaiversWithContext is an alternate form of the ApplyReportWaivers method which supports a Context parameter
+func (results *ResultsV3) ApplyReportWaiversWithContext(ctx context.Context, applyReportWaiversOptions *ApplyReportWaiversOptions) (result *WaivedReport, err error) {
+	err = core.ValidateNotNil(applyReportWaiversOptions, "applyReportWaiversOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(applyReportWaiversOptions, "applyReportWaiversOptions")
+	if err != nil {
+		return
+	}
+	options := applyReportWaiversOptions
+	now := options.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	applier, err := options.Waivers.NewApplier(now)
+	if err != nil {
+		return
+	}
+
+	evaluationsPager, err := results.NewReportEvaluationsPager(&ListReportEvaluationsOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	evaluations, err := evaluationsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	resourcesPager, err := results.NewReportResourcesPager(&ListReportResourcesOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	resources, err := resourcesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	controls, _, err := results.GetReportControlsWithContext(ctx, &GetReportControlsOptions{
+		ReportID:       options.ReportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	result = &WaivedReport{
+		Evaluations: applier.ApplyToEvaluations(evaluations),
+		Resources:   applier.ApplyToResources(resources, evaluations),
+	}
+	result.Controls, result.ControlStats = applier.ApplyToControls(controls.GetControls(), evaluations)
+	result.Usage = applier.Usage()
+	return
+}
@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3_test
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResultsV3 waivers`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+
+	const waiverFile = `
+waivers:
+  - id: public-website
+    assessment_id: rule-public
+    target: "crn:v1:*:website-*"
+    justification: The bucket serves the public website.
+    expires: 2023-06-30
+  - id: legacy-keys
+    control_id: c2
+    justification: Keys are rotated by hand until the migration.
+    expires: 2023-05-01T00:00:00Z
+  - id: old-vpc
+    target: "crn:v1:*:vpc-legacy"
+    justification: The VPC is being decommissioned.
+`
+	now := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports/r1/evaluations": `{"evaluations": [
+				{"control_id": "c1", "status": "failure", "assessment": {"assessment_id": "rule-public"}, "target": {"id": "t1", "resource_crn": "crn:v1:cos:website-a"}},
+				{"control_id": "c1", "status": "failure", "assessment": {"assessment_id": "rule-public"}, "target": {"id": "t2", "resource_crn": "crn:v1:cos:data-b"}},
+				{"control_id": "c1", "status": "pass", "assessment": {"assessment_id": "rule-public"}, "target": {"id": "t3", "resource_crn": "crn:v1:cos:website-c"}},
+				{"control_id": "c2", "status": "failure", "assessment": {"assessment_id": "rule-keys"}, "target": {"id": "t1", "resource_crn": "crn:v1:cos:website-a"}},
+				{"control_id": "c3", "status": "failure", "assessment": {"assessment_id": "rule-tls"}, "target": {"id": "t1", "resource_crn": "crn:v1:cos:website-a"}}
+			]}`,
+			"GET /reports/r1/resources": `{"resources": [
+				{"id": "crn:v1:cos:website-a", "status": "not_compliant", "total_count": 3, "pass_count": 0, "failure_count": 3},
+				{"id": "crn:v1:cos:data-b", "status": "not_compliant", "total_count": 1, "failure_count": 1}
+			]}`,
+			"GET /reports/r1/controls": `{"controls": [
+				{"id": "c1", "status": "not_compliant", "total_count": 1, "not_compliant_count": 1},
+				{"id": "c2", "status": "not_compliant", "total_count": 1, "not_compliant_count": 1},
+				{"id": "c3", "status": "not_compliant", "total_count": 2, "compliant_count": 1, "not_compliant_count": 1}
+			]}`,
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Parses and validates a waiver file`, func() {
+		set, err := resultsv3.ParseWaivers([]byte(waiverFile))
+		Expect(err).To(BeNil())
+		Expect(set.Waivers).To(HaveLen(3))
+		Expect(set.Waivers[0].Expired(now)).To(BeFalse())
+		Expect(set.Waivers[0].Expired(now.Add(12 * time.Hour))).To(BeTrue())
+		Expect(set.Waivers[1].Expired(now)).To(BeTrue())
+		Expect(set.Waivers[2].Expired(now)).To(BeFalse())
+
+		_, err = resultsv3.ParseWaivers([]byte("waivers:\n  - id: w1\n    control: c1\n    justification: x\n"))
+		Expect(err).ToNot(BeNil())
+		_, err = resultsv3.ParseWaivers([]byte("waivers:\n  - id: w1\n    justification: x\n"))
+		Expect(err).To(MatchError("waiver 'w1' must set at least one of assessment_id, control_id and target"))
+		_, err = resultsv3.ParseWaivers([]byte("waivers:\n  - id: w1\n    control_id: c1\n"))
+		Expect(err).To(MatchError("waiver 'w1' has no justification"))
+		_, err = resultsv3.ParseWaivers([]byte("waivers:\n  - id: w1\n    control_id: c1\n    justification: x\n    expires: soon\n"))
+		Expect(err).To(MatchError("waiver 'w1' expires 'soon', which is neither an RFC 3339 time nor a date"))
+
+		_, err = (&resultsv3.WaiverSet{Waivers: []resultsv3.Waiver{{ID: "w1", ControlID: "c1"}}}).NewApplier(now)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Applies waivers to the evaluations, resources and controls of a report`, func() {
+		set, _ := resultsv3.ParseWaivers([]byte(waiverFile))
+		result, err := resultsService.ApplyReportWaivers(resultsService.NewApplyReportWaiversOptions("r1", set).SetNow(now))
+		Expect(err).To(BeNil())
+
+		var waiverIDs []string
+		for _, evaluation := range result.Evaluations {
+			if evaluation.Waiver != nil {
+				waiverIDs = append(waiverIDs, evaluation.Waiver.ID)
+			} else {
+				waiverIDs = append(waiverIDs, "")
+			}
+		}
+		Expect(waiverIDs).To(Equal([]string{"public-website", "", "", "", ""}))
+
+		Expect(result.Resources[0].WaivedCount).To(Equal(int64(1)))
+		Expect(result.Resources[0].WaiverIDs).To(Equal([]string{"public-website"}))
+		Expect(result.Resources[0].Resource.GetFailureCount()).To(Equal(int64(2)))
+		Expect(result.Resources[0].Resource.GetPassCount()).To(Equal(int64(1)))
+		Expect(result.Resources[0].Resource.GetStatus()).To(Equal(resultsv3.Resource_Status_NotCompliant))
+		Expect(result.Resources[1].WaivedCount).To(Equal(int64(0)))
+
+		// c1 still fails for data-b, and the waiver of c2 has expired.
+		Expect(result.Controls[0].Control.GetStatus()).To(Equal(resultsv3.ControlWithStats_Status_NotCompliant))
+		Expect(result.Controls[0].WaiverIDs).To(Equal([]string{"public-website"}))
+		Expect(result.Controls[1].WaivedCount).To(Equal(int64(0)))
+		Expect(result.ControlStats.GetNotCompliantCount()).To(Equal(int64(3)))
+
+		Expect(result.Usage.Expired).To(HaveLen(1))
+		Expect(result.Usage.Expired[0].ID).To(Equal("legacy-keys"))
+		Expect(result.Usage.Unused).To(HaveLen(1))
+		Expect(result.Usage.Unused[0].ID).To(Equal("old-vpc"))
+	})
+	It(`Accepts an empty controls response`, func() {
+		testServer.SetResponse("GET /reports/r1/controls", "")
+		set, _ := resultsv3.ParseWaivers([]byte(waiverFile))
+		result, err := resultsService.ApplyReportWaivers(resultsService.NewApplyReportWaiversOptions("r1", set).SetNow(now))
+		Expect(err).To(BeNil())
+		Expect(result.Evaluations).To(HaveLen(5))
+		Expect(result.Controls).To(BeEmpty())
+	})
+	It(`Recomputes the status of fully waived resources and controls`, func() {
+		set, _ := resultsv3.ParseWaivers([]byte(waiverFile))
+		applier, err := set.NewApplier(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC))
+		Expect(err).To(BeNil())
+		evaluations := []resultsv3.Evaluation{
+			{ControlID: core.StringPtr("c2"), Status: core.StringPtr("failure"), Target: &resultsv3.Target{ResourceCrn: core.StringPtr("crn:v1:kms:key-1")}},
+			{ControlID: core.StringPtr("c2"), Status: core.StringPtr("failure"), Target: &resultsv3.Target{ResourceCrn: core.StringPtr("crn:v1:kms:key-2")}},
+			{ControlID: core.StringPtr("c3"), Status: core.StringPtr("failure"), Target: &resultsv3.Target{ResourceCrn: core.StringPtr("crn:v1:kms:key-2")}},
+		}
+		Expect(applier.FilterEvaluations(evaluations)).To(HaveLen(1))
+
+		resources := applier.ApplyToResources([]resultsv3.Resource{
+			{ID: core.StringPtr("crn:v1:kms:key-1"), Status: core.StringPtr("not_compliant"), FailureCount: core.Int64Ptr(1)},
+			{ID: core.StringPtr("crn:v1:kms:key-2"), Status: core.StringPtr("not_compliant"), FailureCount: core.Int64Ptr(2)},
+		}, evaluations)
+		Expect(resources[0].OriginalStatus).To(Equal(resultsv3.Resource_Status_NotCompliant))
+		Expect(resources[0].Resource.GetStatus()).To(Equal(resultsv3.Resource_Status_Compliant))
+		Expect(resources[1].Resource.GetStatus()).To(Equal(resultsv3.Resource_Status_NotCompliant))
+
+		controls, stats := applier.ApplyToControls([]resultsv3.ControlWithStats{
+			{ID: core.StringPtr("c2"), Status: core.StringPtr("not_compliant"), NotCompliantCount: core.Int64Ptr(1), UnableToPerformCount: core.Int64Ptr(1)},
+			{ID: core.StringPtr("c3"), Status: core.StringPtr("not_compliant"), NotCompliantCount: core.Int64Ptr(1)},
+			{ID: core.StringPtr("c4"), Status: core.StringPtr("compliant"), CompliantCount: core.Int64Ptr(1)},
+		}, evaluations)
+		Expect(controls[0].WaivedCount).To(Equal(int64(1)))
+		Expect(controls[0].Control.GetNotCompliantCount()).To(Equal(int64(0)))
+		Expect(controls[0].Control.GetCompliantCount()).To(Equal(int64(1)))
+		Expect(controls[0].Control.GetStatus()).To(Equal(resultsv3.ControlWithStats_Status_UnableToPerform))
+		Expect(*stats).To(Equal(resultsv3.ComplianceStats{
+			Status:                      core.StringPtr(resultsv3.ControlWithStats_Status_NotCompliant),
+			TotalCount:                  core.Int64Ptr(3),
+			CompliantCount:              core.Int64Ptr(1),
+			NotCompliantCount:           core.Int64Ptr(1),
+			UnableToPerformCount:        core.Int64Ptr(1),
+			UserEvaluationRequiredCount: core.Int64Ptr(0),
+		}))
+
+		usage := applier.Usage()
+		Expect(usage.Expired).To(BeEmpty())
+		Expect(usage.Unused).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,489 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resultsv3
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// WarehouseSchema is the schema of the local results warehouse, in the SQLite dialect. Every statement can be run
+// again on an existing database. Times are stored as RFC 3339 text, as the service returns them, so they sort and
+// compare correctly as text.
+const WarehouseSchema = `
+-- One row per synced report. A report row is written in the same transaction as the controls, evaluations and
+-- resources of the report, so a report that has a row here is complete.
+CREATE TABLE IF NOT EXISTS reports (
+	id                TEXT PRIMARY KEY,
+	group_id          TEXT,
+	created_at        TEXT,
+	scan_time         TEXT,
+	type              TEXT,
+	instance_id       TEXT,
+	account_id        TEXT,
+	account_name      TEXT,
+	profile_id        TEXT,
+	profile_name      TEXT,
+	profile_version   TEXT,
+	scope_id          TEXT,
+	scope_type        TEXT,
+	attachment_id     TEXT,
+	score_passed      INTEGER,
+	score_total_count INTEGER,
+	score_percent     INTEGER,
+	synced_at         TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS reports_scan_time ON reports (scan_time);
+CREATE INDEX IF NOT EXISTS reports_group_id ON reports (group_id, scan_time);
+CREATE INDEX IF NOT EXISTS reports_profile_scope ON reports (profile_id, scope_id, scan_time);
+
+-- One row per control of a report.
+CREATE TABLE IF NOT EXISTS controls (
+	report_id                      TEXT NOT NULL REFERENCES reports (id),
+	control_id                     TEXT NOT NULL,
+	control_library_id             TEXT,
+	control_library_version        TEXT,
+	control_name                   TEXT,
+	control_description            TEXT,
+	control_category               TEXT,
+	control_path                   TEXT,
+	status                         TEXT,
+	total_count                    INTEGER,
+	compliant_count                INTEGER,
+	not_compliant_count            INTEGER,
+	unable_to_perform_count        INTEGER,
+	user_evaluation_required_count INTEGER,
+	PRIMARY KEY (report_id, control_id)
+);
+CREATE INDEX IF NOT EXISTS controls_control_id ON controls (control_id, report_id);
+
+-- One row per evaluation of an assessment on a target. details holds the evaluation details as JSON.
+CREATE TABLE IF NOT EXISTS evaluations (
+	report_id              TEXT NOT NULL REFERENCES reports (id),
+	control_id             TEXT,
+	component_id           TEXT,
+	assessment_id          TEXT,
+	assessment_type        TEXT,
+	assessment_description TEXT,
+	evaluate_time          TEXT,
+	target_id              TEXT,
+	target_account_id      TEXT,
+	target_resource_crn    TEXT,
+	target_resource_name   TEXT,
+	target_service_name    TEXT,
+	status                 TEXT,
+	reason                 TEXT,
+	details                TEXT
+);
+CREATE INDEX IF NOT EXISTS evaluations_report_status ON evaluations (report_id, status);
+CREATE INDEX IF NOT EXISTS evaluations_assessment_target ON evaluations (assessment_id, target_resource_crn, report_id);
+CREATE INDEX IF NOT EXISTS evaluations_target ON evaluations (target_resource_crn, report_id);
+
+-- One row per resource of a report.
+CREATE TABLE IF NOT EXISTS resources (
+	report_id       TEXT NOT NULL REFERENCES reports (id),
+	resource_id     TEXT NOT NULL,
+	resource_name   TEXT,
+	component_id    TEXT,
+	environment     TEXT,
+	account_id      TEXT,
+	status          TEXT,
+	total_count     INTEGER,
+	pass_count      INTEGER,
+	failure_count   INTEGER,
+	error_count     INTEGER,
+	completed_count INTEGER,
+	PRIMARY KEY (report_id, resource_id)
+);
+CREATE INDEX IF NOT EXISTS resources_resource_id ON resources (resource_id, report_id);
+
+`
+
+// CreateWarehouseSchema creates the tables and indexes of WarehouseSchema that do not exist yet.
+func CreateWarehouseSchema(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, WarehouseSchema)
+	return err
+}
+
+// SyncWarehouseOptions : The SyncWarehouse options.
+type SyncWarehouseOptions struct {
+	// The database to sync to. The SDK does not include a database driver; open the database with the SQLite driver of
+	// your choice.
+	DB *sql.DB `validate:"required"`
+
+	// The ID of the attachment.
+	AttachmentID *string
+
+	// The report group id.
+	GroupID *string
+
+	// The ID of the profile.
+	ProfileID *string
+
+	// The ID of the scope.
+	ScopeID *string
+
+	// The type of the scan.
+	Type *string
+
+	// Reports scanned before this time are not synced, nor are reports without a valid scan time. Zero to sync every
+	// report.
+	Since time.Time
+
+	// The most reports to sync in one call. Zero for no limit.
+	MaxReports int
+
+	// The supplied or generated value of this header is logged for a request and repeated in a response header for the
+	// corresponding response.
+	XCorrelationID *string
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewSyncWarehouseOptions : Instantiate SyncWarehouseOptions
+func (*ResultsV3) NewSyncWarehouseOptions(db *sql.DB) *SyncWarehouseOptions {
+	return &SyncWarehouseOptions{
+		DB: db,
+	}
+}
+
+// SetAttachmentID : Allow user to set AttachmentID
+func (_options *SyncWarehouseOptions) SetAttachmentID(attachmentID string) *SyncWarehouseOptions {
+	_options.AttachmentID = core.StringPtr(attachmentID)
+	return _options
+}
+
+// SetGroupID : Allow user to set GroupID
+func (_options *SyncWarehouseOptions) SetGroupID(groupID string) *SyncWarehouseOptions {
+	_options.GroupID = core.StringPtr(groupID)
+	return _options
+}
+
+// SetProfileID : Allow user to set ProfileID
+func (_options *SyncWarehouseOptions) SetProfileID(profileID string) *SyncWarehouseOptions {
+	_options.ProfileID = core.StringPtr(profileID)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *SyncWarehouseOptions) SetScopeID(scopeID string) *SyncWarehouseOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetType : Allow user to set Type
+func (_options *SyncWarehouseOptions) SetType(typeVar string) *SyncWarehouseOptions {
+	_options.Type = core.StringPtr(typeVar)
+	return _options
+}
+
+// SetSince : Allow user to set Since
+func (_options *SyncWarehouseOptions) SetSince(since time.Time) *SyncWarehouseOptions {
+	_options.Since = since
+	return _options
+}
+
+// SetMaxReports : Allow user to set MaxReports
+func (_options *SyncWarehouseOptions) SetMaxReports(maxReports int) *SyncWarehouseOptions {
+	_options.MaxReports = maxReports
+	return _options
+}
+
+// SetXCorrelationID : Allow user to set XCorrelationID
+func (_options *SyncWarehouseOptions) SetXCorrelationID(xCorrelationID string) *SyncWarehouseOptions {
+	_options.XCorrelationID = core.StringPtr(xCorrelationID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SyncWarehouseOptions) SetHeaders(param map[string]string) *SyncWarehouseOptions {
+	options.Headers = param
+	return options
+}
+
+// SyncWarehouseResult : The outcome of a SyncWarehouse call.
+type SyncWarehouseResult struct {
+	// The IDs of the reports that were synced, oldest first.
+	SyncedReportIDs []string
+
+	// The number of listed reports that were already in the warehouse.
+	AlreadySynced int
+
+	// The number of listed reports that are left for a later call because of MaxReports.
+	Remaining int
+
+	// The IDs of the listed reports that were not synced because Since is set and their scan time is missing or
+	// cannot be parsed.
+	NoScanTimeReportIDs []string
+
+	// The number of controls and resources of the synced reports that were not written because the service returned
+	// them without an ID.
+	SkippedRows int
+}
+
+// SyncWarehouse : Sync reports to a local warehouse
+// Create the WarehouseSchema tables when needed, list the reports that match the filters and copy every report that is
+// not in the warehouse yet, with its score, controls, evaluations and resources, oldest first. Every report is written
+// in its own transaction, so an interrupted sync resumes after the last report that was written. When a report fails
+// to sync, the reports that were synced before it are returned together with the error.
+func (results *ResultsV3) SyncWarehouse(syncWarehouseOptions *SyncWarehouseOptions) (result *SyncWarehouseResult, err error) {
+	return results.SyncWarehouseWithContext(context.Background(), syncWarehouseOptions)
+}
+
+// SyncWarehouseWithContext is an alternate form of the SyncWarehouse method which supports a Context parameter
+func (results *ResultsV3) SyncWarehouseWithContext(ctx context.Context, syncWarehouseOptions *SyncWarehouseOptions) (result *SyncWarehouseResult, err error) {
+	err = core.ValidateNotNil(syncWarehouseOptions, "syncWarehouseOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(syncWarehouseOptions, "syncWarehouseOptions")
+	if err != nil {
+		return
+	}
+	options := syncWarehouseOptions
+	db := options.DB
+
+	err = CreateWarehouseSchema(ctx, db)
+	if err != nil {
+		return
+	}
+
+	pager, err := results.NewReportsPager(&ListReportsOptions{
+		XCorrelationID: options.XCorrelationID,
+		AttachmentID:   options.AttachmentID,
+		GroupID:        options.GroupID,
+		ProfileID:      options.ProfileID,
+		ScopeID:        options.ScopeID,
+		Type:           options.Type,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	reports, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	type pendingReport struct {
+		report   *Report
+		scanTime time.Time
+	}
+	var pending []pendingReport
+	result = &SyncWarehouseResult{}
+	for i := range reports {
+		report := &reports[i]
+		if report.ID == nil {
+			continue
+		}
+		scanTime, ok := reportTime(report)
+		if !options.Since.IsZero() {
+			if !ok {
+				result.NoScanTimeReportIDs = append(result.NoScanTimeReportIDs, *report.ID)
+				continue
+			}
+			if scanTime.Before(options.Since) {
+				continue
+			}
+		}
+		var synced bool
+		synced, err = warehouseHasReport(ctx, db, *report.ID)
+		if err != nil {
+			result = nil
+			return
+		}
+		if synced {
+			result.AlreadySynced++
+			continue
+		}
+		pending = append(pending, pendingReport{report, scanTime})
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].scanTime.Before(pending[j].scanTime)
+	})
+
+	for i, p := range pending {
+		if options.MaxReports > 0 && i >= options.MaxReports {
+			result.Remaining = len(pending) - i
+			break
+		}
+		var skipped int
+		skipped, err = results.syncWarehouseReport(ctx, options, p.report)
+		if err != nil {
+			return
+		}
+		result.SkippedRows += skipped
+		result.SyncedReportIDs = append(result.SyncedReportIDs, *p.report.ID)
+	}
+	return
+}
+
+func warehouseHasReport(ctx context.Context, db *sql.DB, reportID string) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM reports WHERE id = ?`, reportID).Scan(&count)
+	return count > 0, err
+}
+
+// syncWarehouseReport fetches everything of one report and writes it in one transaction. Controls and resources
+// without an ID cannot be keyed, so they are skipped and counted.
+func (results *ResultsV3) syncWarehouseReport(ctx context.Context, options *SyncWarehouseOptions, report *Report) (skipped int, err error) {
+	reportID := report.ID
+	summary, _, err := results.GetReportSummaryWithContext(ctx, &GetReportSummaryOptions{
+		ReportID:       reportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	controls, _, err := results.GetReportControlsWithContext(ctx, &GetReportControlsOptions{
+		ReportID:       reportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	evaluationsPager, err := results.NewReportEvaluationsPager(&ListReportEvaluationsOptions{
+		ReportID:       reportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	evaluations, err := evaluationsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+	resourcesPager, err := results.NewReportResourcesPager(&ListReportResourcesOptions{
+		ReportID:       reportID,
+		XCorrelationID: options.XCorrelationID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	resources, err := resourcesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return
+	}
+
+	tx, err := options.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Rows left by an earlier sync of a report that was removed from the reports table are replaced.
+	for _, table := range []string{"controls", "evaluations", "resources"} {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE report_id = ?`, *reportID); err != nil {
+			return
+		}
+	}
+	reportControls := controls.GetControls()
+	for i := range reportControls {
+		control := &reportControls[i]
+		if control.GetID() == "" {
+			skipped++
+			continue
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO controls (report_id, control_id, control_library_id, control_library_version,
+			control_name, control_description, control_category, control_path, status, total_count, compliant_count,
+			not_compliant_count, unable_to_perform_count, user_evaluation_required_count)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			*reportID, control.GetID(), control.ControlLibraryID, control.ControlLibraryVersion,
+			control.ControlName, control.ControlDescription, control.ControlCategory, control.ControlPath, control.Status,
+			control.TotalCount, control.CompliantCount, control.NotCompliantCount, control.UnableToPerformCount,
+			control.UserEvaluationRequiredCount)
+		if err != nil {
+			return
+		}
+	}
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+		var details []byte
+		if evaluation.Details != nil {
+			if details, err = json.Marshal(evaluation.Details); err != nil {
+				return
+			}
+		}
+		assessment := evaluation.GetAssessment()
+		target := evaluation.GetTarget()
+		_, err = tx.ExecContext(ctx, `INSERT INTO evaluations (report_id, control_id, component_id, assessment_id,
+			assessment_type, assessment_description, evaluate_time, target_id, target_account_id, target_resource_crn,
+			target_resource_name, target_service_name, status, reason, details)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			*reportID, evaluation.ControlID, evaluation.ComponentID, assessment.GetAssessmentID(),
+			assessment.GetAssessmentType(), assessment.GetAssessmentDescription(), evaluation.EvaluateTime,
+			target.GetID(), target.GetAccountID(), target.GetResourceCrn(), target.GetResourceName(),
+			target.GetServiceName(), evaluation.Status, evaluation.Reason, nullableText(details))
+		if err != nil {
+			return
+		}
+	}
+	for i := range resources {
+		resource := &resources[i]
+		if resource.GetID() == "" {
+			skipped++
+			continue
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO resources (report_id, resource_id, resource_name, component_id,
+			environment, account_id, status, total_count, pass_count, failure_count, error_count, completed_count)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			*reportID, resource.GetID(), resource.ResourceName, resource.ComponentID, resource.Environment,
+			resource.GetAccount().GetID(), resource.Status, resource.TotalCount, resource.PassCount,
+			resource.FailureCount, resource.ErrorCount, resource.CompletedCount)
+		if err != nil {
+			return
+		}
+	}
+	score := summary.GetScore()
+	if score == nil {
+		score = &ComplianceScore{}
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO reports (id, group_id, created_at, scan_time, type, instance_id, account_id,
+		account_name, profile_id, profile_name, profile_version, scope_id, scope_type, attachment_id, score_passed,
+		score_total_count, score_percent, synced_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		*reportID, report.GroupID, report.CreatedAt, report.ScanTime, report.Type, report.InstanceID,
+		report.GetAccount().GetID(), report.GetAccount().GetName(), report.GetProfile().GetID(),
+		report.GetProfile().GetName(), report.GetProfile().GetVersion(), report.GetScope().GetID(),
+		report.GetScope().GetType(), report.GetAttachment().GetID(), score.Passed, score.TotalCount, score.Percent,
+		time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return
+	}
+	err = tx.Commit()
+	return
+}
+
+func nullableText(text []byte) interface{} {
+	if text == nil {
+		return nil
+	}
+	return string(text)
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warehousetest_test
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v4/internal/fixtureserver"
+	"github.com/IBM/scc-go-sdk/v4/resultsv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	_ "modernc.org/sqlite"
+)
+
+var _ = Describe(`ResultsV3 warehouse sync`, func() {
+	var testServer *fixtureserver.Server
+	var resultsService *resultsv3.ResultsV3
+	var db *sql.DB
+	var dir string
+
+	BeforeEach(func() {
+		responses := map[string]string{
+			"GET /reports": `{"reports": [
+				{"id": "r2", "group_id": "g1", "scan_time": "2023-05-02T08:00:00Z", "profile": {"id": "p1", "name": "FS Cloud"}, "scope": {"id": "s1"}},
+				{"id": "r1", "group_id": "g1", "scan_time": "2023-05-01T08:00:00Z", "profile": {"id": "p1", "name": "FS Cloud"}, "scope": {"id": "s1"}}
+			]}`,
+		}
+		for i, percent := range []int{0, 50, 75} {
+			reportID := fmt.Sprintf("r%d", i)
+			responses["GET /reports/"+reportID+"/summary"] = fmt.Sprintf(`{"report_id": "%s", "score": {"passed": %d, "total_count": 100, "percent": %d}}`, reportID, percent, percent)
+			responses["GET /reports/"+reportID+"/controls"] = `{"controls": [{"id": "c1", "control_path": "AC-2", "status": "not_compliant", "not_compliant_count": 1}]}`
+			responses["GET /reports/"+reportID+"/evaluations"] = `{"evaluations": [
+				{"control_id": "c1", "status": "failure", "reason": "public", "assessment": {"assessment_id": "rule-1"}, "target": {"resource_crn": "crn:bucket-a"},
+					"details": {"properties": [{"property": "public_access", "expected_value": false, "found_value": true}]}},
+				{"control_id": "c1", "status": "pass", "assessment": {"assessment_id": "rule-1"}, "target": {"resource_crn": "crn:bucket-b"}}
+			]}`
+			responses["GET /reports/"+reportID+"/resources"] = `{"resources": [{"id": "crn:bucket-a", "resource_name": "bucket-a", "status": "not_compliant", "failure_count": 1, "account": {"id": "acc-1"}}]}`
+		}
+		testServer = fixtureserver.New(responses)
+		resultsService, _ = resultsv3.NewResultsV3(&resultsv3.ResultsV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		var err error
+		dir, err = os.MkdirTemp("", "warehouse")
+		Expect(err).To(BeNil())
+		db, err = sql.Open("sqlite", filepath.Join(dir, "warehouse.db"))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		db.Close()
+		os.RemoveAll(dir)
+		testServer.Close()
+	})
+
+	It(`Syncs reports incrementally, oldest first`, func() {
+		result, err := resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db).SetMaxReports(1))
+		Expect(err).To(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r1"}))
+		Expect(result.Remaining).To(Equal(1))
+
+		result, err = resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db))
+		Expect(err).To(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r2"}))
+		Expect(result.AlreadySynced).To(Equal(1))
+
+		var percent int64
+		var profileName string
+		Expect(db.QueryRow(`SELECT score_percent, profile_name FROM reports WHERE id = 'r2'`).Scan(&percent, &profileName)).To(Succeed())
+		Expect(percent).To(Equal(int64(75)))
+		Expect(profileName).To(Equal("FS Cloud"))
+
+		rows, err := db.Query(`SELECT e.target_resource_crn, COUNT(DISTINCT e.report_id) FROM evaluations e
+			JOIN reports r ON r.id = e.report_id
+			WHERE e.assessment_id = 'rule-1' AND e.status = 'failure'
+			GROUP BY e.target_resource_crn ORDER BY e.target_resource_crn`)
+		Expect(err).To(BeNil())
+		defer rows.Close()
+		Expect(rows.Next()).To(BeTrue())
+		var crn string
+		var failedScans int
+		Expect(rows.Scan(&crn, &failedScans)).To(Succeed())
+		Expect(crn).To(Equal("crn:bucket-a"))
+		Expect(failedScans).To(Equal(2))
+		Expect(rows.Next()).To(BeFalse())
+
+		var details string
+		Expect(db.QueryRow(`SELECT details FROM evaluations WHERE report_id = 'r1' AND status = 'failure'`).Scan(&details)).To(Succeed())
+		Expect(details).To(ContainSubstring(`"property":"public_access"`))
+	})
+	It(`Reports the reports without a scan time that Since skips`, func() {
+		testServer.SetResponse("GET /reports", `{"reports": [
+			{"id": "r2", "scan_time": "2023-05-02T08:00:00Z"},
+			{"id": "r1", "scan_time": "2023-04-30T08:00:00Z"},
+			{"id": "r0", "scan_time": "yesterday"}
+		]}`)
+		testServer.SetResponse("GET /reports/r2/controls", "")
+		since := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+		result, err := resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db).SetSince(since))
+		Expect(err).To(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r2"}))
+		Expect(result.NoScanTimeReportIDs).To(Equal([]string{"r0"}))
+
+		var controls int
+		Expect(db.QueryRow(`SELECT COUNT(*) FROM controls WHERE report_id = 'r2'`).Scan(&controls)).To(Succeed())
+		Expect(controls).To(Equal(0))
+	})
+	It(`Skips controls and resources without an ID`, func() {
+		testServer.SetResponse("GET /reports/r1/controls", `{"controls": [{"id": "c1", "status": "compliant"}, {"status": "compliant"}, {"id": "", "status": "compliant"}]}`)
+		testServer.SetResponse("GET /reports/r1/resources", `{"resources": [{"resource_name": "bucket-a"}, {"resource_name": "bucket-b"}]}`)
+		result, err := resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db))
+		Expect(err).To(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r1", "r2"}))
+		Expect(result.SkippedRows).To(Equal(4))
+
+		var controls, resources int
+		Expect(db.QueryRow(`SELECT COUNT(*) FROM controls WHERE report_id = 'r1'`).Scan(&controls)).To(Succeed())
+		Expect(controls).To(Equal(1))
+		Expect(db.QueryRow(`SELECT COUNT(*) FROM resources WHERE report_id = 'r1'`).Scan(&resources)).To(Succeed())
+		Expect(resources).To(Equal(0))
+	})
+	It(`Resumes after the last report that was written`, func() {
+		testServer.DeleteResponse("GET /reports/r2/evaluations")
+		result, err := resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db))
+		Expect(err).ToNot(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r1"}))
+
+		var controls int
+		Expect(db.QueryRow(`SELECT COUNT(*) FROM controls WHERE report_id = 'r2'`).Scan(&controls)).To(Succeed())
+		Expect(controls).To(Equal(0))
+
+		testServer.SetResponse("GET /reports/r2/evaluations", `{"evaluations": []}`)
+		result, err = resultsService.SyncWarehouse(resultsService.NewSyncWarehouseOptions(db))
+		Expect(err).To(BeNil())
+		Expect(result.SyncedReportIDs).To(Equal([]string{"r2"}))
+		Expect(result.AlreadySynced).To(Equal(1))
+	})
+})